@@ -98,6 +98,11 @@ type InstallationsController struct {
 // ExecutionsController contains the controller config that reconciles executions.
 type ExecutionsController struct {
 	CommonControllerConfig
+	// MaxParallelDeployItemsPerTarget limits the number of deploy items that are processed in parallel
+	// for the same target across all executions.
+	// If not set, the number of deploy items per target is not limited.
+	// +optional
+	MaxParallelDeployItemsPerTarget *int32
 }
 
 // DeployItemsController contains the controller config that reconciles deploy items.
@@ -259,6 +264,25 @@ type BlueprintStore struct {
 	// +optional
 	IndexMethod IndexMethod
 	GarbageCollectionConfiguration
+	// SharedCache configures a content-addressed cache of blueprint blobs that survives restarts.
+	// The cache can be shared between multiple landscaper instances, e.g. by mounting the same persistent volume.
+	// Blueprints that are not found in the local store are read from the shared cache before they are fetched from the registry.
+	// +optional
+	SharedCache *SharedBlueprintCache
+}
+
+// SharedBlueprintCache contains the configuration for the shared blueprint cache.
+type SharedBlueprintCache struct {
+	// Path defines the root path of the shared cache.
+	Path string
+	// Size is the maximal size of the shared cache.
+	// When the usage reaches the gc high threshold of the store, the least recently used blueprints are evicted
+	// until the gc low threshold is reached.
+	// If the value is 0 or empty there is no limit and no blueprints are evicted.
+	// See the kubernetes quantity docs for detailed description of the format
+	// https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go
+	// +optional
+	Size string
 }
 
 // GarbageCollectionConfiguration contains all options for the cache garbage collection.
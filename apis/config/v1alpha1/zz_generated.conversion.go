@@ -250,6 +250,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SharedBlueprintCache)(nil), (*config.SharedBlueprintCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedBlueprintCache_To_config_SharedBlueprintCache(a.(*SharedBlueprintCache), b.(*config.SharedBlueprintCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SharedBlueprintCache)(nil), (*SharedBlueprintCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SharedBlueprintCache_To_v1alpha1_SharedBlueprintCache(a.(*config.SharedBlueprintCache), b.(*SharedBlueprintCache), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -288,6 +298,7 @@ func autoConvert_v1alpha1_BlueprintStore_To_config_BlueprintStore(in *BlueprintS
 	if err := Convert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(&in.GarbageCollectionConfiguration, &out.GarbageCollectionConfiguration, s); err != nil {
 		return err
 	}
+	out.SharedCache = (*config.SharedBlueprintCache)(unsafe.Pointer(in.SharedCache))
 	return nil
 }
 
@@ -303,6 +314,7 @@ func autoConvert_config_BlueprintStore_To_v1alpha1_BlueprintStore(in *config.Blu
 	if err := Convert_config_GarbageCollectionConfiguration_To_v1alpha1_GarbageCollectionConfiguration(&in.GarbageCollectionConfiguration, &out.GarbageCollectionConfiguration, s); err != nil {
 		return err
 	}
+	out.SharedCache = (*SharedBlueprintCache)(unsafe.Pointer(in.SharedCache))
 	return nil
 }
 
@@ -559,6 +571,7 @@ func autoConvert_v1alpha1_ExecutionsController_To_config_ExecutionsController(in
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxParallelDeployItemsPerTarget = (*int32)(unsafe.Pointer(in.MaxParallelDeployItemsPerTarget))
 	return nil
 }
 
@@ -571,6 +584,7 @@ func autoConvert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in
 	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxParallelDeployItemsPerTarget = (*int32)(unsafe.Pointer(in.MaxParallelDeployItemsPerTarget))
 	return nil
 }
 
@@ -848,3 +862,25 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_SharedBlueprintCache_To_config_SharedBlueprintCache(in *SharedBlueprintCache, out *config.SharedBlueprintCache, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1alpha1_SharedBlueprintCache_To_config_SharedBlueprintCache is an autogenerated conversion function.
+func Convert_v1alpha1_SharedBlueprintCache_To_config_SharedBlueprintCache(in *SharedBlueprintCache, out *config.SharedBlueprintCache, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedBlueprintCache_To_config_SharedBlueprintCache(in, out, s)
+}
+
+func autoConvert_config_SharedBlueprintCache_To_v1alpha1_SharedBlueprintCache(in *config.SharedBlueprintCache, out *SharedBlueprintCache, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_config_SharedBlueprintCache_To_v1alpha1_SharedBlueprintCache is an autogenerated conversion function.
+func Convert_config_SharedBlueprintCache_To_v1alpha1_SharedBlueprintCache(in *config.SharedBlueprintCache, out *SharedBlueprintCache, s conversion.Scope) error {
+	return autoConvert_config_SharedBlueprintCache_To_v1alpha1_SharedBlueprintCache(in, out, s)
+}
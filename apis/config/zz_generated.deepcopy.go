@@ -59,6 +59,11 @@ func (in *AgentConfiguration) DeepCopyObject() runtime.Object {
 func (in *BlueprintStore) DeepCopyInto(out *BlueprintStore) {
 	*out = *in
 	out.GarbageCollectionConfiguration = in.GarbageCollectionConfiguration
+	if in.SharedCache != nil {
+		in, out := &in.SharedCache, &out.SharedCache
+		*out = new(SharedBlueprintCache)
+		**out = **in
+	}
 	return
 }
 
@@ -277,6 +282,11 @@ func (in *DeployerManagementConfiguration) DeepCopy() *DeployerManagementConfigu
 func (in *ExecutionsController) DeepCopyInto(out *ExecutionsController) {
 	*out = *in
 	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.MaxParallelDeployItemsPerTarget != nil {
+		in, out := &in.MaxParallelDeployItemsPerTarget, &out.MaxParallelDeployItemsPerTarget
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -351,7 +361,7 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = (*in).DeepCopy()
 	}
 	in.Registry.DeepCopyInto(&out.Registry)
-	out.BlueprintStore = in.BlueprintStore
+	in.BlueprintStore.DeepCopyInto(&out.BlueprintStore)
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
 		*out = new(MetricsConfiguration)
@@ -505,3 +515,19 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedBlueprintCache) DeepCopyInto(out *SharedBlueprintCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedBlueprintCache.
+func (in *SharedBlueprintCache) DeepCopy() *SharedBlueprintCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedBlueprintCache)
+	in.DeepCopyInto(out)
+	return out
+}
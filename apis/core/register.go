@@ -58,6 +58,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DeployerRegistrationList{},
 		&TargetSync{},
 		&TargetSyncList{},
+		&Rollout{},
+		&RolloutList{},
 	)
 	return nil
 }
@@ -47,4 +47,30 @@ type Context struct {
 	// If the string is empty, no overwrites will be used.
 	// +optional
 	ComponentVersionOverwritesReference string `json:"componentVersionOverwrites"`
+	// MaintenancePolicy restricts the times at which changes and automatic reconciles of the root installations
+	// that use this context are rolled out. It is overwritten by the maintenance policy of an installation.
+	// +optional
+	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+	// DeployItemTimeouts overwrites the default deploy item timeouts of the landscaper configuration
+	// for all deploy items that use this context.
+	// +optional
+	DeployItemTimeouts *DeployItemTimeouts `json:"deployItemTimeouts,omitempty"`
+	// MaxParallelDeployItems limits the number of deploy items of an execution that are processed in parallel
+	// for all installations that use this context. It is overwritten by the limit of the blueprint.
+	// +optional
+	MaxParallelDeployItems *int32 `json:"maxParallelDeployItems,omitempty"`
+}
+
+// DeployItemTimeouts contains the default timeouts for the deploy items of a context.
+type DeployItemTimeouts struct {
+	// Pickup defines how long a deployer can take to react on changes to a deploy item before the landscaper will mark it as failed.
+	// Allowed values are 'none' (to disable pickup timeout detection) and anything that is understood by golang's time.ParseDuration method.
+	// Defaults to the pickup timeout of the landscaper configuration.
+	// +optional
+	Pickup *Duration `json:"pickup,omitempty"`
+	// ProgressingDefault specifies how long the deployer may take to apply a deploy item by default. The value can be overwritten per deploy item in 'spec.timeout'.
+	// Allowed values are 'none' (to disable progressing timeout detection) and anything that is understood by golang's time.ParseDuration method.
+	// Defaults to the progressing timeout of the landscaper configuration.
+	// +optional
+	ProgressingDefault *Duration `json:"progressingDefault,omitempty"`
 }
@@ -8,6 +8,28 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DataObjectSourceType defines the context of a data object.
+type DataObjectSourceType string
+
+const (
+	// ExportDataObjectSourceType is the data object type of a exported object.
+	ExportDataObjectSourceType DataObjectSourceType = "export"
+	// ImportDataObjectSourceType is the data object type of a imported object.
+	ImportDataObjectSourceType DataObjectSourceType = "import"
+)
+
+// DataObjectContextLabel defines the name of the label that specifies the context of the dataobject.
+const DataObjectContextLabel = "data.landscaper.gardener.cloud/context"
+
+// DataObjectSourceTypeLabel defines the name of the label that specifies the source type (import or export) of the dataobject.
+const DataObjectSourceTypeLabel = "data.landscaper.gardener.cloud/sourceType"
+
+// DataObjectKeyLabel defines the name of the label that specifies the export or imported key of the dataobject.
+const DataObjectKeyLabel = "data.landscaper.gardener.cloud/key"
+
+// DataObjectIndexLabel defines the name of the annotation that specifies the index of the dataobject (for list-type imports)
+const DataObjectIndexLabel = "data.landscaper.gardener.cloud/index"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DataObjectList contains a list of DataObject
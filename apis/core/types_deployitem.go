@@ -122,10 +122,26 @@ type DeployItemStatus struct {
 	// JobIDGenerationTime is the timestamp when the JobID was set.
 	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
 
+	// EffectiveTimeouts contains the pickup and progressing timeouts that are applied to the current job of the deploy item.
+	// They are derived from the deploy item, its context and the landscaper configuration.
+	// +optional
+	EffectiveTimeouts *EffectiveDeployItemTimeouts `json:"effectiveTimeouts,omitempty"`
+
 	// DeployerPhase is DEPRECATED and will soon be removed.
 	DeployerPhase *string `json:"deployItemPhase,omitempty"`
 }
 
+// EffectiveDeployItemTimeouts describes the timeouts that are applied to a deploy item.
+// A duration of 'none' means that the timeout detection is disabled.
+type EffectiveDeployItemTimeouts struct {
+	// Pickup is the time a deployer may take to react on changes to the deploy item.
+	// +optional
+	Pickup *Duration `json:"pickup,omitempty"`
+	// Progressing is the time a deployer may take to apply the deploy item.
+	// +optional
+	Progressing *Duration `json:"progressing,omitempty"`
+}
+
 // DeployerInformation holds additional information about the deployer that
 // has reconciled or is reconciling the deploy item.
 type DeployerInformation struct {
@@ -27,6 +27,17 @@ const ExecutionManagedNameAnnotation = "execution.landscaper.gardener.cloud/name
 // ReconcileDeployItemsCondition is the Conditions type to indicate the deploy items status.
 const ReconcileDeployItemsCondition ConditionType = "ReconcileDeployItems"
 
+// ApprovalPendingCondition is the Conditions type to indicate that deploy items are waiting for a manual approval.
+// The condition is set on the execution and propagated to its installation and the parent installations.
+const ApprovalPendingCondition ConditionType = "ApprovalPending"
+
+// ApprovalAnnotationPrefix is the prefix of the execution annotation that approves a deploy item.
+// The annotation "approval.landscaper.gardener.cloud/<deploy item name>" approves the deploy item with the given name
+// for the next run, its value is the identity of the approver.
+// The validation webhook of executions only accepts the name of the user who sets the annotation as approver.
+// If the webhook is disabled, the approver is not authenticated and only informational.
+const ApprovalAnnotationPrefix = "approval.landscaper.gardener.cloud/"
+
 type ExecutionPhase string
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -64,6 +75,12 @@ type ExecutionSpec struct {
 	// DeployItemsCompressed as zipped byte array
 	DeployItemsCompressed []byte `json:"deployItemsCompressed,omitempty"`
 
+	// MaxParallelDeployItems limits the number of deploy items of the execution that are processed in parallel.
+	// Further runnable deploy items are started as soon as running deploy items have finished.
+	// If not set, all runnable deploy items are started at once.
+	// +optional
+	MaxParallelDeployItems *int32 `json:"maxParallelDeployItems,omitempty"`
+
 	// RegistryPullSecrets defines a list of registry credentials that are used to
 	// pull blueprints, component descriptors and jsonschemas from the respective registry.
 	// For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/
@@ -102,12 +119,48 @@ type ExecutionStatus struct {
 	// JobIDFinished is the ID of the finished working request.
 	JobIDFinished string `json:"jobIDFinished,omitempty"`
 
+	// JobIDGenerationTime is the timestamp when the JobID was set.
+	// +optional
+	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
+
 	// ExecutionPhase is the current phase of the execution.
 	ExecutionPhase ExecutionPhase `json:"phase,omitempty"`
 
 	// PhaseTransitionTime is the time when the phase last changed.
 	// +optional
 	PhaseTransitionTime *metav1.Time `json:"phaseTransitionTime,omitempty"`
+
+	// Approvals contains the latest approval of the deploy items that require a manual approval.
+	// +optional
+	Approvals []DeployItemApproval `json:"approvals,omitempty"`
+
+	// Retries contains the automatic retries of failed deploy items in the current job.
+	// +optional
+	Retries []DeployItemRetryStatus `json:"retries,omitempty"`
+}
+
+// DeployItemRetryStatus describes the automatic retries of a failed deploy item.
+type DeployItemRetryStatus struct {
+	// Name is the name of the retried deploy item template.
+	Name string `json:"name"`
+	// JobID is the ID of the job in which the deploy item has been retried.
+	JobID string `json:"jobID"`
+	// NumberOfRetries is the number of retries of the deploy item in the job.
+	NumberOfRetries int `json:"numberOfRetries"`
+	// LastRetryTime is the time of the last retry.
+	LastRetryTime metav1.Time `json:"lastRetryTime"`
+}
+
+// DeployItemApproval describes the manual approval of a deploy item.
+type DeployItemApproval struct {
+	// Name is the name of the approved deploy item template.
+	Name string `json:"name"`
+	// ApprovedBy is the identity of the approver.
+	ApprovedBy string `json:"approvedBy"`
+	// ApprovalTime is the time when the approval has been consumed by the execution.
+	ApprovalTime metav1.Time `json:"approvalTime"`
+	// JobID is the ID of the job for which the deploy item has been approved.
+	JobID string `json:"jobID"`
 }
 
 // ExecutionGeneration links a deployitem to the generation of the execution when it was applied.
@@ -157,6 +210,39 @@ type DeployItemTemplate struct {
 
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
+
+	// RequiresApproval specifies that the deploy item is only triggered after a manual approval.
+	// The execution stops before the deploy item until the approval annotation is set on the execution.
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
+
+	// Retry configures automatic retries of the deploy item if it fails,
+	// without reconciling the whole installation again.
+	// +optional
+	Retry *DeployItemRetryPolicy `json:"retry,omitempty"`
+}
+
+// DeployItemRetryPolicy configures automatic retries of a failed deploy item.
+type DeployItemRetryPolicy struct {
+	// NumberOfRetries specifies the maximal number of retries. If not set, no upper limit exists.
+	// +optional
+	NumberOfRetries *int `json:"numberOfRetries,omitempty"`
+
+	// Interval specifies the interval between two subsequent retries. If not set, a default of 1 minute is used.
+	// If a backoff is configured, the interval is the initial interval that is increased after every retry.
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Backoff configures an exponentially increasing interval between two subsequent retries.
+	// If not set, the interval is constant.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// Rules decide based on the last error of the deploy item whether it is retried.
+	// The first rule that matches the last error is applied. If no rule matches, the deploy item is retried
+	// until the maximal number of retries is reached.
+	// +optional
+	Rules []RetryRule `json:"rules,omitempty"`
 }
 
 // OnDeleteConfig specifies particular setting when deleting a deploy item
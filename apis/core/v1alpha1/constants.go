@@ -109,6 +109,9 @@ const (
 	PhaseStringSucceeded       string = "Succeeded"
 	PhaseStringFailed          string = "Failed"
 
+	// PhaseStringDryRunSucceeded is only used by deploy items whose changes have only been computed with a dry-run.
+	PhaseStringDryRunSucceeded string = "DryRunSucceeded"
+
 	PhaseStringInitDelete    string = "InitDelete"
 	PhaseStringTriggerDelete string = "TriggerDelete"
 	PhaseStringDeleting      string = "Deleting"
@@ -63,6 +63,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DeployerRegistrationList{},
 		&TargetSync{},
 		&TargetSyncList{},
+		&Rollout{},
+		&RolloutList{},
 	)
 	if err := RegisterConversions(scheme); err != nil {
 		return err
@@ -90,6 +92,7 @@ var ResourceDefinition = func() lsschema.CustomResourceDefinitions {
 			EnvironmentDefinition,
 			ComponentVersionOverwritesDefinition,
 			TargetSyncDefinition,
+			RolloutDefinition,
 		},
 	}
 }()
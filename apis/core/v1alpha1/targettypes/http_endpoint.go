@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// HTTPEndpointTargetType defines the landscaper http endpoint target that describes a generic http(s) api.
+const HTTPEndpointTargetType v1alpha1.TargetType = core.GroupName + "/http-endpoint"
+
+// HTTPEndpointTargetConfig defines the landscaper http endpoint target config.
+type HTTPEndpointTargetConfig struct {
+	// URL is the base url of the endpoint.
+	URL string `json:"url"`
+	// CABundle is a PEM encoded bundle of ca certificates that are used to verify the server certificate.
+	// The system certificates are used if no bundle is defined.
+	// +optional
+	CABundle string `json:"caBundle,omitempty"`
+	// InsecureSkipVerify disables the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Username is used for basic authentication.
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Password is used for basic authentication.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// BearerToken is sent as bearer token in the authorization header.
+	// +optional
+	BearerToken string `json:"bearerToken,omitempty"`
+	// Headers are additional headers that are sent with every request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
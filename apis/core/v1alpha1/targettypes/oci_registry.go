@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// OCIRegistryTargetType defines the landscaper oci registry target that contains the access data to an oci registry.
+const OCIRegistryTargetType v1alpha1.TargetType = core.GroupName + "/oci-registry"
+
+// OCIRegistryTargetConfig defines the landscaper oci registry target config.
+type OCIRegistryTargetConfig struct {
+	// Registry is the host of the registry, optionally with port and a repository prefix, e.g. "eu.gcr.io/my-project".
+	Registry string `json:"registry"`
+	// Username is the user to authenticate at the registry.
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Password is the password or token to authenticate at the registry.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// Insecure allows to access the registry via plain http.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
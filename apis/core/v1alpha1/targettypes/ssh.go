@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// SSHTargetType defines the landscaper ssh target that describes a host which is accessible via ssh.
+const SSHTargetType v1alpha1.TargetType = core.GroupName + "/ssh"
+
+// DefaultSSHPort is the port that is used if the ssh target config defines no port.
+const DefaultSSHPort = 22
+
+// SSHTargetConfig defines the landscaper ssh target config.
+type SSHTargetConfig struct {
+	// Host is the hostname or the ip address of the host.
+	Host string `json:"host"`
+	// Port is the port of the ssh server.
+	// Defaults to 22.
+	// +optional
+	Port int `json:"port,omitempty"`
+	// User is the name of the user that is used to log in.
+	User string `json:"user"`
+	// PrivateKey is the PEM encoded private key that is used to authenticate the user.
+	// +optional
+	PrivateKey string `json:"privateKey,omitempty"`
+	// Password is used to authenticate the user if no private key is defined.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// HostPublicKey is the public key of the host in the authorized_keys format.
+	// The host key is not verified if no public key is defined.
+	// +optional
+	HostPublicKey string `json:"hostPublicKey,omitempty"`
+}
@@ -85,6 +85,11 @@ type Blueprint struct {
 	// ExportExecutions defines the templating executors that are used to generate the exports.
 	// +optional
 	ExportExecutions []TemplateExecutor `json:"exportExecutions,omitempty"`
+
+	// MaxParallelDeployItems limits the number of deploy items of the blueprint that are processed in parallel.
+	// It overwrites the limit of the context of the installation.
+	// +optional
+	MaxParallelDeployItems *int32 `json:"maxParallelDeployItems,omitempty"`
 }
 
 // ImportDefinitionList defines a list of import defiinitions.
@@ -135,11 +140,31 @@ type FieldValueDefinition struct {
 	// Schema defines the imported value as jsonschema.
 	// +optional
 	Schema *JSONSchemaDefinition `json:"schema,omitempty"`
+	// Cue defines the imported value as CUE definition.
+	// It can be used as alternative or in addition to the jsonschema.
+	// +optional
+	Cue *CueDefinition `json:"cue,omitempty"`
 	// TargetType defines the type of the imported target.
 	// +optional
 	TargetType string `json:"targetType,omitempty"`
 }
 
+// CueDefinition defines a CUE definition that is used to validate an im- or exported value.
+// Defaults defined in the CUE definition are applied to imported values.
+type CueDefinition struct {
+	// Definition defines the CUE definition inline.
+	// +optional
+	Definition string `json:"definition,omitempty"`
+	// Ref references a CUE definition that is either stored in the blueprint filesystem ("blueprint://<path>")
+	// or as resource of the component descriptor ("cd://componentReferences/<name>/resources/<name>").
+	// +optional
+	Ref string `json:"ref,omitempty"`
+	// Path is the path of the CUE value the im- or exported value is unified with, e.g. "#Config".
+	// The whole CUE definition is used if no path is given.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // Default defines a default value (future idea: also reference?).
 type Default struct {
 	Value AnyJSON `json:"value"`
@@ -171,6 +196,9 @@ const GOTemplateType TemplateType = "GoTemplate"
 // SpiffTemplateType describes the spiff templating type.
 const SpiffTemplateType TemplateType = "Spiff"
 
+// JsonnetTemplateType describes the jsonnet templating type.
+const JsonnetTemplateType TemplateType = "Jsonnet"
+
 // TemplateExecutor describes a templating mechanism and configuration.
 type TemplateExecutor struct {
 	// Name is the unique name of the template
@@ -26,7 +26,7 @@ func (p DeployItemPhase) String() string {
 
 func (p DeployItemPhase) IsFinal() bool {
 	switch p {
-	case DeployItemPhases.Succeeded, DeployItemPhases.Failed, DeployItemPhases.DeleteFailed:
+	case DeployItemPhases.Succeeded, DeployItemPhases.Failed, DeployItemPhases.DeleteFailed, DeployItemPhases.DryRunSucceeded:
 		return true
 	}
 	return false
@@ -61,7 +61,8 @@ var (
 		Failed,
 		InitDelete,
 		Deleting,
-		DeleteFailed DeployItemPhase
+		DeleteFailed,
+		DryRunSucceeded DeployItemPhase
 	}{
 		Init:         DeployItemPhase(PhaseStringInit),
 		Progressing:  DeployItemPhase(PhaseStringProgressing),
@@ -71,6 +72,9 @@ var (
 		InitDelete:   DeployItemPhase(PhaseStringInitDelete),
 		Deleting:     DeployItemPhase(PhaseStringDeleting),
 		DeleteFailed: DeployItemPhase(PhaseStringDeleteFailed),
+		// DryRunSucceeded is a final phase, which indicates that the changes of a deploy item have only been computed
+		// with a dry-run and nothing has been deployed. Deploy items that depend on it are not triggered.
+		DryRunSucceeded: DeployItemPhase(PhaseStringDryRunSucceeded),
 	}
 )
 
@@ -217,6 +221,11 @@ type DeployItemStatus struct {
 	// JobIDGenerationTime is the timestamp when the JobID was set.
 	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
 
+	// EffectiveTimeouts contains the pickup and progressing timeouts that are applied to the current job of the deploy item.
+	// They are derived from the deploy item, its context and the landscaper configuration.
+	// +optional
+	EffectiveTimeouts *EffectiveDeployItemTimeouts `json:"effectiveTimeouts,omitempty"`
+
 	// DeployerPhase is DEPRECATED and will soon be removed.
 	DeployerPhase *string `json:"deployItemPhase,omitempty"`
 }
@@ -253,6 +262,17 @@ func (r *DeployItemStatus) SetJobID(id string) {
 	r.JobID = id
 }
 
+// EffectiveDeployItemTimeouts describes the timeouts that are applied to a deploy item.
+// A duration of 'none' means that the timeout detection is disabled.
+type EffectiveDeployItemTimeouts struct {
+	// Pickup is the time a deployer may take to react on changes to the deploy item.
+	// +optional
+	Pickup *Duration `json:"pickup,omitempty"`
+	// Progressing is the time a deployer may take to apply the deploy item.
+	// +optional
+	Progressing *Duration `json:"progressing,omitempty"`
+}
+
 // DeployerInformation holds additional information about the deployer that
 // has reconciled or is reconciling the deploy item.
 type DeployerInformation struct {
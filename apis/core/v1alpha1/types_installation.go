@@ -215,6 +215,34 @@ type InstallationSpec struct {
 	// AutomaticReconcile allows to configure automatically repeated reconciliations.
 	// +optional
 	AutomaticReconcile *AutomaticReconcile `json:"automaticReconcile,omitempty"`
+
+	// MaintenancePolicy restricts the times at which changes and automatic reconciles of the installation are rolled out.
+	// It overwrites the maintenance policy of the context. Only relevant for root installations.
+	// +optional
+	MaintenancePolicy *MaintenancePolicy `json:"maintenancePolicy,omitempty"`
+}
+
+// MaintenancePolicy restricts the times at which changes of an installation are rolled out.
+type MaintenancePolicy struct {
+	// Paused stops the rollout of changes and automatic reconciles until it is set to false again.
+	// Deletions are not affected.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Windows defines the time windows in which changes and automatic reconciles are rolled out.
+	// If empty, they are rolled out at any time.
+	// +optional
+	Windows []MaintenanceWindow `json:"windows,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression that defines the start of the window, e.g. "0 22 * * 1-5".
+	// The expression is evaluated in UTC unless it is prefixed with a time zone, e.g. "CRON_TZ=Europe/Berlin 0 22 * * *".
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the window.
+	Duration Duration `json:"duration"`
 }
 
 // AutomaticReconcile allows to configure automatically repeated reconciliations.
@@ -246,9 +274,67 @@ type FailedReconcile struct {
 	NumberOfReconciles *int `json:"numberOfReconciles,omitempty"`
 
 	// Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default
-	// of 5 minutes is used.
+	// of 5 minutes is used. If a backoff is configured, the interval is the initial interval that is increased after
+	// every repeated reconciliation.
 	// +optional
 	Interval *Duration `json:"interval,omitempty"`
+
+	// Backoff configures an exponentially increasing interval between two subsequent repeated reconciliations.
+	// If not set, the interval is constant.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// Rules decide based on the last error whether a failed installation is reconciled again.
+	// The first rule that matches the last error is applied. If no rule matches, the installation is reconciled again
+	// until the maximal number of repeated reconciliations is reached.
+	// +optional
+	Rules []RetryRule `json:"rules,omitempty"`
+}
+
+// RetryBackoff configures an exponentially increasing interval between two subsequent retries.
+// The n-th retry is delayed by interval * factor^(n-1), but at most by the max interval.
+type RetryBackoff struct {
+	// Factor is the factor by which the interval is multiplied after every retry. Defaults to 2.
+	// +optional
+	Factor *int32 `json:"factor,omitempty"`
+
+	// MaxInterval is the upper limit of the interval between two subsequent retries.
+	// If not set, the interval is not limited.
+	// +optional
+	MaxInterval *Duration `json:"maxInterval,omitempty"`
+
+	// JitterPercent randomly varies the interval by up to the given percentage in both directions,
+	// so that objects which failed at the same time are not retried at the same time. Defaults to 0.
+	// +optional
+	JitterPercent *int32 `json:"jitterPercent,omitempty"`
+}
+
+// RetryAction describes whether a failed object is retried.
+type RetryAction string
+
+const (
+	// RetryActionRetry retries the failed object until the maximal number of retries is reached.
+	RetryActionRetry RetryAction = "Retry"
+	// RetryActionNever does not retry the failed object.
+	RetryActionNever RetryAction = "Never"
+	// RetryActionAlways retries the failed object without limiting the number of retries.
+	RetryActionAlways RetryAction = "Always"
+)
+
+// RetryRule decides whether a failed object is retried based on its last error.
+// A rule matches an error if the error has at least one of the codes and one of the reasons of the rule.
+// Empty codes or reasons match all errors.
+type RetryRule struct {
+	// Codes are the error codes that are matched by the rule.
+	// +optional
+	Codes []ErrorCode `json:"codes,omitempty"`
+
+	// Reasons are the error reasons that are matched by the rule.
+	// +optional
+	Reasons []string `json:"reasons,omitempty"`
+
+	// Action is the action that is applied if the rule matches.
+	Action RetryAction `json:"action"`
 }
 
 // InstallationStatus contains the current status of a Installation.
@@ -282,6 +368,10 @@ type InstallationStatus struct {
 	// JobIDFinished is the ID of the finished working request.
 	JobIDFinished string `json:"jobIDFinished,omitempty"`
 
+	// JobIDGenerationTime is the timestamp when the JobID was set.
+	// +optional
+	JobIDGenerationTime *metav1.Time `json:"jobIDGenerationTime,omitempty"`
+
 	// InstallationPhase is the current phase of the installation.
 	InstallationPhase InstallationPhase `json:"phase,omitempty"`
 
@@ -299,6 +389,151 @@ type InstallationStatus struct {
 	// DependentsToTrigger lists dependent installations to be triggered
 	// +optional
 	DependentsToTrigger []DependentToTrigger `json:"dependentsToTrigger,omitempty"`
+
+	// Plan contains the result of the last plan operation.
+	// +optional
+	Plan *InstallationPlan `json:"plan,omitempty"`
+
+	// MaintenanceStatus describes a requested reconcile that is postponed by the maintenance policy.
+	// +optional
+	MaintenanceStatus *MaintenanceStatus `json:"maintenanceStatus,omitempty"`
+
+	// BlockedBy lists the sibling installations, data objects and targets the installation is waiting for
+	// before it can start its processing. It is updated on every reconcile of the installation.
+	// +optional
+	BlockedBy []InstallationBlocker `json:"blockedBy,omitempty"`
+}
+
+// InstallationBlockerKind is the kind of the object that blocks an installation.
+type InstallationBlockerKind string
+
+const (
+	// InstallationBlockerKindInstallation indicates that the installation waits for a sibling installation.
+	InstallationBlockerKindInstallation InstallationBlockerKind = "Installation"
+	// InstallationBlockerKindDataObject indicates that the installation waits for an imported data object.
+	InstallationBlockerKindDataObject InstallationBlockerKind = "DataObject"
+	// InstallationBlockerKindTarget indicates that the installation waits for an imported target.
+	InstallationBlockerKindTarget InstallationBlockerKind = "Target"
+)
+
+// InstallationBlockerReason describes why an object blocks an installation.
+type InstallationBlockerReason string
+
+const (
+	// InstallationBlockerReasonReconcilePending indicates that a predecessor has a pending reconcile.
+	InstallationBlockerReasonReconcilePending InstallationBlockerReason = "ReconcilePending"
+	// InstallationBlockerReasonNotFinished indicates that a predecessor has not yet finished its current job.
+	InstallationBlockerReasonNotFinished InstallationBlockerReason = "NotFinished"
+	// InstallationBlockerReasonNotSucceeded indicates that a predecessor has finished but not succeeded.
+	InstallationBlockerReasonNotSucceeded InstallationBlockerReason = "NotSucceeded"
+	// InstallationBlockerReasonOutdatedConfigGeneration indicates that the exporting installation
+	// of an import is currently recomputing its exports.
+	InstallationBlockerReasonOutdatedConfigGeneration InstallationBlockerReason = "OutdatedConfigGeneration"
+	// InstallationBlockerReasonMissingExport indicates that an imported data object or target does not exist.
+	InstallationBlockerReasonMissingExport InstallationBlockerReason = "MissingExport"
+)
+
+// InstallationBlocker describes an object that prevents the processing of an installation.
+type InstallationBlocker struct {
+	// Kind is the kind of the blocking object.
+	Kind InstallationBlockerKind `json:"kind"`
+
+	// Name is the name of the blocking object.
+	Name string `json:"name"`
+
+	// Import is the name of the import that references the blocking object.
+	// +optional
+	Import string `json:"import,omitempty"`
+
+	// Reason describes why the object blocks the installation.
+	Reason InstallationBlockerReason `json:"reason"`
+
+	// Message is a human-readable description of the blocker.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// MaintenanceReason describes why the reconcile of an installation is postponed.
+type MaintenanceReason string
+
+const (
+	// MaintenanceReasonPaused indicates that the maintenance policy of the installation or its context is paused.
+	MaintenanceReasonPaused MaintenanceReason = "Paused"
+	// MaintenanceReasonOutsideWindow indicates that the current time is outside of all maintenance windows.
+	MaintenanceReasonOutsideWindow MaintenanceReason = "OutsideMaintenanceWindow"
+)
+
+// MaintenanceStatus describes a requested reconcile of an installation that is postponed by the maintenance policy.
+type MaintenanceStatus struct {
+	// Reason describes why the reconcile is postponed.
+	Reason MaintenanceReason `json:"reason"`
+
+	// Message is a human-readable description of the postponed reconcile.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// PendingSince is the time since when the reconcile is postponed.
+	PendingSince metav1.Time `json:"pendingSince"`
+
+	// NextWindowStart is the start of the next maintenance window.
+	// It is not set if the maintenance policy is paused.
+	// +optional
+	NextWindowStart *metav1.Time `json:"nextWindowStart,omitempty"`
+}
+
+// InstallationPlan contains the result of a plan operation of an installation.
+// It describes which subinstallations and deploy items would be created, updated or deleted
+// if the installation was reconciled.
+type InstallationPlan struct {
+	// PlanTime is the time when the plan was computed.
+	PlanTime metav1.Time `json:"planTime"`
+
+	// ObservedGeneration is the generation of the installation for which the plan was computed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Error describes the error that occurred during the computation of the plan.
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// Subinstallations describes the planned changes of the subinstallations.
+	// +optional
+	Subinstallations []PlannedChange `json:"subinstallations,omitempty"`
+
+	// DeployItems describes the planned changes of the deploy items of the execution.
+	// +optional
+	DeployItems []PlannedChange `json:"deployItems,omitempty"`
+
+	// ResultReference references the secret that contains the rendered imports, subinstallations and deploy items.
+	// +optional
+	ResultReference *ObjectReference `json:"resultRef,omitempty"`
+}
+
+// PlannedChangeAction describes how an object would be changed by a reconcile.
+type PlannedChangeAction string
+
+const (
+	// PlannedChangeActionCreate indicates that the object does not exist yet and would be created.
+	PlannedChangeActionCreate PlannedChangeAction = "Create"
+	// PlannedChangeActionUpdate indicates that the specification of the object would be changed.
+	PlannedChangeActionUpdate PlannedChangeAction = "Update"
+	// PlannedChangeActionDelete indicates that the object is not rendered anymore and would be deleted.
+	PlannedChangeActionDelete PlannedChangeAction = "Delete"
+	// PlannedChangeActionNone indicates that the specification of the object would not be changed.
+	PlannedChangeActionNone PlannedChangeAction = "None"
+)
+
+// PlannedChange describes the planned change of a subinstallation or deploy item.
+type PlannedChange struct {
+	// Name is the name of the subinstallation or deploy item as defined in the blueprint.
+	Name string `json:"name"`
+
+	// Action describes how the object would be changed.
+	Action PlannedChangeAction `json:"action"`
+
+	// ChangedFields lists the paths of all fields whose values differ between
+	// the current and the rendered specification.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
 }
 
 type DependentToTrigger struct {
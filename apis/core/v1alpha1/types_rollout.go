@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsschema "github.com/gardener/landscaper/apis/schema"
+)
+
+// RolloutPhase describes the phase of a rollout.
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing means that the rollout has not yet updated all selected installations.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhaseSucceeded means that all selected installations have been updated and processed successfully.
+	RolloutPhaseSucceeded RolloutPhase = "Succeeded"
+	// RolloutPhaseFailed means that the rollout is halted because at least one updated installation failed.
+	RolloutPhaseFailed RolloutPhase = "Failed"
+)
+
+const (
+	// RolloutAnnotation is the annotation of installations that have been switched to the context of a rollout.
+	// Its value is the name of the rollout.
+	RolloutAnnotation = LandscaperDomain + "/rollout"
+
+	// RolloutOriginalContextAnnotation is the annotation of installations that have been switched to the context
+	// of a rollout. Its value is the name of the original context, which is restored if the rollout is deleted.
+	RolloutOriginalContextAnnotation = LandscaperDomain + "/rollout-original-context"
+
+	// RolloutVersionAnnotation is the annotation of installations that have been switched to the context of a rollout.
+	// Its value is the version of the rolled out component that is used by this context.
+	RolloutVersionAnnotation = LandscaperDomain + "/rollout-version"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RolloutList contains a list of Rollouts
+type RolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Rollout `json:"items"`
+}
+
+// RolloutDefinition defines the Rollout resource CRD.
+var RolloutDefinition = lsschema.CustomResourceDefinition{
+	Names: lsschema.CustomResourceDefinitionNames{
+		Plural:   "rollouts",
+		Singular: "rollout",
+		ShortNames: []string{
+			"ro",
+		},
+		Kind: "Rollout",
+	},
+	Scope:             lsschema.ClusterScoped,
+	Storage:           true,
+	Served:            true,
+	SubresourceStatus: true,
+	AdditionalPrinterColumns: []lsschema.CustomResourceColumnDefinition{
+		{
+			Name:     "Version",
+			Type:     "string",
+			JSONPath: ".spec.version",
+		},
+		{
+			Name:     "Phase",
+			Type:     "string",
+			JSONPath: ".status.phase",
+		},
+		{
+			Name:     "Updated",
+			Type:     "integer",
+			JSONPath: ".status.updatedInstallations",
+		},
+		{
+			Name:     "Total",
+			Type:     "integer",
+			JSONPath: ".status.totalInstallations",
+		},
+		{
+			Name:     "Age",
+			Type:     "date",
+			JSONPath: ".metadata.creationTimestamp",
+		},
+	},
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Rollout updates the component version of a set of root installations in waves.
+// A rollout is cluster-scoped, so that it can update the installations of many namespaces.
+// The next wave is only started if all installations of the previous waves have been processed successfully.
+type Rollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification
+	Spec RolloutSpec `json:"spec"`
+
+	// Status contains the status
+	// +optional
+	Status RolloutStatus `json:"status"`
+}
+
+// RolloutSpec contains the specification for a Rollout.
+type RolloutSpec struct {
+	// NamespaceSelector selects the namespaces whose root installations are updated.
+	// An empty selector selects all namespaces.
+	// +optional
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Selector selects the root installations in the selected namespaces.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// ComponentName is the name of the component that is rolled out.
+	// Only selected installations that reference this component are updated.
+	ComponentName string `json:"componentName"`
+
+	// Version is the version of the component that is rolled out.
+	Version string `json:"version"`
+
+	// Strategy defines how the selected installations are updated.
+	// +optional
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+}
+
+// RolloutStrategy defines how the selected installations of a rollout are updated.
+type RolloutStrategy struct {
+	// WaveSize is the number of installations that are updated in one wave.
+	// It could be an absolute number (e.g. "5") or a percentage of the selected installations (e.g. "10%").
+	// Percentages are rounded up. Defaults to "1".
+	// +optional
+	WaveSize string `json:"waveSize,omitempty"`
+}
+
+// RolloutStatus contains the status of a Rollout.
+type RolloutStatus struct {
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Phase is the current phase of the rollout.
+	// +optional
+	Phase RolloutPhase `json:"phase,omitempty"`
+
+	// Message describes the current state of the rollout.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Wave is the number of waves that have been started so far.
+	// +optional
+	Wave int32 `json:"wave,omitempty"`
+
+	// TotalInstallations is the number of installations that are selected by the rollout.
+	// +optional
+	TotalInstallations int32 `json:"totalInstallations,omitempty"`
+
+	// UpdatedInstallations is the number of selected installations that use the context of the rollout
+	// for the current version.
+	// +optional
+	UpdatedInstallations int32 `json:"updatedInstallations,omitempty"`
+
+	// SucceededInstallations is the number of updated installations that have been processed successfully.
+	// +optional
+	SucceededInstallations int32 `json:"succeededInstallations,omitempty"`
+
+	// FailedInstallations contains the namespaces and names (<namespace>/<name>) of the updated installations that failed.
+	// +optional
+	FailedInstallations []string `json:"failedInstallations,omitempty"`
+
+	// LastUpdateTime is the last time the status was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
@@ -231,6 +231,11 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// PlanOperation is the annotation to let the landscaper render the imports, subinstallations and deploy items
+	// of an installation without applying them. The rendered result and the differences to the currently deployed
+	// objects are stored in the status of the installation.
+	PlanOperation Operation = "plan"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
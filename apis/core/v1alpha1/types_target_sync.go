@@ -66,6 +66,30 @@ type TargetSyncSpec struct {
 	// +optional
 	ShootNameExpression string `json:"shootNameExpression"`
 
+	// ClusterNameExpression defines the names of Cluster API clusters (cluster.x-k8s.io/v1beta1 Cluster) for which
+	// targets are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with
+	// the extension that * is also a valid expression and matches all names.
+	// The kubeconfig of a cluster is read from the secret "<cluster name>-kubeconfig" that is maintained by Cluster API.
+	// if not set no targets for Cluster API clusters are created
+	// +optional
+	ClusterNameExpression string `json:"clusterNameExpression,omitempty"`
+
+	// ConfigMapNameExpression defines the names of the configmaps containing a kubeconfig which should be synced
+	// via a regular expression according to https://github.com/google/re2/wiki/Syntax with
+	// the extension that * is also a valid expression and matches all names.
+	// if not set no configmaps are synced
+	// +optional
+	ConfigMapNameExpression string `json:"configMapNameExpression,omitempty"`
+
+	// ConfigMapKey is the key of the kubeconfig in the synced configmaps. Defaults to "kubeconfig".
+	// +optional
+	ConfigMapKey string `json:"configMapKey,omitempty"`
+
+	// LabelSelector restricts the synced secrets, shoots, Cluster API clusters and configmaps to those with matching
+	// labels. It is applied in addition to the name expressions.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
 	// TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the
 	// secrets to sync. The token expires after 90 days and will be rotated every 60 days.
 	// +optional
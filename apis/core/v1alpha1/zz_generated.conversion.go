@@ -212,6 +212,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CueDefinition)(nil), (*core.CueDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CueDefinition_To_core_CueDefinition(a.(*CueDefinition), b.(*core.CueDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CueDefinition)(nil), (*CueDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CueDefinition_To_v1alpha1_CueDefinition(a.(*core.CueDefinition), b.(*CueDefinition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataExport)(nil), (*core.DataExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataExport_To_core_DataExport(a.(*DataExport), b.(*core.DataExport), scope)
 	}); err != nil {
@@ -282,6 +292,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemApproval)(nil), (*core.DeployItemApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemApproval_To_core_DeployItemApproval(a.(*DeployItemApproval), b.(*core.DeployItemApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemApproval)(nil), (*DeployItemApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemApproval_To_v1alpha1_DeployItemApproval(a.(*core.DeployItemApproval), b.(*DeployItemApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemList)(nil), (*core.DeployItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemList_To_core_DeployItemList(a.(*DeployItemList), b.(*core.DeployItemList), scope)
 	}); err != nil {
@@ -292,6 +312,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemRetryPolicy)(nil), (*core.DeployItemRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemRetryPolicy_To_core_DeployItemRetryPolicy(a.(*DeployItemRetryPolicy), b.(*core.DeployItemRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemRetryPolicy)(nil), (*DeployItemRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemRetryPolicy_To_v1alpha1_DeployItemRetryPolicy(a.(*core.DeployItemRetryPolicy), b.(*DeployItemRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemRetryStatus)(nil), (*core.DeployItemRetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemRetryStatus_To_core_DeployItemRetryStatus(a.(*DeployItemRetryStatus), b.(*core.DeployItemRetryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemRetryStatus)(nil), (*DeployItemRetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemRetryStatus_To_v1alpha1_DeployItemRetryStatus(a.(*core.DeployItemRetryStatus), b.(*DeployItemRetryStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemSpec)(nil), (*core.DeployItemSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemSpec_To_core_DeployItemSpec(a.(*DeployItemSpec), b.(*core.DeployItemSpec), scope)
 	}); err != nil {
@@ -322,6 +362,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemTimeouts)(nil), (*core.DeployItemTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemTimeouts_To_core_DeployItemTimeouts(a.(*DeployItemTimeouts), b.(*core.DeployItemTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemTimeouts)(nil), (*DeployItemTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(a.(*core.DeployItemTimeouts), b.(*DeployItemTimeouts), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployerInformation)(nil), (*core.DeployerInformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployerInformation_To_core_DeployerInformation(a.(*DeployerInformation), b.(*core.DeployerInformation), scope)
 	}); err != nil {
@@ -392,6 +442,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EffectiveDeployItemTimeouts)(nil), (*core.EffectiveDeployItemTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EffectiveDeployItemTimeouts_To_core_EffectiveDeployItemTimeouts(a.(*EffectiveDeployItemTimeouts), b.(*core.EffectiveDeployItemTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EffectiveDeployItemTimeouts)(nil), (*EffectiveDeployItemTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EffectiveDeployItemTimeouts_To_v1alpha1_EffectiveDeployItemTimeouts(a.(*core.EffectiveDeployItemTimeouts), b.(*EffectiveDeployItemTimeouts), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Environment)(nil), (*core.Environment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Environment_To_core_Environment(a.(*Environment), b.(*core.Environment), scope)
 	}); err != nil {
@@ -542,6 +602,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationBlocker)(nil), (*core.InstallationBlocker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationBlocker_To_core_InstallationBlocker(a.(*InstallationBlocker), b.(*core.InstallationBlocker), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationBlocker)(nil), (*InstallationBlocker)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationBlocker_To_v1alpha1_InstallationBlocker(a.(*core.InstallationBlocker), b.(*InstallationBlocker), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationExports)(nil), (*core.InstallationExports)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationExports_To_core_InstallationExports(a.(*InstallationExports), b.(*core.InstallationExports), scope)
 	}); err != nil {
@@ -572,6 +642,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationPlan)(nil), (*core.InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(a.(*InstallationPlan), b.(*core.InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationPlan)(nil), (*InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(a.(*core.InstallationPlan), b.(*InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -662,6 +742,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenancePolicy)(nil), (*core.MaintenancePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenancePolicy_To_core_MaintenancePolicy(a.(*MaintenancePolicy), b.(*core.MaintenancePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenancePolicy)(nil), (*MaintenancePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenancePolicy_To_v1alpha1_MaintenancePolicy(a.(*core.MaintenancePolicy), b.(*MaintenancePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceStatus)(nil), (*core.MaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceStatus_To_core_MaintenanceStatus(a.(*MaintenanceStatus), b.(*core.MaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceStatus)(nil), (*MaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceStatus_To_v1alpha1_MaintenanceStatus(a.(*core.MaintenanceStatus), b.(*MaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*core.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(a.(*MaintenanceWindow), b.(*core.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*core.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedObjectReference)(nil), (*core.NamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(a.(*NamedObjectReference), b.(*core.NamedObjectReference), scope)
 	}); err != nil {
@@ -692,6 +802,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PlannedChange)(nil), (*core.PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedChange_To_core_PlannedChange(a.(*PlannedChange), b.(*core.PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlannedChange)(nil), (*PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlannedChange_To_v1alpha1_PlannedChange(a.(*core.PlannedChange), b.(*PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -732,6 +852,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetryBackoff)(nil), (*core.RetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff(a.(*RetryBackoff), b.(*core.RetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryBackoff)(nil), (*RetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff(a.(*core.RetryBackoff), b.(*RetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetryRule)(nil), (*core.RetryRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryRule_To_core_RetryRule(a.(*RetryRule), b.(*core.RetryRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryRule)(nil), (*RetryRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryRule_To_v1alpha1_RetryRule(a.(*core.RetryRule), b.(*RetryRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Rollout)(nil), (*core.Rollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Rollout_To_core_Rollout(a.(*Rollout), b.(*core.Rollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Rollout)(nil), (*Rollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Rollout_To_v1alpha1_Rollout(a.(*core.Rollout), b.(*Rollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutList)(nil), (*core.RolloutList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutList_To_core_RolloutList(a.(*RolloutList), b.(*core.RolloutList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutList)(nil), (*RolloutList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutList_To_v1alpha1_RolloutList(a.(*core.RolloutList), b.(*RolloutList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutSpec)(nil), (*core.RolloutSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutSpec_To_core_RolloutSpec(a.(*RolloutSpec), b.(*core.RolloutSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutSpec)(nil), (*RolloutSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutSpec_To_v1alpha1_RolloutSpec(a.(*core.RolloutSpec), b.(*RolloutSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStatus)(nil), (*core.RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(a.(*RolloutStatus), b.(*core.RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStatus)(nil), (*RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(a.(*core.RolloutStatus), b.(*RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStrategy)(nil), (*core.RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(a.(*RolloutStrategy), b.(*core.RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutStrategy)(nil), (*RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(a.(*core.RolloutStrategy), b.(*RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -1084,6 +1274,7 @@ func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.B
 	out.SubinstallationExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
 	out.ExportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	return nil
 }
 
@@ -1103,6 +1294,7 @@ func autoConvert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *B
 	out.SubinstallationExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
 	out.ExportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	return nil
 }
 
@@ -1415,6 +1607,9 @@ func autoConvert_v1alpha1_Context_To_core_Context(in *Context, out *core.Context
 	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.MaintenancePolicy = (*core.MaintenancePolicy)(unsafe.Pointer(in.MaintenancePolicy))
+	out.DeployItemTimeouts = (*core.DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	return nil
 }
 
@@ -1429,6 +1624,9 @@ func autoConvert_core_Context_To_v1alpha1_Context(in *core.Context, out *Context
 	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.MaintenancePolicy = (*MaintenancePolicy)(unsafe.Pointer(in.MaintenancePolicy))
+	out.DeployItemTimeouts = (*DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	return nil
 }
 
@@ -1459,6 +1657,30 @@ func Convert_core_ContextList_To_v1alpha1_ContextList(in *core.ContextList, out
 	return autoConvert_core_ContextList_To_v1alpha1_ContextList(in, out, s)
 }
 
+func autoConvert_v1alpha1_CueDefinition_To_core_CueDefinition(in *CueDefinition, out *core.CueDefinition, s conversion.Scope) error {
+	out.Definition = in.Definition
+	out.Ref = in.Ref
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_CueDefinition_To_core_CueDefinition is an autogenerated conversion function.
+func Convert_v1alpha1_CueDefinition_To_core_CueDefinition(in *CueDefinition, out *core.CueDefinition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CueDefinition_To_core_CueDefinition(in, out, s)
+}
+
+func autoConvert_core_CueDefinition_To_v1alpha1_CueDefinition(in *core.CueDefinition, out *CueDefinition, s conversion.Scope) error {
+	out.Definition = in.Definition
+	out.Ref = in.Ref
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_core_CueDefinition_To_v1alpha1_CueDefinition is an autogenerated conversion function.
+func Convert_core_CueDefinition_To_v1alpha1_CueDefinition(in *core.CueDefinition, out *CueDefinition, s conversion.Scope) error {
+	return autoConvert_core_CueDefinition_To_v1alpha1_CueDefinition(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataExport_To_core_DataExport(in *DataExport, out *core.DataExport, s conversion.Scope) error {
 	out.Name = in.Name
 	out.DataRef = in.DataRef
@@ -1633,6 +1855,32 @@ func Convert_core_DeployItem_To_v1alpha1_DeployItem(in *core.DeployItem, out *De
 	return autoConvert_core_DeployItem_To_v1alpha1_DeployItem(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemApproval_To_core_DeployItemApproval(in *DeployItemApproval, out *core.DeployItemApproval, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = in.ApprovalTime
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemApproval_To_core_DeployItemApproval is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemApproval_To_core_DeployItemApproval(in *DeployItemApproval, out *core.DeployItemApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemApproval_To_core_DeployItemApproval(in, out, s)
+}
+
+func autoConvert_core_DeployItemApproval_To_v1alpha1_DeployItemApproval(in *core.DeployItemApproval, out *DeployItemApproval, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = in.ApprovalTime
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_core_DeployItemApproval_To_v1alpha1_DeployItemApproval is an autogenerated conversion function.
+func Convert_core_DeployItemApproval_To_v1alpha1_DeployItemApproval(in *core.DeployItemApproval, out *DeployItemApproval, s conversion.Scope) error {
+	return autoConvert_core_DeployItemApproval_To_v1alpha1_DeployItemApproval(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemList_To_core_DeployItemList(in *DeployItemList, out *core.DeployItemList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.DeployItem)(unsafe.Pointer(&in.Items))
@@ -1655,6 +1903,58 @@ func Convert_core_DeployItemList_To_v1alpha1_DeployItemList(in *core.DeployItemL
 	return autoConvert_core_DeployItemList_To_v1alpha1_DeployItemList(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemRetryPolicy_To_core_DeployItemRetryPolicy(in *DeployItemRetryPolicy, out *core.DeployItemRetryPolicy, s conversion.Scope) error {
+	out.NumberOfRetries = (*int)(unsafe.Pointer(in.NumberOfRetries))
+	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*core.RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.Rules = *(*[]core.RetryRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemRetryPolicy_To_core_DeployItemRetryPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemRetryPolicy_To_core_DeployItemRetryPolicy(in *DeployItemRetryPolicy, out *core.DeployItemRetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemRetryPolicy_To_core_DeployItemRetryPolicy(in, out, s)
+}
+
+func autoConvert_core_DeployItemRetryPolicy_To_v1alpha1_DeployItemRetryPolicy(in *core.DeployItemRetryPolicy, out *DeployItemRetryPolicy, s conversion.Scope) error {
+	out.NumberOfRetries = (*int)(unsafe.Pointer(in.NumberOfRetries))
+	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.Rules = *(*[]RetryRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_core_DeployItemRetryPolicy_To_v1alpha1_DeployItemRetryPolicy is an autogenerated conversion function.
+func Convert_core_DeployItemRetryPolicy_To_v1alpha1_DeployItemRetryPolicy(in *core.DeployItemRetryPolicy, out *DeployItemRetryPolicy, s conversion.Scope) error {
+	return autoConvert_core_DeployItemRetryPolicy_To_v1alpha1_DeployItemRetryPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeployItemRetryStatus_To_core_DeployItemRetryStatus(in *DeployItemRetryStatus, out *core.DeployItemRetryStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.JobID = in.JobID
+	out.NumberOfRetries = in.NumberOfRetries
+	out.LastRetryTime = in.LastRetryTime
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemRetryStatus_To_core_DeployItemRetryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemRetryStatus_To_core_DeployItemRetryStatus(in *DeployItemRetryStatus, out *core.DeployItemRetryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemRetryStatus_To_core_DeployItemRetryStatus(in, out, s)
+}
+
+func autoConvert_core_DeployItemRetryStatus_To_v1alpha1_DeployItemRetryStatus(in *core.DeployItemRetryStatus, out *DeployItemRetryStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.JobID = in.JobID
+	out.NumberOfRetries = in.NumberOfRetries
+	out.LastRetryTime = in.LastRetryTime
+	return nil
+}
+
+// Convert_core_DeployItemRetryStatus_To_v1alpha1_DeployItemRetryStatus is an autogenerated conversion function.
+func Convert_core_DeployItemRetryStatus_To_v1alpha1_DeployItemRetryStatus(in *core.DeployItemRetryStatus, out *DeployItemRetryStatus, s conversion.Scope) error {
+	return autoConvert_core_DeployItemRetryStatus_To_v1alpha1_DeployItemRetryStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemSpec_To_core_DeployItemSpec(in *DeployItemSpec, out *core.DeployItemSpec, s conversion.Scope) error {
 	out.Type = core.DeployItemType(in.Type)
 	out.Target = (*core.ObjectReference)(unsafe.Pointer(in.Target))
@@ -1705,6 +2005,7 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.EffectiveTimeouts = (*core.EffectiveDeployItemTimeouts)(unsafe.Pointer(in.EffectiveTimeouts))
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	return nil
 }
@@ -1730,6 +2031,7 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.EffectiveTimeouts = (*EffectiveDeployItemTimeouts)(unsafe.Pointer(in.EffectiveTimeouts))
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	return nil
 }
@@ -1749,6 +2051,8 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.RequiresApproval = in.RequiresApproval
+	out.Retry = (*core.DeployItemRetryPolicy)(unsafe.Pointer(in.Retry))
 	return nil
 }
 
@@ -1767,6 +2071,8 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.RequiresApproval = in.RequiresApproval
+	out.Retry = (*DeployItemRetryPolicy)(unsafe.Pointer(in.Retry))
 	return nil
 }
 
@@ -1775,6 +2081,28 @@ func Convert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core.Dep
 	return autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemTimeouts_To_core_DeployItemTimeouts(in *DeployItemTimeouts, out *core.DeployItemTimeouts, s conversion.Scope) error {
+	out.Pickup = (*core.Duration)(unsafe.Pointer(in.Pickup))
+	out.ProgressingDefault = (*core.Duration)(unsafe.Pointer(in.ProgressingDefault))
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemTimeouts_To_core_DeployItemTimeouts is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemTimeouts_To_core_DeployItemTimeouts(in *DeployItemTimeouts, out *core.DeployItemTimeouts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemTimeouts_To_core_DeployItemTimeouts(in, out, s)
+}
+
+func autoConvert_core_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(in *core.DeployItemTimeouts, out *DeployItemTimeouts, s conversion.Scope) error {
+	out.Pickup = (*Duration)(unsafe.Pointer(in.Pickup))
+	out.ProgressingDefault = (*Duration)(unsafe.Pointer(in.ProgressingDefault))
+	return nil
+}
+
+// Convert_core_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts is an autogenerated conversion function.
+func Convert_core_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(in *core.DeployItemTimeouts, out *DeployItemTimeouts, s conversion.Scope) error {
+	return autoConvert_core_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployerInformation_To_core_DeployerInformation(in *DeployerInformation, out *core.DeployerInformation, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.Name = in.Name
@@ -1953,6 +2281,28 @@ func Convert_core_Duration_To_v1alpha1_Duration(in *core.Duration, out *Duration
 	return autoConvert_core_Duration_To_v1alpha1_Duration(in, out, s)
 }
 
+func autoConvert_v1alpha1_EffectiveDeployItemTimeouts_To_core_EffectiveDeployItemTimeouts(in *EffectiveDeployItemTimeouts, out *core.EffectiveDeployItemTimeouts, s conversion.Scope) error {
+	out.Pickup = (*core.Duration)(unsafe.Pointer(in.Pickup))
+	out.Progressing = (*core.Duration)(unsafe.Pointer(in.Progressing))
+	return nil
+}
+
+// Convert_v1alpha1_EffectiveDeployItemTimeouts_To_core_EffectiveDeployItemTimeouts is an autogenerated conversion function.
+func Convert_v1alpha1_EffectiveDeployItemTimeouts_To_core_EffectiveDeployItemTimeouts(in *EffectiveDeployItemTimeouts, out *core.EffectiveDeployItemTimeouts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EffectiveDeployItemTimeouts_To_core_EffectiveDeployItemTimeouts(in, out, s)
+}
+
+func autoConvert_core_EffectiveDeployItemTimeouts_To_v1alpha1_EffectiveDeployItemTimeouts(in *core.EffectiveDeployItemTimeouts, out *EffectiveDeployItemTimeouts, s conversion.Scope) error {
+	out.Pickup = (*Duration)(unsafe.Pointer(in.Pickup))
+	out.Progressing = (*Duration)(unsafe.Pointer(in.Progressing))
+	return nil
+}
+
+// Convert_core_EffectiveDeployItemTimeouts_To_v1alpha1_EffectiveDeployItemTimeouts is an autogenerated conversion function.
+func Convert_core_EffectiveDeployItemTimeouts_To_v1alpha1_EffectiveDeployItemTimeouts(in *core.EffectiveDeployItemTimeouts, out *EffectiveDeployItemTimeouts, s conversion.Scope) error {
+	return autoConvert_core_EffectiveDeployItemTimeouts_To_v1alpha1_EffectiveDeployItemTimeouts(in, out, s)
+}
+
 func autoConvert_v1alpha1_Environment_To_core_Environment(in *Environment, out *core.Environment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_EnvironmentSpec_To_core_EnvironmentSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2165,6 +2515,7 @@ func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec,
 	out.Context = in.Context
 	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	out.RegistryPullSecrets = *(*[]core.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	return nil
 }
@@ -2173,6 +2524,7 @@ func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.Execution
 	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
 	out.RegistryPullSecrets = *(*[]ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	return nil
 }
@@ -2186,8 +2538,11 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.ExecutionGenerations = *(*[]core.ExecutionGeneration)(unsafe.Pointer(&in.ExecutionGenerations))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.ExecutionPhase = core.ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
+	out.Approvals = *(*[]core.DeployItemApproval)(unsafe.Pointer(&in.Approvals))
+	out.Retries = *(*[]core.DeployItemRetryStatus)(unsafe.Pointer(&in.Retries))
 	return nil
 }
 
@@ -2205,8 +2560,11 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.ExecutionGenerations = *(*[]ExecutionGeneration)(unsafe.Pointer(&in.ExecutionGenerations))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.ExecutionPhase = ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
+	out.Approvals = *(*[]DeployItemApproval)(unsafe.Pointer(&in.Approvals))
+	out.Retries = *(*[]DeployItemRetryStatus)(unsafe.Pointer(&in.Retries))
 	return nil
 }
 
@@ -2244,6 +2602,8 @@ func Convert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.ExportD
 func autoConvert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcile, out *core.FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*core.RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.Rules = *(*[]core.RetryRule)(unsafe.Pointer(&in.Rules))
 	return nil
 }
 
@@ -2255,6 +2615,8 @@ func Convert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcil
 func autoConvert_core_FailedReconcile_To_v1alpha1_FailedReconcile(in *core.FailedReconcile, out *FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.Rules = *(*[]RetryRule)(unsafe.Pointer(&in.Rules))
 	return nil
 }
 
@@ -2266,6 +2628,7 @@ func Convert_core_FailedReconcile_To_v1alpha1_FailedReconcile(in *core.FailedRec
 func autoConvert_v1alpha1_FieldValueDefinition_To_core_FieldValueDefinition(in *FieldValueDefinition, out *core.FieldValueDefinition, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Schema = (*core.JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
+	out.Cue = (*core.CueDefinition)(unsafe.Pointer(in.Cue))
 	out.TargetType = in.TargetType
 	return nil
 }
@@ -2278,6 +2641,7 @@ func Convert_v1alpha1_FieldValueDefinition_To_core_FieldValueDefinition(in *Fiel
 func autoConvert_core_FieldValueDefinition_To_v1alpha1_FieldValueDefinition(in *core.FieldValueDefinition, out *FieldValueDefinition, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Schema = (*JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
+	out.Cue = (*CueDefinition)(unsafe.Pointer(in.Cue))
 	out.TargetType = in.TargetType
 	return nil
 }
@@ -2415,6 +2779,34 @@ func Convert_core_Installation_To_v1alpha1_Installation(in *core.Installation, o
 	return autoConvert_core_Installation_To_v1alpha1_Installation(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationBlocker_To_core_InstallationBlocker(in *InstallationBlocker, out *core.InstallationBlocker, s conversion.Scope) error {
+	out.Kind = core.InstallationBlockerKind(in.Kind)
+	out.Name = in.Name
+	out.Import = in.Import
+	out.Reason = core.InstallationBlockerReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_InstallationBlocker_To_core_InstallationBlocker is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationBlocker_To_core_InstallationBlocker(in *InstallationBlocker, out *core.InstallationBlocker, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationBlocker_To_core_InstallationBlocker(in, out, s)
+}
+
+func autoConvert_core_InstallationBlocker_To_v1alpha1_InstallationBlocker(in *core.InstallationBlocker, out *InstallationBlocker, s conversion.Scope) error {
+	out.Kind = InstallationBlockerKind(in.Kind)
+	out.Name = in.Name
+	out.Import = in.Import
+	out.Reason = InstallationBlockerReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_InstallationBlocker_To_v1alpha1_InstallationBlocker is an autogenerated conversion function.
+func Convert_core_InstallationBlocker_To_v1alpha1_InstallationBlocker(in *core.InstallationBlocker, out *InstallationBlocker, s conversion.Scope) error {
+	return autoConvert_core_InstallationBlocker_To_v1alpha1_InstallationBlocker(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationExports_To_core_InstallationExports(in *InstallationExports, out *core.InstallationExports, s conversion.Scope) error {
 	out.Data = *(*[]core.DataExport)(unsafe.Pointer(&in.Data))
 	out.Targets = *(*[]core.TargetExport)(unsafe.Pointer(&in.Targets))
@@ -2481,6 +2873,36 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	out.PlanTime = in.PlanTime
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Error = (*core.Error)(unsafe.Pointer(in.Error))
+	out.Subinstallations = *(*[]core.PlannedChange)(unsafe.Pointer(&in.Subinstallations))
+	out.DeployItems = *(*[]core.PlannedChange)(unsafe.Pointer(&in.DeployItems))
+	out.ResultReference = (*core.ObjectReference)(unsafe.Pointer(in.ResultReference))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in, out, s)
+}
+
+func autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	out.PlanTime = in.PlanTime
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Error = (*Error)(unsafe.Pointer(in.Error))
+	out.Subinstallations = *(*[]PlannedChange)(unsafe.Pointer(&in.Subinstallations))
+	out.DeployItems = *(*[]PlannedChange)(unsafe.Pointer(&in.DeployItems))
+	out.ResultReference = (*ObjectReference)(unsafe.Pointer(in.ResultReference))
+	return nil
+}
+
+// Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan is an autogenerated conversion function.
+func Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	return autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.ComponentDescriptor = (*core.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -2497,6 +2919,7 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.MaintenancePolicy = (*core.MaintenancePolicy)(unsafe.Pointer(in.MaintenancePolicy))
 	return nil
 }
 
@@ -2521,6 +2944,7 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.MaintenancePolicy = (*MaintenancePolicy)(unsafe.Pointer(in.MaintenancePolicy))
 	return nil
 }
 
@@ -2539,11 +2963,15 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.ExecutionReference = (*core.ObjectReference)(unsafe.Pointer(in.ExecutionReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.InstallationPhase = core.InstallationPhase(in.InstallationPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
+	out.Plan = (*core.InstallationPlan)(unsafe.Pointer(in.Plan))
+	out.MaintenanceStatus = (*core.MaintenanceStatus)(unsafe.Pointer(in.MaintenanceStatus))
+	out.BlockedBy = *(*[]core.InstallationBlocker)(unsafe.Pointer(&in.BlockedBy))
 	return nil
 }
 
@@ -2562,11 +2990,15 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.ExecutionReference = (*ObjectReference)(unsafe.Pointer(in.ExecutionReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
+	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.InstallationPhase = InstallationPhase(in.InstallationPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
+	out.Plan = (*InstallationPlan)(unsafe.Pointer(in.Plan))
+	out.MaintenanceStatus = (*MaintenanceStatus)(unsafe.Pointer(in.MaintenanceStatus))
+	out.BlockedBy = *(*[]InstallationBlocker)(unsafe.Pointer(&in.BlockedBy))
 	return nil
 }
 
@@ -2755,6 +3187,80 @@ func Convert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in *core.LsHea
 	return autoConvert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenancePolicy_To_core_MaintenancePolicy(in *MaintenancePolicy, out *core.MaintenancePolicy, s conversion.Scope) error {
+	out.Paused = in.Paused
+	out.Windows = *(*[]core.MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenancePolicy_To_core_MaintenancePolicy is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenancePolicy_To_core_MaintenancePolicy(in *MaintenancePolicy, out *core.MaintenancePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenancePolicy_To_core_MaintenancePolicy(in, out, s)
+}
+
+func autoConvert_core_MaintenancePolicy_To_v1alpha1_MaintenancePolicy(in *core.MaintenancePolicy, out *MaintenancePolicy, s conversion.Scope) error {
+	out.Paused = in.Paused
+	out.Windows = *(*[]MaintenanceWindow)(unsafe.Pointer(&in.Windows))
+	return nil
+}
+
+// Convert_core_MaintenancePolicy_To_v1alpha1_MaintenancePolicy is an autogenerated conversion function.
+func Convert_core_MaintenancePolicy_To_v1alpha1_MaintenancePolicy(in *core.MaintenancePolicy, out *MaintenancePolicy, s conversion.Scope) error {
+	return autoConvert_core_MaintenancePolicy_To_v1alpha1_MaintenancePolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceStatus_To_core_MaintenanceStatus(in *MaintenanceStatus, out *core.MaintenanceStatus, s conversion.Scope) error {
+	out.Reason = core.MaintenanceReason(in.Reason)
+	out.Message = in.Message
+	out.PendingSince = in.PendingSince
+	out.NextWindowStart = (*metav1.Time)(unsafe.Pointer(in.NextWindowStart))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceStatus_To_core_MaintenanceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceStatus_To_core_MaintenanceStatus(in *MaintenanceStatus, out *core.MaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceStatus_To_core_MaintenanceStatus(in, out, s)
+}
+
+func autoConvert_core_MaintenanceStatus_To_v1alpha1_MaintenanceStatus(in *core.MaintenanceStatus, out *MaintenanceStatus, s conversion.Scope) error {
+	out.Reason = MaintenanceReason(in.Reason)
+	out.Message = in.Message
+	out.PendingSince = in.PendingSince
+	out.NextWindowStart = (*metav1.Time)(unsafe.Pointer(in.NextWindowStart))
+	return nil
+}
+
+// Convert_core_MaintenanceStatus_To_v1alpha1_MaintenanceStatus is an autogenerated conversion function.
+func Convert_core_MaintenanceStatus_To_v1alpha1_MaintenanceStatus(in *core.MaintenanceStatus, out *MaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceStatus_To_v1alpha1_MaintenanceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	if err := Convert_v1alpha1_Duration_To_core_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	if err := Convert_core_Duration_To_v1alpha1_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(in *NamedObjectReference, out *core.NamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -2823,6 +3329,30 @@ func Convert_core_OnDeleteConfig_To_v1alpha1_OnDeleteConfig(in *core.OnDeleteCon
 	return autoConvert_core_OnDeleteConfig_To_v1alpha1_OnDeleteConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_PlannedChange_To_core_PlannedChange(in *PlannedChange, out *core.PlannedChange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = core.PlannedChangeAction(in.Action)
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	return nil
+}
+
+// Convert_v1alpha1_PlannedChange_To_core_PlannedChange is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedChange_To_core_PlannedChange(in *PlannedChange, out *core.PlannedChange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedChange_To_core_PlannedChange(in, out, s)
+}
+
+func autoConvert_core_PlannedChange_To_v1alpha1_PlannedChange(in *core.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = PlannedChangeAction(in.Action)
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	return nil
+}
+
+// Convert_core_PlannedChange_To_v1alpha1_PlannedChange is an autogenerated conversion function.
+func Convert_core_PlannedChange_To_v1alpha1_PlannedChange(in *core.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	return autoConvert_core_PlannedChange_To_v1alpha1_PlannedChange(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -2911,6 +3441,196 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in *RetryBackoff, out *core.RetryBackoff, s conversion.Scope) error {
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	out.MaxInterval = (*core.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.JitterPercent = (*int32)(unsafe.Pointer(in.JitterPercent))
+	return nil
+}
+
+// Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff is an autogenerated conversion function.
+func Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in *RetryBackoff, out *core.RetryBackoff, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in, out, s)
+}
+
+func autoConvert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in *core.RetryBackoff, out *RetryBackoff, s conversion.Scope) error {
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	out.MaxInterval = (*Duration)(unsafe.Pointer(in.MaxInterval))
+	out.JitterPercent = (*int32)(unsafe.Pointer(in.JitterPercent))
+	return nil
+}
+
+// Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff is an autogenerated conversion function.
+func Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in *core.RetryBackoff, out *RetryBackoff, s conversion.Scope) error {
+	return autoConvert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in, out, s)
+}
+
+func autoConvert_v1alpha1_RetryRule_To_core_RetryRule(in *RetryRule, out *core.RetryRule, s conversion.Scope) error {
+	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	out.Action = core.RetryAction(in.Action)
+	return nil
+}
+
+// Convert_v1alpha1_RetryRule_To_core_RetryRule is an autogenerated conversion function.
+func Convert_v1alpha1_RetryRule_To_core_RetryRule(in *RetryRule, out *core.RetryRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryRule_To_core_RetryRule(in, out, s)
+}
+
+func autoConvert_core_RetryRule_To_v1alpha1_RetryRule(in *core.RetryRule, out *RetryRule, s conversion.Scope) error {
+	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	out.Action = RetryAction(in.Action)
+	return nil
+}
+
+// Convert_core_RetryRule_To_v1alpha1_RetryRule is an autogenerated conversion function.
+func Convert_core_RetryRule_To_v1alpha1_RetryRule(in *core.RetryRule, out *RetryRule, s conversion.Scope) error {
+	return autoConvert_core_RetryRule_To_v1alpha1_RetryRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_Rollout_To_core_Rollout(in *Rollout, out *core.Rollout, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RolloutSpec_To_core_RolloutSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Rollout_To_core_Rollout is an autogenerated conversion function.
+func Convert_v1alpha1_Rollout_To_core_Rollout(in *Rollout, out *core.Rollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Rollout_To_core_Rollout(in, out, s)
+}
+
+func autoConvert_core_Rollout_To_v1alpha1_Rollout(in *core.Rollout, out *Rollout, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_RolloutSpec_To_v1alpha1_RolloutSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_Rollout_To_v1alpha1_Rollout is an autogenerated conversion function.
+func Convert_core_Rollout_To_v1alpha1_Rollout(in *core.Rollout, out *Rollout, s conversion.Scope) error {
+	return autoConvert_core_Rollout_To_v1alpha1_Rollout(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutList_To_core_RolloutList(in *RolloutList, out *core.RolloutList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.Rollout)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutList_To_core_RolloutList is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutList_To_core_RolloutList(in *RolloutList, out *core.RolloutList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutList_To_core_RolloutList(in, out, s)
+}
+
+func autoConvert_core_RolloutList_To_v1alpha1_RolloutList(in *core.RolloutList, out *RolloutList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Rollout)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_RolloutList_To_v1alpha1_RolloutList is an autogenerated conversion function.
+func Convert_core_RolloutList_To_v1alpha1_RolloutList(in *core.RolloutList, out *RolloutList, s conversion.Scope) error {
+	return autoConvert_core_RolloutList_To_v1alpha1_RolloutList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutSpec_To_core_RolloutSpec(in *RolloutSpec, out *core.RolloutSpec, s conversion.Scope) error {
+	out.NamespaceSelector = in.NamespaceSelector
+	out.Selector = in.Selector
+	out.ComponentName = in.ComponentName
+	out.Version = in.Version
+	if err := Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RolloutSpec_To_core_RolloutSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutSpec_To_core_RolloutSpec(in *RolloutSpec, out *core.RolloutSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutSpec_To_core_RolloutSpec(in, out, s)
+}
+
+func autoConvert_core_RolloutSpec_To_v1alpha1_RolloutSpec(in *core.RolloutSpec, out *RolloutSpec, s conversion.Scope) error {
+	out.NamespaceSelector = in.NamespaceSelector
+	out.Selector = in.Selector
+	out.ComponentName = in.ComponentName
+	out.Version = in.Version
+	if err := Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_RolloutSpec_To_v1alpha1_RolloutSpec is an autogenerated conversion function.
+func Convert_core_RolloutSpec_To_v1alpha1_RolloutSpec(in *core.RolloutSpec, out *RolloutSpec, s conversion.Scope) error {
+	return autoConvert_core_RolloutSpec_To_v1alpha1_RolloutSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = core.RolloutPhase(in.Phase)
+	out.Message = in.Message
+	out.Wave = in.Wave
+	out.TotalInstallations = in.TotalInstallations
+	out.UpdatedInstallations = in.UpdatedInstallations
+	out.SucceededInstallations = in.SucceededInstallations
+	out.FailedInstallations = *(*[]string)(unsafe.Pointer(&in.FailedInstallations))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in *RolloutStatus, out *core.RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStatus_To_core_RolloutStatus(in, out, s)
+}
+
+func autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = RolloutPhase(in.Phase)
+	out.Message = in.Message
+	out.Wave = in.Wave
+	out.TotalInstallations = in.TotalInstallations
+	out.UpdatedInstallations = in.UpdatedInstallations
+	out.SucceededInstallations = in.SucceededInstallations
+	out.FailedInstallations = *(*[]string)(unsafe.Pointer(&in.FailedInstallations))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus is an autogenerated conversion function.
+func Convert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in *core.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	return autoConvert_core_RolloutStatus_To_v1alpha1_RolloutStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(in *RolloutStrategy, out *core.RolloutStrategy, s conversion.Scope) error {
+	out.WaveSize = in.WaveSize
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(in *RolloutStrategy, out *core.RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStrategy_To_core_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *core.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	out.WaveSize = in.WaveSize
+	return nil
+}
+
+// Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy is an autogenerated conversion function.
+func Convert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *core.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_core_RolloutStrategy_To_v1alpha1_RolloutStrategy(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
@@ -3306,6 +4026,10 @@ func autoConvert_v1alpha1_TargetSyncSpec_To_core_TargetSyncSpec(in *TargetSyncSp
 	out.TargetToSourceName = in.TargetToSourceName
 	out.SecretNameExpression = in.SecretNameExpression
 	out.ShootNameExpression = in.ShootNameExpression
+	out.ClusterNameExpression = in.ClusterNameExpression
+	out.ConfigMapNameExpression = in.ConfigMapNameExpression
+	out.ConfigMapKey = in.ConfigMapKey
+	out.LabelSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
 	out.TokenRotation = (*core.TokenRotation)(unsafe.Pointer(in.TokenRotation))
 	return nil
 }
@@ -3324,6 +4048,10 @@ func autoConvert_core_TargetSyncSpec_To_v1alpha1_TargetSyncSpec(in *core.TargetS
 	out.TargetToSourceName = in.TargetToSourceName
 	out.SecretNameExpression = in.SecretNameExpression
 	out.ShootNameExpression = in.ShootNameExpression
+	out.ClusterNameExpression = in.ClusterNameExpression
+	out.ConfigMapNameExpression = in.ConfigMapNameExpression
+	out.ConfigMapKey = in.ConfigMapKey
+	out.LabelSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
 	out.TokenRotation = (*TokenRotation)(unsafe.Pointer(in.TokenRotation))
 	return nil
 }
@@ -38,6 +38,7 @@ func ValidateBlueprint(blueprint *core.Blueprint) field.ErrorList {
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("exportExecutions"), blueprint.ExportExecutions)...)
 	allErrs = append(allErrs, ValidateSubinstallations(field.NewPath("subinstallations"), blueprint.Subinstallations)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("subinstallationExecutions"), blueprint.SubinstallationExecutions)...)
+	allErrs = append(allErrs, ValidateMaxParallelDeployItems(field.NewPath("maxParallelDeployItems"), blueprint.MaxParallelDeployItems)...)
 	return allErrs
 }
 
@@ -81,11 +82,12 @@ func validateBlueprintImportDefinitions(fldPath *field.Path, imports []core.Impo
 			}
 		} else {
 			// type is not specified, fallback to validation based on specified fields
-			if importDef.Schema == nil && len(importDef.TargetType) == 0 {
-				allErrs = append(allErrs, field.Required(defPath, "either schema or targetType must not be empty"))
+			hasSchema := importDef.Schema != nil || importDef.Cue != nil
+			if !hasSchema && len(importDef.TargetType) == 0 {
+				allErrs = append(allErrs, field.Required(defPath, "either schema, cue or targetType must not be empty"))
 			}
-			if importDef.Schema != nil && len(importDef.TargetType) != 0 {
-				allErrs = append(allErrs, field.Invalid(defPath, importDef, "either schema or targetType must be specified, not both"))
+			if hasSchema && len(importDef.TargetType) != 0 {
+				allErrs = append(allErrs, field.Invalid(defPath, importDef, "either schema/cue or targetType must be specified, not both"))
 			}
 		}
 
@@ -152,41 +154,63 @@ func validateMutuallyExclusiveConfig(fldPath *field.Path, def interface{}, expec
 	allErrs := field.ErrorList{}
 	val := reflect.ValueOf(def)
 	for key := range relevantConfigFields {
-		var f reflect.Value
-		if _, ok := isFieldValueDefinition[key]; ok {
-			f = val.FieldByName("FieldValueDefinition")
-		} else {
-			f = val
-		}
-		f = f.FieldByName(key)
-		if !f.IsValid() {
+		set, ok := isConfigSet(val, key)
+		if !ok {
 			// definition doesn't have this field
 			// this can happen because import and export definitions support different fields
 			continue
 		}
-		kind := f.Kind()
-		expected := false
-		for _, exp := range expectedConfigs {
-			if key == exp {
-				expected = true
-				break
-			}
-		}
-		if expected {
-			if ((kind == reflect.Ptr || kind == reflect.Slice || kind == reflect.Map || kind == reflect.Interface) && f.IsNil()) || f.IsZero() {
+		if stringContains(expectedConfigs, key) {
+			if !set && !isAlternativeConfigSet(val, key) {
 				// field should be set but is empty
-				allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("%s must not be empty for type %s", key, defType)))
-			}
-		} else {
-			if ((kind == reflect.Ptr || kind == reflect.Slice || kind == reflect.Map || kind == reflect.Interface) && !f.IsNil()) || !f.IsZero() {
-				// field should not be set but it is
-				allErrs = append(allErrs, field.Invalid(fldPath, def, fmt.Sprintf("unexpected config '%s', only [%s] should be set", key, strings.Join(expectedConfigs, ", "))))
+				allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("%s must not be empty for type %s", strings.Join(append([]string{key}, alternativeConfigs[key]...), " or "), defType)))
 			}
+		} else if set && !isExpectedAlternativeConfig(expectedConfigs, key) {
+			// field should not be set but it is
+			allErrs = append(allErrs, field.Invalid(fldPath, def, fmt.Sprintf("unexpected config '%s', only [%s] should be set", key, strings.Join(expectedConfigs, ", "))))
 		}
 	}
 	return allErrs
 }
 
+// isConfigSet checks whether the config with the given key is set in the import/export definition.
+// The second return value is false if the definition doesn't have the config.
+func isConfigSet(def reflect.Value, key string) (bool, bool) {
+	f := def
+	if _, ok := isFieldValueDefinition[key]; ok {
+		f = def.FieldByName("FieldValueDefinition")
+	}
+	f = f.FieldByName(key)
+	if !f.IsValid() {
+		return false, false
+	}
+	kind := f.Kind()
+	if kind == reflect.Ptr || kind == reflect.Slice || kind == reflect.Map || kind == reflect.Interface {
+		return !f.IsNil(), true
+	}
+	return !f.IsZero(), true
+}
+
+// isAlternativeConfigSet checks whether one of the alternatives of the given config is set.
+func isAlternativeConfigSet(def reflect.Value, key string) bool {
+	for _, alt := range alternativeConfigs[key] {
+		if set, _ := isConfigSet(def, alt); set {
+			return true
+		}
+	}
+	return false
+}
+
+// isExpectedAlternativeConfig checks whether the given config is an alternative of one of the expected configs.
+func isExpectedAlternativeConfig(expectedConfigs []string, key string) bool {
+	for _, exp := range expectedConfigs {
+		if stringContains(alternativeConfigs[exp], key) {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateFieldValueDefinition validates a field value definition
 func ValidateFieldValueDefinition(fldPath *field.Path, def core.FieldValueDefinition) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -196,6 +220,9 @@ func ValidateFieldValueDefinition(fldPath *field.Path, def core.FieldValueDefini
 	if def.Schema != nil {
 		allErrs = append(allErrs, ValidateJsonSchema(fldPath, def.Schema)...)
 	}
+	if def.Cue != nil {
+		allErrs = append(allErrs, ValidateCueDefinition(fldPath.Child("cue"), def.Cue)...)
+	}
 
 	return allErrs
 }
@@ -206,6 +233,15 @@ func ValidateJsonSchema(fldPath *field.Path, schema *core.JSONSchemaDefinition)
 	return allErrs
 }
 
+// ValidateCueDefinition validates a CUE definition
+func ValidateCueDefinition(fldPath *field.Path, def *core.CueDefinition) field.ErrorList {
+	allErrs := ValidateExactlyOneOf(fldPath, def, "Definition", "Ref")
+	if len(def.Ref) != 0 && !strings.HasPrefix(def.Ref, "blueprint://") && !strings.HasPrefix(def.Ref, "cd://") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ref"), def.Ref, "only references with the \"blueprint\" or \"cd\" scheme are supported"))
+	}
+	return allErrs
+}
+
 // ValidateTemplateExecutorList validates a list of template executors
 func ValidateTemplateExecutorList(fldPath *field.Path, list []core.TemplateExecutor) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -278,7 +314,7 @@ func ValidateInstallationTemplates(fldPath *field.Path, blueprintImportDefs []co
 			}
 		} else {
 			// fallback to old logic
-			if bImport.Schema != nil {
+			if bImport.Schema != nil || bImport.Cue != nil {
 				blueprintDataImports.Insert(bImport.Name)
 			} else if len(bImport.TargetType) != 0 {
 				blueprintTargetImports.Insert(bImport.Name)
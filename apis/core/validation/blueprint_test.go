@@ -165,6 +165,59 @@ var _ = Describe("Blueprint", func() {
 				"Detail": Equal("conditional imports on required import"),
 			}))))
 		})
+
+		It("should pass if a data ImportDefinition defines a CUE definition instead of a schema", func() {
+			impDef1 := core.ImportDefinition{}
+			impDef1.Name = "my-import1"
+			impDef1.Type = core.ImportTypeData
+			impDef1.Cue = &core.CueDefinition{Definition: "replicas: int"}
+			impDef2 := core.ImportDefinition{}
+			impDef2.Name = "my-import2"
+			impDef2.Type = core.ImportTypeData
+			impDef2.Schema = &core.JSONSchemaDefinition{}
+			impDef2.Cue = &core.CueDefinition{Ref: "blueprint://schema.cue", Path: "#Config"}
+
+			allErrs := validation.ValidateBlueprintImportDefinitions(field.NewPath(""), []core.ImportDefinition{impDef1, impDef2})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a target ImportDefinition defines a CUE definition", func() {
+			impDef := core.ImportDefinition{}
+			impDef.Name = "myimport"
+			impDef.Type = core.ImportTypeTarget
+			impDef.TargetType = "test"
+			impDef.Cue = &core.CueDefinition{Definition: "replicas: int"}
+
+			allErrs := validation.ValidateBlueprintImportDefinitions(field.NewPath("x"), []core.ImportDefinition{impDef})
+			Expect(allErrs).To(HaveLen(1))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("x[0][myimport]"),
+				"Detail": ContainSubstring("Cue"),
+			}))))
+		})
+
+		It("should fail if a CUE definition is invalid", func() {
+			impDef1 := core.ImportDefinition{}
+			impDef1.Name = "myimport1"
+			impDef1.Type = core.ImportTypeData
+			impDef1.Cue = &core.CueDefinition{}
+			impDef2 := core.ImportDefinition{}
+			impDef2.Name = "myimport2"
+			impDef2.Type = core.ImportTypeData
+			impDef2.Cue = &core.CueDefinition{Ref: "local://config"}
+
+			allErrs := validation.ValidateBlueprintImportDefinitions(field.NewPath("x"), []core.ImportDefinition{impDef1, impDef2})
+			Expect(allErrs).To(HaveLen(2))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("x[0][myimport1].cue"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("x[1][myimport2].cue.ref"),
+			}))))
+		})
 	})
 
 	Context("ExportDefinitions", func() {
@@ -177,8 +230,12 @@ var _ = Describe("Blueprint", func() {
 			expDef2.Name = "my-export3"
 			expDef2.Type = core.ExportTypeData
 			expDef2.Schema = &core.JSONSchemaDefinition{}
+			expDef3 := core.ExportDefinition{}
+			expDef3.Name = "my-export4"
+			expDef3.Type = core.ExportTypeData
+			expDef3.Cue = &core.CueDefinition{Definition: "replicas: int"}
 
-			allErrs := validation.ValidateBlueprintExportDefinitions(field.NewPath(""), []core.ExportDefinition{expDef1, expDef2})
+			allErrs := validation.ValidateBlueprintExportDefinitions(field.NewPath(""), []core.ExportDefinition{expDef1, expDef2, expDef3})
 			Expect(allErrs).To(HaveLen(0))
 		})
 
@@ -214,6 +271,16 @@ var _ = Describe("Blueprint", func() {
 			Expect(allErrs).To(HaveLen(0))
 		})
 
+		It("should pass if a TemplateExecutor uses jsonnet", func() {
+			executor := core.TemplateExecutor{}
+			executor.Name = "myname"
+			executor.Type = core.JsonnetTemplateType
+			executor.File = "/deploy-execution.jsonnet"
+
+			allErrs := validation.ValidateTemplateExecutorList(field.NewPath(""), []core.TemplateExecutor{executor})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
 		It("should fail if TemplateExecutor.name is missing", func() {
 			executor := core.TemplateExecutor{}
 
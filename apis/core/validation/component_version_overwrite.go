@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateComponentVersionOverwrites validates a ComponentVersionOverwrites object
+func ValidateComponentVersionOverwrites(cvo *core.ComponentVersionOverwrites) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateComponentVersionOverwriteList(cvo.Overwrites, field.NewPath("overwrites"))...)
+	return allErrs
+}
+
+// ValidateComponentVersionOverwriteList validates a list of component version overwrites.
+// All overwrites whose source matches a reference are applied to the original reference in a single pass,
+// so sources must be unique and overlapping sources must not substitute the same field with different values.
+func ValidateComponentVersionOverwriteList(overwrites core.ComponentVersionOverwriteList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, overwrite := range overwrites {
+		idxPath := fldPath.Index(i)
+		allErrs = append(allErrs, validateComponentVersionOverwriteReference(overwrite.Source, idxPath.Child("source"))...)
+		allErrs = append(allErrs, validateComponentVersionOverwriteReference(overwrite.Substitution, idxPath.Child("substitution"))...)
+
+		for j := 0; j < i; j++ {
+			if overwriteReferencesEqual(overwrites[j].Source, overwrite.Source) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("source"),
+					fmt.Sprintf("source is already defined in %s", fldPath.Index(j).String())))
+				break
+			}
+			if overwriteSourcesOverlap(overwrites[j].Source, overwrite.Source) &&
+				substitutionsConflict(overwrites[j].Substitution, overwrite.Substitution) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("substitution"), overwrite.Substitution,
+					fmt.Sprintf("conflicts with the substitution of %s for references that match both sources, "+
+						"it would not be applied to these references", fldPath.Index(j).String())))
+				break
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateComponentVersionOverwriteReference(ref core.ComponentVersionOverwriteReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ref.RepositoryContext == nil && len(ref.ComponentName) == 0 && len(ref.Version) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of repositoryContext, componentName or version has to be defined"))
+	}
+	allErrs = append(allErrs, ValidateRepositoryContext(ref.RepositoryContext, fldPath.Child("repositoryContext"))...)
+	return allErrs
+}
+
+func overwriteReferencesEqual(a, b core.ComponentVersionOverwriteReference) bool {
+	if a.ComponentName != b.ComponentName || a.Version != b.Version {
+		return false
+	}
+	if a.RepositoryContext == nil || b.RepositoryContext == nil {
+		return a.RepositoryContext == nil && b.RepositoryContext == nil
+	}
+	return cdv2.UnstructuredTypesEqual(a.RepositoryContext, b.RepositoryContext)
+}
+
+// overwriteSourcesOverlap checks whether there are references that are matched by both sources.
+// Fields that are not defined in a source match any value.
+func overwriteSourcesOverlap(a, b core.ComponentVersionOverwriteReference) bool {
+	if len(a.ComponentName) != 0 && len(b.ComponentName) != 0 && a.ComponentName != b.ComponentName {
+		return false
+	}
+	if len(a.Version) != 0 && len(b.Version) != 0 && a.Version != b.Version {
+		return false
+	}
+	if a.RepositoryContext != nil && b.RepositoryContext != nil && !cdv2.UnstructuredTypesEqual(a.RepositoryContext, b.RepositoryContext) {
+		return false
+	}
+	return true
+}
+
+// substitutionsConflict checks whether both substitutions set the same field to different values.
+func substitutionsConflict(a, b core.ComponentVersionOverwriteReference) bool {
+	if len(a.ComponentName) != 0 && len(b.ComponentName) != 0 && a.ComponentName != b.ComponentName {
+		return true
+	}
+	if len(a.Version) != 0 && len(b.Version) != 0 && a.Version != b.Version {
+		return true
+	}
+	return a.RepositoryContext != nil && b.RepositoryContext != nil && !cdv2.UnstructuredTypesEqual(a.RepositoryContext, b.RepositoryContext)
+}
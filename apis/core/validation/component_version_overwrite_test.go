@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("ComponentVersionOverwrites", func() {
+
+	overwrite := func(srcName, srcVersion, subName, subVersion string) core.ComponentVersionOverwrite {
+		return core.ComponentVersionOverwrite{
+			Source:       core.ComponentVersionOverwriteReference{ComponentName: srcName, Version: srcVersion},
+			Substitution: core.ComponentVersionOverwriteReference{ComponentName: subName, Version: subVersion},
+		}
+	}
+
+	It("should accept valid overwrites", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("example.com/a", "", "", "v1.0.0"),
+				overwrite("example.com/b", "v1.0.0", "example.com/c", ""),
+				overwrite("example.com/c", "v1.0.0", "", "v1.1.0"),
+			},
+		}
+		Expect(validation.ValidateComponentVersionOverwrites(cvo)).To(BeEmpty())
+	})
+
+	It("should reject empty sources and substitutions", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("", "", "", ""),
+			},
+		}
+		allErrs := validation.ValidateComponentVersionOverwrites(cvo)
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("overwrites[0].source"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("overwrites[0].substitution"),
+			})),
+		))
+	})
+
+	It("should reject an unsupported repository context", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				{
+					Source: core.ComponentVersionOverwriteReference{ComponentName: "example.com/a"},
+					Substitution: core.ComponentVersionOverwriteReference{
+						RepositoryContext: cdv2.NewUnstructuredType("unknown", map[string]interface{}{}),
+					},
+				},
+			},
+		}
+		allErrs := validation.ValidateComponentVersionOverwrites(cvo)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("overwrites[0].substitution.repositoryContext.type"),
+		}))))
+	})
+
+	It("should reject duplicated sources", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("example.com/a", "v1.0.0", "", "v1.1.0"),
+				overwrite("example.com/a", "v1.0.0", "", "v1.2.0"),
+			},
+		}
+		allErrs := validation.ValidateComponentVersionOverwrites(cvo)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("overwrites[1].source"),
+		}))))
+	})
+
+	It("should accept overwrites that swap the versions of a component", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("example.com/a", "v1.0.0", "", "v2.0.0"),
+				overwrite("example.com/a", "v2.0.0", "", "v1.0.0"),
+				overwrite("example.com/b", "v1.0.0", "example.com/c", ""),
+				overwrite("example.com/c", "v1.0.0", "example.com/b", ""),
+			},
+		}
+		Expect(validation.ValidateComponentVersionOverwrites(cvo)).To(BeEmpty())
+	})
+
+	It("should reject overlapping sources with conflicting substitutions", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("example.com/a", "", "", "v2.0.0"),
+				overwrite("example.com/a", "v1.0.0", "", "v3.0.0"),
+				overwrite("", "v1.0.0", "example.com/b", ""),
+			},
+		}
+		allErrs := validation.ValidateComponentVersionOverwrites(cvo)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("overwrites[1].substitution"),
+			"Detail": ContainSubstring("overwrites[0]"),
+		}))))
+	})
+
+	It("should accept overlapping sources that substitute different fields", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("example.com/a", "", "", "v2.0.0"),
+				overwrite("", "v1.0.0", "example.com/b", ""),
+				overwrite("example.com/c", "", "", "v2.0.0"),
+			},
+		}
+		Expect(validation.ValidateComponentVersionOverwrites(cvo)).To(BeEmpty())
+	})
+
+	It("should accept an overwrite that pins the version of a component", func() {
+		cvo := &core.ComponentVersionOverwrites{
+			Overwrites: core.ComponentVersionOverwriteList{
+				overwrite("example.com/a", "", "", "v1.0.0"),
+			},
+		}
+		Expect(validation.ValidateComponentVersionOverwrites(cvo)).To(BeEmpty())
+	})
+
+})
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// SupportedRepositoryContextTypes contains the types of repository contexts that can be resolved by the landscaper.
+var SupportedRepositoryContextTypes = sets.NewString(cdv2.OCIRegistryType, "local")
+
+// ValidateContext validates a Context
+func ValidateContext(lsCtx *core.Context) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateRepositoryContext(lsCtx.RepositoryContext, field.NewPath("repositoryContext"))...)
+	for i, secretRef := range lsCtx.RegistryPullSecrets {
+		if len(secretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(field.NewPath("registryPullSecrets").Index(i).Child("name"), "name must not be empty"))
+		}
+	}
+	if len(lsCtx.ComponentVersionOverwritesReference) != 0 {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(lsCtx.ComponentVersionOverwritesReference, false) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("componentVersionOverwrites"), lsCtx.ComponentVersionOverwritesReference, msg))
+		}
+	}
+	allErrs = append(allErrs, ValidateMaintenancePolicy(lsCtx.MaintenancePolicy, field.NewPath("maintenancePolicy"))...)
+	allErrs = append(allErrs, ValidateDeployItemTimeouts(lsCtx.DeployItemTimeouts, field.NewPath("deployItemTimeouts"))...)
+	allErrs = append(allErrs, ValidateMaxParallelDeployItems(field.NewPath("maxParallelDeployItems"), lsCtx.MaxParallelDeployItems)...)
+	return allErrs
+}
+
+// ValidateDeployItemTimeouts validates that the deploy item timeouts of a context are not negative.
+func ValidateDeployItemTimeouts(timeouts *core.DeployItemTimeouts, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if timeouts == nil {
+		return allErrs
+	}
+	if timeouts.Pickup != nil && timeouts.Pickup.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pickup"), timeouts.Pickup.Duration.String(), "must not be negative"))
+	}
+	if timeouts.ProgressingDefault != nil && timeouts.ProgressingDefault.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("progressingDefault"), timeouts.ProgressingDefault.Duration.String(), "must not be negative"))
+	}
+	return allErrs
+}
+
+// ValidateRepositoryContext validates that a repository context has a type that is supported by the landscaper.
+func ValidateRepositoryContext(repoCtx *cdv2.UnstructuredTypedObject, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if repoCtx == nil {
+		return allErrs
+	}
+	if len(repoCtx.GetType()) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "type must not be empty"))
+	} else if !SupportedRepositoryContextTypes.Has(repoCtx.GetType()) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), repoCtx.GetType(), SupportedRepositoryContextTypes.List()))
+	}
+	return allErrs
+}
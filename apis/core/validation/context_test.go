@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("Context", func() {
+
+	var lsCtx *core.Context
+
+	BeforeEach(func() {
+		repoCtx, _ := cdv2.NewUnstructured(cdv2.NewOCIRegistryRepository("example.com", ""))
+		lsCtx = &core.Context{
+			RepositoryContext:                   &repoCtx,
+			RegistryPullSecrets:                 []corev1.LocalObjectReference{{Name: "my-pull-secret"}},
+			ComponentVersionOverwritesReference: "my-overwrites",
+		}
+	})
+
+	It("should accept a valid context", func() {
+		Expect(validation.ValidateContext(lsCtx)).To(BeEmpty())
+	})
+
+	It("should reject an unsupported repository context type", func() {
+		lsCtx.RepositoryContext = cdv2.NewUnstructuredType("unknown", map[string]interface{}{})
+		allErrs := validation.ValidateContext(lsCtx)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeNotSupported),
+			"Field": Equal("repositoryContext.type"),
+		}))))
+	})
+
+	It("should reject a pull secret without a name", func() {
+		lsCtx.RegistryPullSecrets = append(lsCtx.RegistryPullSecrets, corev1.LocalObjectReference{})
+		allErrs := validation.ValidateContext(lsCtx)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("registryPullSecrets[1].name"),
+		}))))
+	})
+
+	It("should reject an invalid component version overwrites reference", func() {
+		lsCtx.ComponentVersionOverwritesReference = "My_Overwrites"
+		allErrs := validation.ValidateContext(lsCtx)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("componentVersionOverwrites"),
+		}))))
+	})
+
+	It("should reject negative deploy item timeouts", func() {
+		lsCtx.DeployItemTimeouts = &core.DeployItemTimeouts{
+			Pickup:             &core.Duration{Duration: -time.Minute},
+			ProgressingDefault: &core.Duration{Duration: 10 * time.Minute},
+		}
+		allErrs := validation.ValidateContext(lsCtx)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("deployItemTimeouts.pickup"),
+		}))))
+	})
+
+	It("should reject an invalid number of parallel deploy items", func() {
+		lsCtx.MaxParallelDeployItems = pointer.Int32(0)
+		allErrs := validation.ValidateContext(lsCtx)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": Equal("maxParallelDeployItems"),
+		}))))
+	})
+
+})
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// MaxDataObjectDataSize is the maximal size of the data of a data object in bytes.
+// Larger objects would be rejected by etcd anyway.
+const MaxDataObjectDataSize = 1536 * 1024
+
+// ValidateDataObject validates a DataObject
+func ValidateDataObject(do *core.DataObject) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateDataObjectLabels(do.GetLabels(), field.NewPath("metadata", "labels"))...)
+	if size := len(do.Data.RawMessage); size > MaxDataObjectDataSize {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("data"), fmt.Sprintf("%d bytes", size),
+			fmt.Sprintf("data must not be larger than %d bytes", MaxDataObjectDataSize)))
+	}
+	return allErrs
+}
+
+// ValidateDataObjectLabels validates that the landscaper specific labels of a data object are consistent.
+// Data objects that are created for a context also need a source type and a key, imported data objects always belong to a context.
+func ValidateDataObjectLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	context, hasContext := labels[core.DataObjectContextLabel]
+	sourceType, hasSourceType := labels[core.DataObjectSourceTypeLabel]
+	_, hasKey := labels[core.DataObjectKeyLabel]
+
+	if hasContext && !hasSourceType {
+		allErrs = append(allErrs, field.Required(fldPath.Key(core.DataObjectSourceTypeLabel),
+			fmt.Sprintf("must be set if the context label %q is set", core.DataObjectContextLabel)))
+	}
+	if hasSourceType {
+		switch core.DataObjectSourceType(sourceType) {
+		case core.ImportDataObjectSourceType:
+			if !hasContext || len(context) == 0 {
+				allErrs = append(allErrs, field.Required(fldPath.Key(core.DataObjectContextLabel),
+					fmt.Sprintf("must be set for data objects of source type %q", core.ImportDataObjectSourceType)))
+			}
+		case core.ExportDataObjectSourceType:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(core.DataObjectSourceTypeLabel), sourceType,
+				[]string{string(core.ImportDataObjectSourceType), string(core.ExportDataObjectSourceType)}))
+		}
+	}
+	if (hasContext || hasSourceType) && !hasKey {
+		allErrs = append(allErrs, field.Required(fldPath.Key(core.DataObjectKeyLabel),
+			fmt.Sprintf("must be set if the context label %q or the source type label %q is set", core.DataObjectContextLabel, core.DataObjectSourceTypeLabel)))
+	}
+	if rawIndex, ok := labels[core.DataObjectIndexLabel]; ok {
+		if index, err := strconv.Atoi(rawIndex); err != nil || index < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(core.DataObjectIndexLabel), rawIndex, "must be a non-negative integer"))
+		}
+	}
+	return allErrs
+}
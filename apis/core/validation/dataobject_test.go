@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("DataObject", func() {
+
+	DescribeTable("labels",
+		func(labels map[string]string, errField string) {
+			do := &core.DataObject{}
+			do.SetLabels(labels)
+			allErrs := validation.ValidateDataObject(do)
+			if len(errField) == 0 {
+				Expect(allErrs).To(BeEmpty())
+				return
+			}
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal(errField),
+			}))))
+		},
+		Entry("no labels", nil, ""),
+		Entry("exported data object of a context", map[string]string{
+			core.DataObjectContextLabel:    "Inst.abc",
+			core.DataObjectSourceTypeLabel: string(core.ExportDataObjectSourceType),
+			core.DataObjectKeyLabel:        "my-export",
+		}, ""),
+		Entry("exported data object of a root installation", map[string]string{
+			core.DataObjectSourceTypeLabel: string(core.ExportDataObjectSourceType),
+			core.DataObjectKeyLabel:        "my-export",
+		}, ""),
+		Entry("imported list data object", map[string]string{
+			core.DataObjectContextLabel:    "Inst.abc",
+			core.DataObjectSourceTypeLabel: string(core.ImportDataObjectSourceType),
+			core.DataObjectKeyLabel:        "my-import",
+			core.DataObjectIndexLabel:      "2",
+		}, ""),
+		Entry("context without source type", map[string]string{
+			core.DataObjectContextLabel: "Inst.abc",
+			core.DataObjectKeyLabel:     "my-export",
+		}, "metadata.labels[data.landscaper.gardener.cloud/sourceType]"),
+		Entry("unknown source type", map[string]string{
+			core.DataObjectContextLabel:    "Inst.abc",
+			core.DataObjectSourceTypeLabel: "unknown",
+			core.DataObjectKeyLabel:        "my-export",
+		}, "metadata.labels[data.landscaper.gardener.cloud/sourceType]"),
+		Entry("import without context", map[string]string{
+			core.DataObjectSourceTypeLabel: string(core.ImportDataObjectSourceType),
+			core.DataObjectKeyLabel:        "my-import",
+		}, "metadata.labels[data.landscaper.gardener.cloud/context]"),
+		Entry("source type without key", map[string]string{
+			core.DataObjectContextLabel:    "Inst.abc",
+			core.DataObjectSourceTypeLabel: string(core.ExportDataObjectSourceType),
+		}, "metadata.labels[data.landscaper.gardener.cloud/key]"),
+		Entry("negative index", map[string]string{
+			core.DataObjectIndexLabel: "-1",
+		}, "metadata.labels[data.landscaper.gardener.cloud/index]"),
+		Entry("non-numeric index", map[string]string{
+			core.DataObjectIndexLabel: "first",
+		}, "metadata.labels[data.landscaper.gardener.cloud/index]"),
+	)
+
+	It("should reject data that exceeds the maximal size", func() {
+		data, err := json.Marshal(strings.Repeat("a", validation.MaxDataObjectDataSize))
+		Expect(err).ToNot(HaveOccurred())
+		do := &core.DataObject{Data: core.NewAnyJSON(data)}
+
+		allErrs := validation.ValidateDataObject(do)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("data"),
+		}))))
+	})
+
+})
@@ -23,6 +23,16 @@ func ValidateExecution(execution *core.Execution) field.ErrorList {
 func ValidateExecutionSpec(fldpath *field.Path, spec core.ExecutionSpec) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateDeployItemTemplateList(fldpath.Child("deployItems"), spec.DeployItems)...)
+	allErrs = append(allErrs, ValidateMaxParallelDeployItems(fldpath.Child("maxParallelDeployItems"), spec.MaxParallelDeployItems)...)
+	return allErrs
+}
+
+// ValidateMaxParallelDeployItems validates the maximum number of deploy items that are processed in parallel.
+func ValidateMaxParallelDeployItems(fldPath *field.Path, maxParallelDeployItems *int32) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if maxParallelDeployItems != nil && *maxParallelDeployItems < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *maxParallelDeployItems, "must be at least 1"))
+	}
 	return allErrs
 }
 
@@ -173,5 +183,17 @@ func ValidateDeployItemTemplate(fldPath *field.Path, tmpl core.DeployItemTemplat
 		allErrs = append(allErrs, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
 	}
 
+	if tmpl.Retry != nil {
+		retryPath := fldPath.Child("retry")
+		if tmpl.Retry.NumberOfRetries != nil && *tmpl.Retry.NumberOfRetries < 0 {
+			allErrs = append(allErrs, field.Invalid(retryPath.Child("numberOfRetries"), *tmpl.Retry.NumberOfRetries, "must not be negative"))
+		}
+		if tmpl.Retry.Interval != nil && tmpl.Retry.Interval.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(retryPath.Child("interval"), tmpl.Retry.Interval.Duration.String(), "must be positive"))
+		}
+		allErrs = append(allErrs, ValidateRetryBackoff(tmpl.Retry.Backoff, retryPath.Child("backoff"))...)
+		allErrs = append(allErrs, ValidateRetryRules(tmpl.Retry.Rules, retryPath.Child("rules"))...)
+	}
+
 	return allErrs
 }
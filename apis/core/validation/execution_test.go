@@ -150,4 +150,21 @@ var _ = Describe("Execution", func() {
 
 	})
 
+	Context("ValidateMaxParallelDeployItems", func() {
+		It("should pass if no limit or a positive limit is defined", func() {
+			Expect(validation.ValidateMaxParallelDeployItems(field.NewPath("x"), nil)).To(HaveLen(0))
+			limit := int32(3)
+			Expect(validation.ValidateMaxParallelDeployItems(field.NewPath("x"), &limit)).To(HaveLen(0))
+		})
+
+		It("should fail if the limit is not positive", func() {
+			limit := int32(0)
+			allErrs := validation.ValidateMaxParallelDeployItems(field.NewPath("x"), &limit)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("x"),
+			}))))
+		})
+	})
+
 })
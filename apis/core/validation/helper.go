@@ -25,6 +25,12 @@ var exportTypesWithExpectedConfig = map[string][]string{
 	string(core.ExportTypeTarget): {"TargetType"},
 }
 
+// alternativeConfigs lists the configs that can be used instead of an expected config.
+// E.g. data imports and exports can be validated by a jsonschema, a CUE definition or both.
+var alternativeConfigs = map[string][]string{
+	"Schema": {"Cue"},
+}
+
 var relevantConfigFields map[string]bool
 
 // isFieldValueDefinition lists the fields which are not directly part of an Import/ExportDefinition, but of the FieldValueDefinition
@@ -33,6 +39,7 @@ var relevantConfigFields map[string]bool
 // a map is used for easier 'contains' queries, the values are ignored
 var isFieldValueDefinition = map[string]bool{
 	"Schema":     true,
+	"Cue":        true,
 	"TargetType": true,
 }
 
@@ -46,7 +53,7 @@ func keys(m map[string][]string) []string {
 }
 
 // computeRelevantConfigFields returns the union of the values of importTypesWithExpectedConfig and exportTypesWithExpectedConfig
-// as a map[string]bool for easier 'contains' queries. The value will always be set to 'true'.
+// and their alternatives as a map[string]bool for easier 'contains' queries. The value will always be set to 'true'.
 // This is used to detect if a config field is set which should not be set for the specified type.
 func computeRelevantConfigFields() map[string]bool {
 	res := map[string]bool{}
@@ -60,6 +67,11 @@ func computeRelevantConfigFields() map[string]bool {
 			res[e] = true
 		}
 	}
+	for _, v := range alternativeConfigs {
+		for _, e := range v {
+			res[e] = true
+		}
+	}
 	return res
 }
 
@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"github.com/robfig/cron/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -58,6 +59,83 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	// check RegistryPullSecrets
 	allErrs = append(allErrs, ValidateObjectReferenceList(spec.RegistryPullSecrets, fldPath.Child("registryPullSecrets"))...)
 
+	allErrs = append(allErrs, ValidateMaintenancePolicy(spec.MaintenancePolicy, fldPath.Child("maintenancePolicy"))...)
+
+	if spec.AutomaticReconcile != nil && spec.AutomaticReconcile.FailedReconcile != nil {
+		allErrs = append(allErrs, ValidateFailedReconcile(spec.AutomaticReconcile.FailedReconcile,
+			fldPath.Child("automaticReconcile", "failedReconcile"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateFailedReconcile validates the automatic reconcile configuration for failed installations
+func ValidateFailedReconcile(failedReconcile *core.FailedReconcile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if failedReconcile.NumberOfReconciles != nil && *failedReconcile.NumberOfReconciles < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numberOfReconciles"), *failedReconcile.NumberOfReconciles, "must not be negative"))
+	}
+	if failedReconcile.Interval != nil && failedReconcile.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), failedReconcile.Interval.Duration.String(), "must be positive"))
+	}
+	allErrs = append(allErrs, ValidateRetryBackoff(failedReconcile.Backoff, fldPath.Child("backoff"))...)
+	allErrs = append(allErrs, ValidateRetryRules(failedReconcile.Rules, fldPath.Child("rules"))...)
+	return allErrs
+}
+
+// ValidateRetryBackoff validates the backoff of automatic retries
+func ValidateRetryBackoff(backoff *core.RetryBackoff, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if backoff == nil {
+		return allErrs
+	}
+	if backoff.Factor != nil && *backoff.Factor < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("factor"), *backoff.Factor, "must be at least 1"))
+	}
+	if backoff.MaxInterval != nil && backoff.MaxInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInterval"), backoff.MaxInterval.Duration.String(), "must be positive"))
+	}
+	if backoff.JitterPercent != nil && (*backoff.JitterPercent < 0 || *backoff.JitterPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jitterPercent"), *backoff.JitterPercent, "must be between 0 and 100"))
+	}
+	return allErrs
+}
+
+// ValidateRetryRules validates the rules of automatic retries
+func ValidateRetryRules(rules []core.RetryRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	supportedActions := []string{string(core.RetryActionRetry), string(core.RetryActionNever), string(core.RetryActionAlways)}
+	for i, rule := range rules {
+		switch rule.Action {
+		case core.RetryActionRetry, core.RetryActionNever, core.RetryActionAlways:
+		case "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("action"), "must not be empty"))
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("action"), rule.Action, supportedActions))
+		}
+	}
+	return allErrs
+}
+
+// ValidateMaintenancePolicy validates the maintenance policy of an installation or context
+func ValidateMaintenancePolicy(policy *core.MaintenancePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	for i, window := range policy.Windows {
+		windowPath := fldPath.Child("windows").Index(i)
+		if len(window.Schedule) == 0 {
+			allErrs = append(allErrs, field.Required(windowPath.Child("schedule"), "must not be empty"))
+		} else if _, err := cron.ParseStandard(window.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("schedule"), window.Schedule, err.Error()))
+		}
+		if window.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("duration"), window.Duration.Duration.String(), "must be positive"))
+		}
+	}
+
 	return allErrs
 }
 
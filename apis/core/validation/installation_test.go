@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 
@@ -87,6 +90,94 @@ var _ = Describe("Installation", func() {
 		})
 	})
 
+	Context("MaintenancePolicy", func() {
+		It("should pass if the maintenance windows are valid", func() {
+			policy := &core.MaintenancePolicy{
+				Windows: []core.MaintenanceWindow{
+					{Schedule: "0 22 * * 1-5", Duration: core.Duration{Duration: 2 * time.Hour}},
+					{Schedule: "CRON_TZ=Europe/Berlin 0 10 * * 6", Duration: core.Duration{Duration: time.Hour}},
+				},
+			}
+
+			allErrs := validation.ValidateMaintenancePolicy(policy, field.NewPath("maintenancePolicy"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a maintenance window has an invalid schedule or duration", func() {
+			policy := &core.MaintenancePolicy{
+				Windows: []core.MaintenanceWindow{
+					{Schedule: "every night", Duration: core.Duration{Duration: time.Hour}},
+					{Schedule: "0 22 * * *"},
+				},
+			}
+
+			allErrs := validation.ValidateMaintenancePolicy(policy, field.NewPath("maintenancePolicy"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenancePolicy.windows[0].schedule"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenancePolicy.windows[1].duration"),
+				})),
+			))
+		})
+	})
+
+	Context("FailedReconcile", func() {
+		It("should pass if the backoff and the rules are valid", func() {
+			failedReconcile := &core.FailedReconcile{
+				Interval: &core.Duration{Duration: time.Minute},
+				Backoff: &core.RetryBackoff{
+					Factor:        pointer.Int32(3),
+					MaxInterval:   &core.Duration{Duration: time.Hour},
+					JitterPercent: pointer.Int32(10),
+				},
+				Rules: []core.RetryRule{
+					{Codes: []core.ErrorCode{core.ErrorConfigurationProblem}, Action: core.RetryActionNever},
+					{Reasons: []string{"FetchTarget"}, Action: core.RetryActionAlways},
+				},
+			}
+
+			allErrs := validation.ValidateFailedReconcile(failedReconcile, field.NewPath("failedReconcile"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if the backoff and the rules are invalid", func() {
+			failedReconcile := &core.FailedReconcile{
+				Backoff: &core.RetryBackoff{
+					Factor:        pointer.Int32(0),
+					JitterPercent: pointer.Int32(150),
+				},
+				Rules: []core.RetryRule{
+					{Codes: []core.ErrorCode{core.ErrorConfigurationProblem}},
+					{Action: "Sometimes"},
+				},
+			}
+
+			allErrs := validation.ValidateFailedReconcile(failedReconcile, field.NewPath("failedReconcile"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("failedReconcile.backoff.factor"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("failedReconcile.backoff.jitterPercent"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("failedReconcile.rules[0].action"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("failedReconcile.rules[1].action"),
+				})),
+			))
+		})
+	})
+
 	Context("InstallationBlueprint", func() {
 		It("should accept a Blueprint reference", func() {
 			bpDef := core.BlueprintDefinition{
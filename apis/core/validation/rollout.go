@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateRollout validates a Rollout
+func ValidateRollout(rollout *core.Rollout) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateRolloutSpec(&rollout.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateRolloutSpec validates the spec of a rollout
+func ValidateRolloutSpec(spec *core.RolloutSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.NamespaceSelector,
+		metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.Selector,
+		metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector"))...)
+	if len(spec.ComponentName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("componentName"), "must not be empty"))
+	}
+	if len(spec.Version) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("version"), "must not be empty"))
+	}
+	allErrs = append(allErrs, ValidateWaveSize(spec.Strategy.WaveSize, fldPath.Child("strategy", "waveSize"))...)
+
+	return allErrs
+}
+
+// ValidateWaveSize validates the wave size of a rollout strategy.
+// The wave size must be a positive number or a percentage between 1% and 100%.
+func ValidateWaveSize(waveSize string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(waveSize) == 0 {
+		return allErrs
+	}
+
+	value := intstr.Parse(waveSize)
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(&value, 100, true)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, waveSize, "must be a number or a percentage"))
+		return allErrs
+	}
+	if scaled <= 0 || (value.Type == intstr.String && scaled > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, waveSize, "must be a positive number or a percentage between 1% and 100%"))
+	}
+	return allErrs
+}
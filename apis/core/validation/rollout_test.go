@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("Rollout", func() {
+
+	var rollout *core.Rollout
+
+	BeforeEach(func() {
+		rollout = &core.Rollout{
+			Spec: core.RolloutSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "my-app"},
+				},
+				ComponentName: "example.com/my-component",
+				Version:       "v1.1.0",
+			},
+		}
+	})
+
+	It("should accept a valid rollout", func() {
+		Expect(validation.ValidateRollout(rollout)).To(BeEmpty())
+	})
+
+	It("should reject a rollout without component name and version", func() {
+		rollout.Spec.ComponentName = ""
+		rollout.Spec.Version = ""
+
+		allErrs := validation.ValidateRollout(rollout)
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.componentName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.version"),
+			})),
+		))
+	})
+
+	It("should reject an invalid label selector", func() {
+		rollout.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+			{
+				Key:      "app",
+				Operator: "Unknown",
+			},
+		}
+
+		allErrs := validation.ValidateRollout(rollout)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": HavePrefix("spec.selector"),
+		}))))
+	})
+
+	It("should reject an invalid namespace selector", func() {
+		rollout.Spec.NamespaceSelector.MatchExpressions = []metav1.LabelSelectorRequirement{
+			{
+				Key:      "stage",
+				Operator: "Unknown",
+			},
+		}
+
+		allErrs := validation.ValidateRollout(rollout)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": HavePrefix("spec.namespaceSelector"),
+		}))))
+	})
+
+	DescribeTable("wave size",
+		func(waveSize string, valid bool) {
+			rollout.Spec.Strategy.WaveSize = waveSize
+			allErrs := validation.ValidateRollout(rollout)
+			if valid {
+				Expect(allErrs).To(BeEmpty())
+				return
+			}
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.strategy.waveSize"),
+			}))))
+		},
+		Entry("empty", "", true),
+		Entry("number", "5", true),
+		Entry("percentage", "10%", true),
+		Entry("full percentage", "100%", true),
+		Entry("zero", "0", false),
+		Entry("negative number", "-1", false),
+		Entry("zero percentage", "0%", false),
+		Entry("percentage above 100", "150%", false),
+		Entry("no number", "all", false),
+	)
+})
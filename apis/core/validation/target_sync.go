@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"regexp"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateTargetSync validates a TargetSync
+func ValidateTargetSync(targetSync *core.TargetSync) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateTargetSyncSpec(&targetSync.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateTargetSyncSpec validates the spec of a TargetSync
+func ValidateTargetSyncSpec(spec *core.TargetSyncSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.SourceNamespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceNamespace"), "must not be empty"))
+	}
+	allErrs = append(allErrs, ValidateLocalSecretReference(spec.SecretRef, fldPath.Child("secretRef"))...)
+	if len(spec.TargetToSourceName) != 0 {
+		for _, msg := range validation.IsDNS1123Subdomain(spec.TargetToSourceName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetToSourceName"), spec.TargetToSourceName, msg))
+		}
+	}
+
+	allErrs = append(allErrs, ValidateNameExpression(spec.SecretNameExpression, fldPath.Child("secretNameExpression"))...)
+	allErrs = append(allErrs, ValidateNameExpression(spec.ShootNameExpression, fldPath.Child("shootNameExpression"))...)
+	allErrs = append(allErrs, ValidateNameExpression(spec.ClusterNameExpression, fldPath.Child("clusterNameExpression"))...)
+	allErrs = append(allErrs, ValidateNameExpression(spec.ConfigMapNameExpression, fldPath.Child("configMapNameExpression"))...)
+
+	if spec.LabelSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.LabelSelector,
+			metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("labelSelector"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateNameExpression validates that a name expression of a TargetSync is a valid regular expression.
+// In addition to regular expressions "*" is a valid expression that matches all names.
+func ValidateNameExpression(expression string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(expression) == 0 || expression == "*" {
+		return allErrs
+	}
+	if _, err := regexp.Compile(expression); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, expression, err.Error()))
+	}
+	return allErrs
+}
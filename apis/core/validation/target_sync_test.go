@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("TargetSync", func() {
+
+	var targetSync *core.TargetSync
+
+	BeforeEach(func() {
+		targetSync = &core.TargetSync{
+			Spec: core.TargetSyncSpec{
+				SourceNamespace:      "garden-my-project",
+				SecretRef:            core.LocalSecretReference{Name: "my-kubeconfig"},
+				SecretNameExpression: ".*",
+				ShootNameExpression:  "*",
+			},
+		}
+	})
+
+	It("should accept a valid target sync", func() {
+		Expect(validation.ValidateTargetSync(targetSync)).To(BeEmpty())
+	})
+
+	It("should reject a target sync without source namespace and secret reference", func() {
+		targetSync.Spec.SourceNamespace = ""
+		targetSync.Spec.SecretRef.Name = ""
+
+		allErrs := validation.ValidateTargetSync(targetSync)
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.sourceNamespace"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.secretRef.name"),
+			})),
+		))
+	})
+
+	It("should reject an invalid target to source name", func() {
+		targetSync.Spec.TargetToSourceName = "My_Target"
+		allErrs := validation.ValidateTargetSync(targetSync)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.targetToSourceName"),
+		}))))
+	})
+
+	It("should reject an invalid label selector", func() {
+		targetSync.Spec.LabelSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}},
+		}
+		allErrs := validation.ValidateTargetSync(targetSync)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Field": HavePrefix("spec.labelSelector"),
+		}))))
+	})
+
+	DescribeTable("name expressions",
+		func(expression string, valid bool) {
+			targetSync.Spec.ClusterNameExpression = expression
+			allErrs := validation.ValidateTargetSync(targetSync)
+			if valid {
+				Expect(allErrs).To(BeEmpty())
+				return
+			}
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.clusterNameExpression"),
+			}))))
+		},
+		Entry("empty", "", true),
+		Entry("wildcard", "*", true),
+		Entry("regular expression", "^my-cluster-[0-9]+$", true),
+		Entry("unclosed group", "my-(cluster", false),
+		Entry("leading repetition", "*cluster", false),
+	)
+})
@@ -15,6 +15,7 @@ import (
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -149,6 +150,11 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaxParallelDeployItems != nil {
+		in, out := &in.MaxParallelDeployItems, &out.MaxParallelDeployItems
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -484,6 +490,21 @@ func (in *Context) DeepCopyInto(out *Context) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeployItemTimeouts != nil {
+		in, out := &in.DeployItemTimeouts, &out.DeployItemTimeouts
+		*out = new(DeployItemTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxParallelDeployItems != nil {
+		in, out := &in.MaxParallelDeployItems, &out.MaxParallelDeployItems
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -538,6 +559,22 @@ func (in *ContextList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CueDefinition) DeepCopyInto(out *CueDefinition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CueDefinition.
+func (in *CueDefinition) DeepCopy() *CueDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(CueDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataExport) DeepCopyInto(out *DataExport) {
 	*out = *in
@@ -701,6 +738,23 @@ func (in *DeployItem) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemApproval) DeepCopyInto(out *DeployItemApproval) {
+	*out = *in
+	in.ApprovalTime.DeepCopyInto(&out.ApprovalTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemApproval.
+func (in *DeployItemApproval) DeepCopy() *DeployItemApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemList) DeepCopyInto(out *DeployItemList) {
 	*out = *in
@@ -734,6 +788,61 @@ func (in *DeployItemList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemRetryPolicy) DeepCopyInto(out *DeployItemRetryPolicy) {
+	*out = *in
+	if in.NumberOfRetries != nil {
+		in, out := &in.NumberOfRetries, &out.NumberOfRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RetryRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemRetryPolicy.
+func (in *DeployItemRetryPolicy) DeepCopy() *DeployItemRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemRetryStatus) DeepCopyInto(out *DeployItemRetryStatus) {
+	*out = *in
+	in.LastRetryTime.DeepCopyInto(&out.LastRetryTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemRetryStatus.
+func (in *DeployItemRetryStatus) DeepCopy() *DeployItemRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemSpec) DeepCopyInto(out *DeployItemSpec) {
 	*out = *in
@@ -825,6 +934,11 @@ func (in *DeployItemStatus) DeepCopyInto(out *DeployItemStatus) {
 		in, out := &in.JobIDGenerationTime, &out.JobIDGenerationTime
 		*out = (*in).DeepCopy()
 	}
+	if in.EffectiveTimeouts != nil {
+		in, out := &in.EffectiveTimeouts, &out.EffectiveTimeouts
+		*out = new(EffectiveDeployItemTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeployerPhase != nil {
 		in, out := &in.DeployerPhase, &out.DeployerPhase
 		*out = new(string)
@@ -878,6 +992,11 @@ func (in *DeployItemTemplate) DeepCopyInto(out *DeployItemTemplate) {
 		*out = new(OnDeleteConfig)
 		**out = **in
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(DeployItemRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -913,6 +1032,32 @@ func (in DeployItemTemplateList) DeepCopy() DeployItemTemplateList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
+	*out = *in
+	if in.Pickup != nil {
+		in, out := &in.Pickup, &out.Pickup
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ProgressingDefault != nil {
+		in, out := &in.ProgressingDefault, &out.ProgressingDefault
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemTimeouts.
+func (in *DeployItemTimeouts) DeepCopy() *DeployItemTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployerInformation) DeepCopyInto(out *DeployerInformation) {
 	*out = *in
@@ -1079,6 +1224,32 @@ func (in *Duration) DeepCopy() *Duration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveDeployItemTimeouts) DeepCopyInto(out *EffectiveDeployItemTimeouts) {
+	*out = *in
+	if in.Pickup != nil {
+		in, out := &in.Pickup, &out.Pickup
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Progressing != nil {
+		in, out := &in.Progressing, &out.Progressing
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveDeployItemTimeouts.
+func (in *EffectiveDeployItemTimeouts) DeepCopy() *EffectiveDeployItemTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveDeployItemTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Environment) DeepCopyInto(out *Environment) {
 	*out = *in
@@ -1279,6 +1450,11 @@ func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxParallelDeployItems != nil {
+		in, out := &in.MaxParallelDeployItems, &out.MaxParallelDeployItems
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RegistryPullSecrets != nil {
 		in, out := &in.RegistryPullSecrets, &out.RegistryPullSecrets
 		*out = make([]ObjectReference, len(*in))
@@ -1327,10 +1503,28 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = make([]ExecutionGeneration, len(*in))
 		copy(*out, *in)
 	}
+	if in.JobIDGenerationTime != nil {
+		in, out := &in.JobIDGenerationTime, &out.JobIDGenerationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.PhaseTransitionTime != nil {
 		in, out := &in.PhaseTransitionTime, &out.PhaseTransitionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]DeployItemApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = make([]DeployItemRetryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1396,6 +1590,18 @@ func (in *FailedReconcile) DeepCopyInto(out *FailedReconcile) {
 		*out = new(Duration)
 		**out = **in
 	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RetryRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1417,6 +1623,11 @@ func (in *FieldValueDefinition) DeepCopyInto(out *FieldValueDefinition) {
 		*out = new(JSONSchemaDefinition)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cue != nil {
+		in, out := &in.Cue, &out.Cue
+		*out = new(CueDefinition)
+		**out = **in
+	}
 	return
 }
 
@@ -1555,6 +1766,22 @@ func (in *Installation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationBlocker) DeepCopyInto(out *InstallationBlocker) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationBlocker.
+func (in *InstallationBlocker) DeepCopy() *InstallationBlocker {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationBlocker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationExports) DeepCopyInto(out *InstallationExports) {
 	*out = *in
@@ -1644,6 +1871,47 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationPlan) DeepCopyInto(out *InstallationPlan) {
+	*out = *in
+	in.PlanTime.DeepCopyInto(&out.PlanTime)
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subinstallations != nil {
+		in, out := &in.Subinstallations, &out.Subinstallations
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResultReference != nil {
+		in, out := &in.ResultReference, &out.ResultReference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationPlan.
+func (in *InstallationPlan) DeepCopy() *InstallationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
@@ -1679,6 +1947,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(AutomaticReconcile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(MaintenancePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1724,6 +1997,10 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.JobIDGenerationTime != nil {
+		in, out := &in.JobIDGenerationTime, &out.JobIDGenerationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.PhaseTransitionTime != nil {
 		in, out := &in.PhaseTransitionTime, &out.PhaseTransitionTime
 		*out = (*in).DeepCopy()
@@ -1738,6 +2015,21 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = make([]DependentToTrigger, len(*in))
 		copy(*out, *in)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InstallationPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceStatus != nil {
+		in, out := &in.MaintenanceStatus, &out.MaintenanceStatus
+		*out = new(MaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockedBy != nil {
+		in, out := &in.BlockedBy, &out.BlockedBy
+		*out = make([]InstallationBlocker, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1949,6 +2241,65 @@ func (in *LsHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenancePolicy) DeepCopyInto(out *MaintenancePolicy) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenancePolicy.
+func (in *MaintenancePolicy) DeepCopy() *MaintenancePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenancePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceStatus) DeepCopyInto(out *MaintenanceStatus) {
+	*out = *in
+	in.PendingSince.DeepCopyInto(&out.PendingSince)
+	if in.NextWindowStart != nil {
+		in, out := &in.NextWindowStart, &out.NextWindowStart
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceStatus.
+func (in *MaintenanceStatus) DeepCopy() *MaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedObjectReference) DeepCopyInto(out *NamedObjectReference) {
 	*out = *in
@@ -1998,6 +2349,27 @@ func (in *OnDeleteConfig) DeepCopy() *OnDeleteConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
@@ -2072,6 +2444,184 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.JitterPercent != nil {
+		in, out := &in.JitterPercent, &out.JitterPercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryRule) DeepCopyInto(out *RetryRule) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryRule.
+func (in *RetryRule) DeepCopy() *RetryRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Rollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutList) DeepCopyInto(out *RolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Rollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutList.
+func (in *RolloutList) DeepCopy() *RolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.Selector.DeepCopyInto(&out.Selector)
+	out.Strategy = in.Strategy
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutSpec.
+func (in *RolloutSpec) DeepCopy() *RolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.FailedInstallations != nil {
+		in, out := &in.FailedInstallations, &out.FailedInstallations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretLabelSelectorRef) DeepCopyInto(out *SecretLabelSelectorRef) {
 	*out = *in
@@ -2494,6 +3044,11 @@ func (in *TargetSyncList) DeepCopyObject() runtime.Object {
 func (in *TargetSyncSpec) DeepCopyInto(out *TargetSyncSpec) {
 	*out = *in
 	out.SecretRef = in.SecretRef
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenRotation != nil {
 		in, out := &in.TokenRotation, &out.TokenRotation
 		*out = new(TokenRotation)
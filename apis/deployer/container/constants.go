@@ -35,6 +35,12 @@ const ContainerDeployerDeployItemNamespaceLabel = "deployitem.container.deployer
 // ContainerDeployerDeployItemGenerationLabel is the name of the label that indicates the deploy item generation.
 const ContainerDeployerDeployItemGenerationLabel = "deployitem.container.deployer.landscaper.gardener.cloud/generation"
 
+// CapturedLogType is the value of the type label of objects that contain a captured log of the main container.
+const CapturedLogType = "captured-log"
+
+// DefaultCapturedLogLines is the default number of lines at the end of the main container's log that are captured.
+const DefaultCapturedLogLines int64 = 100
+
 // InitContainerConditionType defines the condition for the current init container
 const InitContainerConditionType = "InitContainer"
 
@@ -104,6 +110,18 @@ const StatePathName = "STATE_PATH"
 // StatePath is the path to the state directory.
 var StatePath = filepath.Join(SharedBasePath, "state")
 
+// StateConfigurationName is the name of the env var that contains the configuration of the state backend as json.
+const StateConfigurationName = "STATE_CONFIGURATION"
+
+// StateVolumePath is the path in the init and wait container where the volume of the state volume backend is mounted.
+var StateVolumePath = filepath.Join(BasePath, "state-volume")
+
+// StateEncryptionKeyFilename is the name of the file that contains the passphrase to encrypt the state.
+const StateEncryptionKeyFilename = "key"
+
+// StateEncryptionKeyPath is the path to the file in the init and wait container that contains the passphrase to encrypt the state.
+var StateEncryptionKeyPath = filepath.Join(BasePath, "state-encryption", StateEncryptionKeyFilename)
+
 // ConfigurationPathName is the name of the env var that points to the provider configuration file.
 const ConfigurationPathName = "CONFIGURATION_PATH"
 
@@ -134,6 +152,21 @@ const DeployItemNamespaceName = "DEPLOY_ITEM_NAMESPACE"
 // MainContainerName is the name of the container running the user workload.
 const MainContainerName = "main"
 
+// ReservedVolumeNames contains the names of the volumes that are added to the pod by the container deployer.
+// Additional volumes of the provider configuration must not use these names.
+var ReservedVolumeNames = []string{
+	"shared-volume",
+	"serviceaccount-init",
+	"serviceaccount-wait",
+	"configuration",
+	"target",
+	"blueprint-pull-secret",
+	"cd-pull-secret",
+	"state-volume",
+	"state-encryption",
+	"state-push-secret",
+}
+
 // InitContainerName is the name of the container running the init container.
 const InitContainerName = "init"
 
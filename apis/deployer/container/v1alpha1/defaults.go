@@ -32,4 +32,7 @@ func SetDefaults_GarbageCollection(obj *GarbageCollection) {
 	if obj.RequeueTimeSeconds <= 0 {
 		obj.RequeueTimeSeconds = 60
 	}
+	if obj.CapturedLogsRetention <= 0 {
+		obj.CapturedLogsRetention = 5
+	}
 }
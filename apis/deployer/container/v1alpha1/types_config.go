@@ -52,6 +52,10 @@ type Configuration struct {
 	// GarbageCollection configures the container deployer garbage collector.
 	GarbageCollection GarbageCollection `json:"garbageCollection"`
 
+	// PodPolicy restricts the pod settings that deploy items may define in their provider configuration.
+	// +optional
+	PodPolicy PodPolicy `json:"podPolicy,omitempty"`
+
 	// DebugOptions configure additional debug options.
 	DebugOptions *DebugOptions `json:"debug,omitempty"`
 
@@ -97,6 +101,7 @@ type ContainerSpec struct {
 // GarbageCollection defines the container deployer garbage collection configuration.
 type GarbageCollection struct {
 	// Disable disables the garbage collector and the resources clean-up.
+	// The retention of the captured logs is enforced nonetheless.
 	Disable bool `json:"disable"`
 	// Worker defines the number of parallel garbage collection routines.
 	// Defaults to 5.
@@ -104,6 +109,30 @@ type GarbageCollection struct {
 	// RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued.
 	// Defaults to 60.
 	RequeueTimeSeconds int `json:"requeueTimeSeconds"`
+	// CapturedLogsRetention is the number of captured logs of the main container that are kept per deploy item.
+	// Older captured logs are deleted.
+	// Defaults to 5.
+	CapturedLogsRetention int `json:"capturedLogsRetention"`
+}
+
+// PodPolicy defines which pod settings of the provider configuration deploy items are allowed to use.
+// The pods run in the namespace of the container deployer, therefore secrets, configmaps and service accounts
+// of that namespace can only be used by deploy items if they are explicitly allowed.
+type PodPolicy struct {
+	// AllowedServiceAccountNames lists the service accounts that deploy items may run their pods with.
+	// +optional
+	AllowedServiceAccountNames []string `json:"allowedServiceAccountNames,omitempty"`
+	// AllowedSecretNames lists the secrets that deploy items may reference in environment variables and volumes.
+	// +optional
+	AllowedSecretNames []string `json:"allowedSecretNames,omitempty"`
+	// AllowedConfigMapNames lists the configmaps that deploy items may reference in environment variables and volumes.
+	// +optional
+	AllowedConfigMapNames []string `json:"allowedConfigMapNames,omitempty"`
+	// AllowedVolumeTypes lists the volume types in addition to emptyDir, secret and configMap
+	// that deploy items may define, e.g. "persistentVolumeClaim".
+	// HostPath volumes are never allowed.
+	// +optional
+	AllowedVolumeTypes []string `json:"allowedVolumeTypes,omitempty"`
 }
 
 // DebugOptions defines optional debug options.
@@ -45,6 +45,46 @@ type ProviderConfiguration struct {
 	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
 	// +optional
 	Args []string `json:"args,omitempty"`
+	// Resources defines the compute resources of the main container.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Env defines additional environment variables of the main container.
+	// Values could also be read from secrets and configmaps in the namespace of the pod.
+	// The environment variables set by the container deployer cannot be overwritten.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Volumes defines additional volumes of the pod.
+	// The volumes are only mounted into the main container if a respective volume mount is defined.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts defines the mounts of the additional volumes into the main container.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// NodeSelector is a selector which must match a node's labels for the pod to be scheduled on that node.
+	// More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations defines the tolerations of the pod.
+	// More info: https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// SecurityContext defines the security context of the pod.
+	// If not set, the pod runs with user 1000, group 3000 and fs group 2000.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+	// ServiceAccountName is the name of the service account that is used to run the pod.
+	// The token of the service account is not mounted automatically.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// LogCapture configures the capturing of the main container's log after each run.
+	// The log is not captured if this is not provided.
+	// +optional
+	LogCapture *LogCapture `json:"logCapture,omitempty"`
+	// State configures how the state of the main container is stored between runs.
+	// By default, the state is stored in secrets in the host cluster.
+	// +optional
+	State *StateConfiguration `json:"state,omitempty"`
 	// ImportValues contains the import values for the container.
 	// +optional
 	ImportValues json.RawMessage `json:"importValues,omitempty"`
@@ -65,6 +105,90 @@ type ProviderConfiguration struct {
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
 }
 
+// StateBackendType defines the type of the backend that stores the state of the main container.
+type StateBackendType string
+
+const (
+	// StateBackendSecret stores the state in chunked secrets in the host cluster.
+	StateBackendSecret StateBackendType = "Secret"
+	// StateBackendOCI stores the state as oci artifact in a registry.
+	StateBackendOCI StateBackendType = "OCI"
+	// StateBackendVolume stores the state in a persistent volume.
+	StateBackendVolume StateBackendType = "Volume"
+)
+
+// StateConfiguration defines how the state of the main container is stored.
+// Every run stores a new version of the state, and the latest version is restored before the next run.
+type StateConfiguration struct {
+	// Backend is the type of the backend that stores the state.
+	// Can be "Secret", "OCI" or "Volume". Defaults to "Secret".
+	// +optional
+	Backend StateBackendType `json:"backend,omitempty"`
+	// OCI configures the oci backend.
+	// Required if the oci backend is used.
+	// +optional
+	OCI *OCIStateBackend `json:"oci,omitempty"`
+	// Volume configures the volume backend.
+	// Required if the volume backend is used.
+	// +optional
+	Volume *VolumeStateBackend `json:"volume,omitempty"`
+	// Encryption configures the encryption of the state at rest.
+	// +optional
+	Encryption *StateEncryption `json:"encryption,omitempty"`
+	// History is the number of state versions that are kept.
+	// Older versions are deleted when the state is restored. Defaults to 1.
+	// +optional
+	History int32 `json:"history,omitempty"`
+	// RestoreVersion is the version of the state that is restored before the next run.
+	// Defaults to the latest version.
+	// +optional
+	RestoreVersion string `json:"restoreVersion,omitempty"`
+}
+
+// OCIStateBackend configures the backend that stores the state as oci artifact.
+type OCIStateBackend struct {
+	// Repository is the oci repository the state is pushed to, e.g. "example.com/landscaper/states".
+	// The versions of the state of a deploy item are pushed to "<repository>/<deploy item namespace>/<deploy item name>"
+	// with the version as tag.
+	Repository string `json:"repository"`
+}
+
+// VolumeStateBackend configures the backend that stores the state in a persistent volume.
+type VolumeStateBackend struct {
+	// ClaimName is the name of the persistent volume claim in the namespace of the pod.
+	ClaimName string `json:"claimName"`
+}
+
+// StateEncryption configures the encryption of the state.
+type StateEncryption struct {
+	// SecretRef references the passphrase that is used to encrypt the state.
+	// The secret has to exist in the namespace of the deploy item in the landscaper cluster.
+	// The namespace defaults to the namespace of the deploy item.
+	SecretRef lsv1alpha1.SecretReference `json:"secretRef"`
+}
+
+// LogCaptureKind defines the kind of the object the captured log is stored in.
+type LogCaptureKind string
+
+const (
+	// LogCaptureKindSecret stores the captured log in a secret.
+	LogCaptureKindSecret LogCaptureKind = "Secret"
+	// LogCaptureKindConfigMap stores the captured log in a configmap.
+	LogCaptureKindConfigMap LogCaptureKind = "ConfigMap"
+)
+
+// LogCapture defines how the log of the main container is captured.
+type LogCapture struct {
+	// Lines is the number of lines at the end of the main container's log that are captured.
+	// Defaults to 100.
+	// +optional
+	Lines *int64 `json:"lines,omitempty"`
+	// Kind is the kind of the object the captured log is stored in.
+	// Can be "Secret" or "ConfigMap". Defaults to "Secret".
+	// +optional
+	Kind LogCaptureKind `json:"kind,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ProviderStatus is the container provider specific status
@@ -75,6 +199,24 @@ type ProviderStatus struct {
 	LastOperation string `json:"lastOperation"`
 	// PodStatus indicated the status of the executed pod.
 	PodStatus *PodStatus `json:"podStatus,omitempty"`
+	// CapturedLog references the captured log of the main container of the last run.
+	// +optional
+	CapturedLog *CapturedLogReference `json:"capturedLog,omitempty"`
+}
+
+// CapturedLogReference references the object in the namespace of the deploy item
+// that contains the captured log of a run of the main container.
+type CapturedLogReference struct {
+	// Kind is the kind of the object, either "Secret" or "ConfigMap".
+	Kind LogCaptureKind `json:"kind"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace"`
+	// Key is the key of the log in the data of the object.
+	Key string `json:"key"`
+	// JobID is the JobID of the deploy item the run belongs to.
+	JobID string `json:"jobID"`
 }
 
 // PodStatus describes the status of a pod with its init, wait and main container
@@ -5,11 +5,20 @@
 package validation
 
 import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
-	"github.com/gardener/landscaper/apis/core/validation"
+	corevalidation "github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 )
@@ -22,9 +31,268 @@ func ValidateProviderConfiguration(config *containerv1alpha1.ProviderConfigurati
 		if err := lsv1alpha1.Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&secretRef, &coreSecretRef, nil); err != nil {
 			return err
 		}
-		allErrs = append(allErrs, validation.ValidateObjectReference(coreSecretRef, field.NewPath("registryPullSecrets").Index(i))...)
+		allErrs = append(allErrs, corevalidation.ValidateObjectReference(coreSecretRef, field.NewPath("registryPullSecrets").Index(i))...)
 	}
 
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, ValidateEnv(config.Env, field.NewPath("env"))...)
+	allErrs = append(allErrs, ValidateVolumes(config.Volumes, config.VolumeMounts, field.NewPath("volumes"), field.NewPath("volumeMounts"))...)
+	allErrs = append(allErrs, ValidateSecurityContext(config.SecurityContext, field.NewPath("securityContext"))...)
+	allErrs = append(allErrs, ValidateLogCapture(config.LogCapture, field.NewPath("logCapture"))...)
+	allErrs = append(allErrs, ValidateStateConfiguration(config.State, field.NewPath("state"))...)
+
+	if len(config.ServiceAccountName) != 0 {
+		for _, msg := range validation.IsDNS1123Subdomain(config.ServiceAccountName) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("serviceAccountName"), config.ServiceAccountName, msg))
+		}
+	}
 	return allErrs.ToAggregate()
 }
+
+// ValidateStateConfiguration validates the configuration of the state backend.
+func ValidateStateConfiguration(state *containerv1alpha1.StateConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if state == nil {
+		return allErrs
+	}
+
+	switch state.Backend {
+	case "", containerv1alpha1.StateBackendSecret:
+	case containerv1alpha1.StateBackendOCI:
+		if state.OCI == nil || len(state.OCI.Repository) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("oci", "repository"), "must be defined for the oci backend"))
+		}
+	case containerv1alpha1.StateBackendVolume:
+		if state.Volume == nil || len(state.Volume.ClaimName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("volume", "claimName"), "must be defined for the volume backend"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(state.Volume.ClaimName) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("volume", "claimName"), state.Volume.ClaimName, msg))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), state.Backend, []string{
+			string(containerv1alpha1.StateBackendSecret),
+			string(containerv1alpha1.StateBackendOCI),
+			string(containerv1alpha1.StateBackendVolume),
+		}))
+	}
+
+	if state.Encryption != nil {
+		secretRefPath := fldPath.Child("encryption", "secretRef")
+		if len(state.Encryption.SecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(secretRefPath.Child("name"), "must be defined"))
+		}
+		if len(state.Encryption.SecretRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(secretRefPath.Child("key"), "must be defined"))
+		}
+	}
+
+	if state.History < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("history"), state.History, "must not be negative"))
+	}
+	return allErrs
+}
+
+// ValidateLogCapture validates the log capture configuration of the main container.
+func ValidateLogCapture(logCapture *containerv1alpha1.LogCapture, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if logCapture == nil {
+		return allErrs
+	}
+
+	if logCapture.Lines != nil && *logCapture.Lines <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("lines"), *logCapture.Lines, "must be greater than 0"))
+	}
+
+	switch logCapture.Kind {
+	case "", containerv1alpha1.LogCaptureKindSecret, containerv1alpha1.LogCaptureKindConfigMap:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), logCapture.Kind,
+			[]string{string(containerv1alpha1.LogCaptureKindSecret), string(containerv1alpha1.LogCaptureKindConfigMap)}))
+	}
+	return allErrs
+}
+
+// ValidateEnv validates the additional environment variables of the main container.
+// The environment variables that are set by the container deployer must not be overwritten.
+func ValidateEnv(env []corev1.EnvVar, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	reserved := sets.New[string](container.OperationName)
+	for _, envVar := range container.DefaultEnvVars {
+		reserved.Insert(envVar.Name)
+	}
+
+	names := sets.New[string]()
+	for i, envVar := range env {
+		envPath := fldPath.Index(i).Child("name")
+		if len(envVar.Name) == 0 {
+			allErrs = append(allErrs, field.Required(envPath, "must not be empty"))
+			continue
+		}
+		if reserved.Has(envVar.Name) {
+			allErrs = append(allErrs, field.Forbidden(envPath, "environment variable is set by the container deployer"))
+		}
+		if names.Has(envVar.Name) {
+			allErrs = append(allErrs, field.Duplicate(envPath, envVar.Name))
+		}
+		names.Insert(envVar.Name)
+	}
+	return allErrs
+}
+
+// ValidateVolumes validates the additional volumes of the pod and their mounts into the main container.
+func ValidateVolumes(volumes []corev1.Volume, mounts []corev1.VolumeMount, volPath, mountPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	reserved := sets.New[string](container.ReservedVolumeNames...)
+	names := sets.New[string]()
+	for i, vol := range volumes {
+		namePath := volPath.Index(i).Child("name")
+		if len(vol.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must not be empty"))
+			continue
+		}
+		if reserved.Has(vol.Name) {
+			allErrs = append(allErrs, field.Forbidden(namePath, "volume name is used by the container deployer"))
+		}
+		if names.Has(vol.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, vol.Name))
+		}
+		names.Insert(vol.Name)
+		if vol.HostPath != nil {
+			allErrs = append(allErrs, field.Forbidden(volPath.Index(i).Child("hostPath"), "hostPath volumes are not allowed"))
+		}
+	}
+
+	for i, mount := range mounts {
+		if !names.Has(mount.Name) {
+			allErrs = append(allErrs, field.NotFound(mountPath.Index(i).Child("name"), mount.Name))
+		}
+		if len(mount.MountPath) == 0 {
+			allErrs = append(allErrs, field.Required(mountPath.Index(i).Child("mountPath"), "must not be empty"))
+			continue
+		}
+		if isSubPath(container.SharedBasePath, mount.MountPath) {
+			allErrs = append(allErrs, field.Forbidden(mountPath.Index(i).Child("mountPath"),
+				"must not be located in the shared directory "+container.SharedBasePath))
+		}
+	}
+	return allErrs
+}
+
+// isSubPath checks whether path is equal to or located below the given base path.
+func isSubPath(base, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(base), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// ValidateSecurityContext validates the pod security context of the provider configuration.
+// The pod must not run as root.
+func ValidateSecurityContext(secCtx *corev1.PodSecurityContext, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if secCtx == nil {
+		return allErrs
+	}
+	if secCtx.RunAsUser != nil && *secCtx.RunAsUser == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsUser"), "the pod must not run as root"))
+	}
+	if secCtx.RunAsGroup != nil && *secCtx.RunAsGroup == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsGroup"), "the pod must not run with the root group"))
+	}
+	if secCtx.RunAsNonRoot != nil && !*secCtx.RunAsNonRoot {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsNonRoot"), "the pod must not run as root"))
+	}
+	return allErrs
+}
+
+// defaultAllowedVolumeTypes are the volume types that are always allowed.
+var defaultAllowedVolumeTypes = []string{"emptyDir", "secret", "configMap"}
+
+// ValidatePodPolicy validates that the pod settings of the provider configuration are allowed by the pod policy
+// of the container deployer.
+func ValidatePodPolicy(config *containerv1alpha1.ProviderConfiguration, policy containerv1alpha1.PodPolicy) error {
+	allErrs := field.ErrorList{}
+
+	allowedSecrets := sets.New[string](policy.AllowedSecretNames...)
+	allowedConfigMaps := sets.New[string](policy.AllowedConfigMapNames...)
+
+	if len(config.ServiceAccountName) != 0 && !sets.New[string](policy.AllowedServiceAccountNames...).Has(config.ServiceAccountName) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("serviceAccountName"),
+			"service account is not allowed by the pod policy of the container deployer"))
+	}
+
+	envPath := field.NewPath("env")
+	for i, envVar := range config.Env {
+		if envVar.ValueFrom == nil {
+			continue
+		}
+		valueFromPath := envPath.Index(i).Child("valueFrom")
+		if ref := envVar.ValueFrom.SecretKeyRef; ref != nil && !allowedSecrets.Has(ref.Name) {
+			allErrs = append(allErrs, field.Forbidden(valueFromPath.Child("secretKeyRef", "name"),
+				"secret is not allowed by the pod policy of the container deployer"))
+		}
+		if ref := envVar.ValueFrom.ConfigMapKeyRef; ref != nil && !allowedConfigMaps.Has(ref.Name) {
+			allErrs = append(allErrs, field.Forbidden(valueFromPath.Child("configMapKeyRef", "name"),
+				"configmap is not allowed by the pod policy of the container deployer"))
+		}
+	}
+
+	allowedVolumeTypes := sets.New[string](defaultAllowedVolumeTypes...).Insert(policy.AllowedVolumeTypes...).Delete("hostPath")
+	volPath := field.NewPath("volumes")
+	for i, vol := range config.Volumes {
+		volumeType := VolumeSourceType(vol.VolumeSource)
+		if !allowedVolumeTypes.Has(volumeType) {
+			allErrs = append(allErrs, field.Forbidden(volPath.Index(i),
+				fmt.Sprintf("volume type %q is not allowed by the pod policy of the container deployer", volumeType)))
+			continue
+		}
+		var (
+			secretNames    []string
+			configMapNames []string
+		)
+		if vol.Secret != nil {
+			secretNames = append(secretNames, vol.Secret.SecretName)
+		}
+		if vol.ConfigMap != nil {
+			configMapNames = append(configMapNames, vol.ConfigMap.Name)
+		}
+		if vol.Projected != nil {
+			for _, source := range vol.Projected.Sources {
+				if source.Secret != nil {
+					secretNames = append(secretNames, source.Secret.Name)
+				}
+				if source.ConfigMap != nil {
+					configMapNames = append(configMapNames, source.ConfigMap.Name)
+				}
+			}
+		}
+		for _, name := range secretNames {
+			if !allowedSecrets.Has(name) {
+				allErrs = append(allErrs, field.Forbidden(volPath.Index(i),
+					fmt.Sprintf("secret %q is not allowed by the pod policy of the container deployer", name)))
+			}
+		}
+		for _, name := range configMapNames {
+			if !allowedConfigMaps.Has(name) {
+				allErrs = append(allErrs, field.Forbidden(volPath.Index(i),
+					fmt.Sprintf("configmap %q is not allowed by the pod policy of the container deployer", name)))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// VolumeSourceType returns the json name of the volume source that is set, e.g. "emptyDir" or "hostPath".
+func VolumeSourceType(source corev1.VolumeSource) string {
+	val := reflect.ValueOf(source)
+	for i := 0; i < val.NumField(); i++ {
+		if val.Field(i).IsNil() {
+			continue
+		}
+		return strings.Split(val.Type().Field(i).Tag.Get("json"), ",")[0]
+	}
+	return ""
+}
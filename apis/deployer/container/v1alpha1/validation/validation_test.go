@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container/v1alpha1/validation"
+)
+
+var _ = Describe("ProviderConfiguration", func() {
+
+	It("should accept additional env vars, volumes and pod settings", func() {
+		config := &containerv1alpha1.ProviderConfiguration{
+			Image: "example.com/terraform:v1",
+			Env: []corev1.EnvVar{
+				{Name: "TF_LOG", Value: "debug"},
+				{
+					Name: "TOKEN",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "cache", MountPath: "/cache"},
+			},
+			ServiceAccountName: "terraform",
+		}
+		Expect(validation.ValidateProviderConfiguration(config)).To(Succeed())
+	})
+
+	Context("Env", func() {
+		It("should reject env vars that are set by the container deployer", func() {
+			allErrs := validation.ValidateEnv([]corev1.EnvVar{
+				{Name: container.OperationName, Value: "DELETE"},
+				{Name: container.ImportsPathName, Value: "/tmp"},
+			}, field.NewPath("env"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("env[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("env[1].name"),
+				})),
+			))
+		})
+
+		It("should reject duplicate and empty env vars", func() {
+			allErrs := validation.ValidateEnv([]corev1.EnvVar{
+				{Name: "A"},
+				{Name: "A"},
+				{Value: "b"},
+			}, field.NewPath("env"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("env[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("env[2].name"),
+				})),
+			))
+		})
+	})
+
+	Context("Volumes", func() {
+		It("should reject volumes with reserved or duplicate names", func() {
+			allErrs := validation.ValidateVolumes([]corev1.Volume{
+				{Name: "shared-volume"},
+				{Name: "cache"},
+				{Name: "cache"},
+			}, nil, field.NewPath("volumes"), field.NewPath("volumeMounts"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("volumes[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("volumes[2].name"),
+				})),
+			))
+		})
+
+		It("should reject mounts of unknown volumes", func() {
+			allErrs := validation.ValidateVolumes(nil, []corev1.VolumeMount{
+				{Name: "configuration", MountPath: "/config"},
+			}, field.NewPath("volumes"), field.NewPath("volumeMounts"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("volumeMounts[0].name"),
+			}))))
+		})
+
+		It("should reject mounts into the shared directory", func() {
+			allErrs := validation.ValidateVolumes([]corev1.Volume{{Name: "cache"}}, []corev1.VolumeMount{
+				{Name: "cache", MountPath: container.SharedBasePath},
+				{Name: "cache", MountPath: container.StatePath},
+				{Name: "cache", MountPath: "/data"},
+			}, field.NewPath("volumes"), field.NewPath("volumeMounts"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("volumeMounts[0].mountPath"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("volumeMounts[1].mountPath"),
+				})),
+			))
+		})
+
+		It("should reject hostPath volumes", func() {
+			allErrs := validation.ValidateVolumes([]corev1.Volume{
+				{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}}},
+			}, nil, field.NewPath("volumes"), field.NewPath("volumeMounts"))
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumes[0].hostPath"),
+			}))))
+		})
+	})
+
+	Context("SecurityContext", func() {
+		It("should accept a non-root security context", func() {
+			Expect(validation.ValidateSecurityContext(&corev1.PodSecurityContext{
+				RunAsUser:  pointer.Int64(1001),
+				RunAsGroup: pointer.Int64(1001),
+			}, field.NewPath("securityContext"))).To(BeEmpty())
+		})
+
+		It("should reject root user and group ids", func() {
+			allErrs := validation.ValidateSecurityContext(&corev1.PodSecurityContext{
+				RunAsUser:    pointer.Int64(0),
+				RunAsGroup:   pointer.Int64(0),
+				RunAsNonRoot: pointer.Bool(false),
+			}, field.NewPath("securityContext"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("securityContext.runAsUser"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("securityContext.runAsGroup"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("securityContext.runAsNonRoot"),
+				})),
+			))
+		})
+	})
+
+	Context("PodPolicy", func() {
+		var config *containerv1alpha1.ProviderConfiguration
+
+		BeforeEach(func() {
+			config = &containerv1alpha1.ProviderConfiguration{
+				Env: []corev1.EnvVar{
+					{
+						Name: "TOKEN",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"},
+								Key:                  "token",
+							},
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"},
+					}}},
+					{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "data",
+					}}},
+				},
+				ServiceAccountName: "terraform",
+			}
+		})
+
+		It("should reject service accounts, secrets, configmaps and volume types that are not allowed", func() {
+			err := validation.ValidatePodPolicy(config, containerv1alpha1.PodPolicy{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("serviceAccountName"))
+			Expect(err.Error()).To(ContainSubstring("env[0].valueFrom.secretKeyRef.name"))
+			Expect(err.Error()).To(ContainSubstring(`configmap "my-config"`))
+			Expect(err.Error()).To(ContainSubstring(`volume type "persistentVolumeClaim"`))
+		})
+
+		It("should accept settings that are allowed by the pod policy", func() {
+			Expect(validation.ValidatePodPolicy(config, containerv1alpha1.PodPolicy{
+				AllowedServiceAccountNames: []string{"terraform"},
+				AllowedSecretNames:         []string{"my-secret"},
+				AllowedConfigMapNames:      []string{"my-config"},
+				AllowedVolumeTypes:         []string{"persistentVolumeClaim"},
+			})).To(Succeed())
+		})
+
+		It("should never allow hostPath volumes", func() {
+			config = &containerv1alpha1.ProviderConfiguration{
+				Volumes: []corev1.Volume{
+					{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}}},
+				},
+			}
+			Expect(validation.ValidatePodPolicy(config, containerv1alpha1.PodPolicy{
+				AllowedVolumeTypes: []string{"hostPath"},
+			})).To(HaveOccurred())
+		})
+	})
+
+	It("should reject an invalid service account name", func() {
+		config := &containerv1alpha1.ProviderConfiguration{
+			ServiceAccountName: "Invalid_Name",
+		}
+		Expect(validation.ValidateProviderConfiguration(config)).To(HaveOccurred())
+	})
+
+	Context("LogCapture", func() {
+		It("should accept a log capture configuration", func() {
+			lines := int64(50)
+			logCapture := &containerv1alpha1.LogCapture{
+				Lines: &lines,
+				Kind:  containerv1alpha1.LogCaptureKindConfigMap,
+			}
+			Expect(validation.ValidateLogCapture(logCapture, field.NewPath("logCapture"))).To(BeEmpty())
+			Expect(validation.ValidateLogCapture(&containerv1alpha1.LogCapture{}, field.NewPath("logCapture"))).To(BeEmpty())
+		})
+
+		It("should reject a non-positive number of lines and an unknown kind", func() {
+			lines := int64(0)
+			logCapture := &containerv1alpha1.LogCapture{
+				Lines: &lines,
+				Kind:  "Pod",
+			}
+			errList := validation.ValidateLogCapture(logCapture, field.NewPath("logCapture"))
+			Expect(errList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("logCapture.lines"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("logCapture.kind"),
+				})),
+			))
+		})
+	})
+
+	Context("State", func() {
+		It("should accept the state backends", func() {
+			states := []*containerv1alpha1.StateConfiguration{
+				{},
+				{Backend: containerv1alpha1.StateBackendSecret, History: 3},
+				{
+					Backend: containerv1alpha1.StateBackendOCI,
+					OCI:     &containerv1alpha1.OCIStateBackend{Repository: "example.com/landscaper/states"},
+				},
+				{
+					Backend: containerv1alpha1.StateBackendVolume,
+					Volume:  &containerv1alpha1.VolumeStateBackend{ClaimName: "states"},
+					Encryption: &containerv1alpha1.StateEncryption{
+						SecretRef: lsv1alpha1.SecretReference{
+							ObjectReference: lsv1alpha1.ObjectReference{Name: "state-key", Namespace: "default"},
+							Key:             "passphrase",
+						},
+					},
+				},
+			}
+			for _, state := range states {
+				Expect(validation.ValidateStateConfiguration(state, field.NewPath("state"))).To(BeEmpty())
+			}
+		})
+
+		It("should reject backends without their configuration", func() {
+			errList := validation.ValidateStateConfiguration(&containerv1alpha1.StateConfiguration{
+				Backend: containerv1alpha1.StateBackendOCI,
+			}, field.NewPath("state"))
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("state.oci.repository"),
+			}))))
+
+			errList = validation.ValidateStateConfiguration(&containerv1alpha1.StateConfiguration{
+				Backend: containerv1alpha1.StateBackendVolume,
+			}, field.NewPath("state"))
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("state.volume.claimName"),
+			}))))
+		})
+
+		It("should reject an unknown backend, an incomplete encryption and a negative history", func() {
+			errList := validation.ValidateStateConfiguration(&containerv1alpha1.StateConfiguration{
+				Backend:    "S3",
+				Encryption: &containerv1alpha1.StateEncryption{},
+				History:    -1,
+			}, field.NewPath("state"))
+			Expect(errList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("state.backend"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("state.encryption.secretRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("state.encryption.secretRef.key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("state.history"),
+				})),
+			))
+		})
+	})
+})
@@ -32,6 +32,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CapturedLogReference)(nil), (*container.CapturedLogReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CapturedLogReference_To_container_CapturedLogReference(a.(*CapturedLogReference), b.(*container.CapturedLogReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.CapturedLogReference)(nil), (*CapturedLogReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_CapturedLogReference_To_v1alpha1_CapturedLogReference(a.(*container.CapturedLogReference), b.(*CapturedLogReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*container.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Configuration_To_container_Configuration(a.(*Configuration), b.(*container.Configuration), scope)
 	}); err != nil {
@@ -92,6 +102,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LogCapture)(nil), (*container.LogCapture)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LogCapture_To_container_LogCapture(a.(*LogCapture), b.(*container.LogCapture), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.LogCapture)(nil), (*LogCapture)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_LogCapture_To_v1alpha1_LogCapture(a.(*container.LogCapture), b.(*LogCapture), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OCIStateBackend)(nil), (*container.OCIStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCIStateBackend_To_container_OCIStateBackend(a.(*OCIStateBackend), b.(*container.OCIStateBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.OCIStateBackend)(nil), (*OCIStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_OCIStateBackend_To_v1alpha1_OCIStateBackend(a.(*container.OCIStateBackend), b.(*OCIStateBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodStatus)(nil), (*container.PodStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodStatus_To_container_PodStatus(a.(*PodStatus), b.(*container.PodStatus), scope)
 	}); err != nil {
@@ -102,6 +132,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodPolicy)(nil), (*container.PodPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodPolicy_To_container_PodPolicy(a.(*PodPolicy), b.(*container.PodPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.PodPolicy)(nil), (*PodPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_PodPolicy_To_v1alpha1_PodPolicy(a.(*container.PodPolicy), b.(*PodPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*container.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_container_ProviderConfiguration(a.(*ProviderConfiguration), b.(*container.ProviderConfiguration), scope)
 	}); err != nil {
@@ -122,9 +162,67 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StateConfiguration)(nil), (*container.StateConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration(a.(*StateConfiguration), b.(*container.StateConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.StateConfiguration)(nil), (*StateConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration(a.(*container.StateConfiguration), b.(*StateConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StateEncryption)(nil), (*container.StateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StateEncryption_To_container_StateEncryption(a.(*StateEncryption), b.(*container.StateEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.StateEncryption)(nil), (*StateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_StateEncryption_To_v1alpha1_StateEncryption(a.(*container.StateEncryption), b.(*StateEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeStateBackend)(nil), (*container.VolumeStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeStateBackend_To_container_VolumeStateBackend(a.(*VolumeStateBackend), b.(*container.VolumeStateBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.VolumeStateBackend)(nil), (*VolumeStateBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_VolumeStateBackend_To_v1alpha1_VolumeStateBackend(a.(*container.VolumeStateBackend), b.(*VolumeStateBackend), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
+func autoConvert_v1alpha1_CapturedLogReference_To_container_CapturedLogReference(in *CapturedLogReference, out *container.CapturedLogReference, s conversion.Scope) error {
+	out.Kind = container.LogCaptureKind(in.Kind)
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Key = in.Key
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_v1alpha1_CapturedLogReference_To_container_CapturedLogReference is an autogenerated conversion function.
+func Convert_v1alpha1_CapturedLogReference_To_container_CapturedLogReference(in *CapturedLogReference, out *container.CapturedLogReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CapturedLogReference_To_container_CapturedLogReference(in, out, s)
+}
+
+func autoConvert_container_CapturedLogReference_To_v1alpha1_CapturedLogReference(in *container.CapturedLogReference, out *CapturedLogReference, s conversion.Scope) error {
+	out.Kind = LogCaptureKind(in.Kind)
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Key = in.Key
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_container_CapturedLogReference_To_v1alpha1_CapturedLogReference is an autogenerated conversion function.
+func Convert_container_CapturedLogReference_To_v1alpha1_CapturedLogReference(in *container.CapturedLogReference, out *CapturedLogReference, s conversion.Scope) error {
+	return autoConvert_container_CapturedLogReference_To_v1alpha1_CapturedLogReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configuration, out *container.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
@@ -142,6 +240,9 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	if err := Convert_v1alpha1_GarbageCollection_To_container_GarbageCollection(&in.GarbageCollection, &out.GarbageCollection, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_PodPolicy_To_container_PodPolicy(&in.PodPolicy, &out.PodPolicy, s); err != nil {
+		return err
+	}
 	out.DebugOptions = (*container.DebugOptions)(unsafe.Pointer(in.DebugOptions))
 	if err := Convert_v1alpha1_Controller_To_container_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
@@ -171,6 +272,9 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	if err := Convert_container_GarbageCollection_To_v1alpha1_GarbageCollection(&in.GarbageCollection, &out.GarbageCollection, s); err != nil {
 		return err
 	}
+	if err := Convert_container_PodPolicy_To_v1alpha1_PodPolicy(&in.PodPolicy, &out.PodPolicy, s); err != nil {
+		return err
+	}
 	out.DebugOptions = (*DebugOptions)(unsafe.Pointer(in.DebugOptions))
 	if err := Convert_container_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
@@ -283,6 +387,7 @@ func autoConvert_v1alpha1_GarbageCollection_To_container_GarbageCollection(in *G
 	out.Disable = in.Disable
 	out.Worker = in.Worker
 	out.RequeueTimeSeconds = in.RequeueTimeSeconds
+	out.CapturedLogsRetention = in.CapturedLogsRetention
 	return nil
 }
 
@@ -295,6 +400,7 @@ func autoConvert_container_GarbageCollection_To_v1alpha1_GarbageCollection(in *c
 	out.Disable = in.Disable
 	out.Worker = in.Worker
 	out.RequeueTimeSeconds = in.RequeueTimeSeconds
+	out.CapturedLogsRetention = in.CapturedLogsRetention
 	return nil
 }
 
@@ -303,6 +409,48 @@ func Convert_container_GarbageCollection_To_v1alpha1_GarbageCollection(in *conta
 	return autoConvert_container_GarbageCollection_To_v1alpha1_GarbageCollection(in, out, s)
 }
 
+func autoConvert_v1alpha1_LogCapture_To_container_LogCapture(in *LogCapture, out *container.LogCapture, s conversion.Scope) error {
+	out.Lines = (*int64)(unsafe.Pointer(in.Lines))
+	out.Kind = container.LogCaptureKind(in.Kind)
+	return nil
+}
+
+// Convert_v1alpha1_LogCapture_To_container_LogCapture is an autogenerated conversion function.
+func Convert_v1alpha1_LogCapture_To_container_LogCapture(in *LogCapture, out *container.LogCapture, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LogCapture_To_container_LogCapture(in, out, s)
+}
+
+func autoConvert_container_LogCapture_To_v1alpha1_LogCapture(in *container.LogCapture, out *LogCapture, s conversion.Scope) error {
+	out.Lines = (*int64)(unsafe.Pointer(in.Lines))
+	out.Kind = LogCaptureKind(in.Kind)
+	return nil
+}
+
+// Convert_container_LogCapture_To_v1alpha1_LogCapture is an autogenerated conversion function.
+func Convert_container_LogCapture_To_v1alpha1_LogCapture(in *container.LogCapture, out *LogCapture, s conversion.Scope) error {
+	return autoConvert_container_LogCapture_To_v1alpha1_LogCapture(in, out, s)
+}
+
+func autoConvert_v1alpha1_OCIStateBackend_To_container_OCIStateBackend(in *OCIStateBackend, out *container.OCIStateBackend, s conversion.Scope) error {
+	out.Repository = in.Repository
+	return nil
+}
+
+// Convert_v1alpha1_OCIStateBackend_To_container_OCIStateBackend is an autogenerated conversion function.
+func Convert_v1alpha1_OCIStateBackend_To_container_OCIStateBackend(in *OCIStateBackend, out *container.OCIStateBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCIStateBackend_To_container_OCIStateBackend(in, out, s)
+}
+
+func autoConvert_container_OCIStateBackend_To_v1alpha1_OCIStateBackend(in *container.OCIStateBackend, out *OCIStateBackend, s conversion.Scope) error {
+	out.Repository = in.Repository
+	return nil
+}
+
+// Convert_container_OCIStateBackend_To_v1alpha1_OCIStateBackend is an autogenerated conversion function.
+func Convert_container_OCIStateBackend_To_v1alpha1_OCIStateBackend(in *container.OCIStateBackend, out *OCIStateBackend, s conversion.Scope) error {
+	return autoConvert_container_OCIStateBackend_To_v1alpha1_OCIStateBackend(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *container.PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
@@ -345,10 +493,46 @@ func Convert_container_PodStatus_To_v1alpha1_PodStatus(in *container.PodStatus,
 	return autoConvert_container_PodStatus_To_v1alpha1_PodStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodPolicy_To_container_PodPolicy(in *PodPolicy, out *container.PodPolicy, s conversion.Scope) error {
+	out.AllowedServiceAccountNames = *(*[]string)(unsafe.Pointer(&in.AllowedServiceAccountNames))
+	out.AllowedSecretNames = *(*[]string)(unsafe.Pointer(&in.AllowedSecretNames))
+	out.AllowedConfigMapNames = *(*[]string)(unsafe.Pointer(&in.AllowedConfigMapNames))
+	out.AllowedVolumeTypes = *(*[]string)(unsafe.Pointer(&in.AllowedVolumeTypes))
+	return nil
+}
+
+// Convert_v1alpha1_PodPolicy_To_container_PodPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_PodPolicy_To_container_PodPolicy(in *PodPolicy, out *container.PodPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodPolicy_To_container_PodPolicy(in, out, s)
+}
+
+func autoConvert_container_PodPolicy_To_v1alpha1_PodPolicy(in *container.PodPolicy, out *PodPolicy, s conversion.Scope) error {
+	out.AllowedServiceAccountNames = *(*[]string)(unsafe.Pointer(&in.AllowedServiceAccountNames))
+	out.AllowedSecretNames = *(*[]string)(unsafe.Pointer(&in.AllowedSecretNames))
+	out.AllowedConfigMapNames = *(*[]string)(unsafe.Pointer(&in.AllowedConfigMapNames))
+	out.AllowedVolumeTypes = *(*[]string)(unsafe.Pointer(&in.AllowedVolumeTypes))
+	return nil
+}
+
+// Convert_container_PodPolicy_To_v1alpha1_PodPolicy is an autogenerated conversion function.
+func Convert_container_PodPolicy_To_v1alpha1_PodPolicy(in *container.PodPolicy, out *PodPolicy, s conversion.Scope) error {
+	return autoConvert_container_PodPolicy_To_v1alpha1_PodPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfiguration(in *ProviderConfiguration, out *container.ProviderConfiguration, s conversion.Scope) error {
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.Volumes = *(*[]v1.Volume)(unsafe.Pointer(&in.Volumes))
+	out.VolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.VolumeMounts))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.SecurityContext = (*v1.PodSecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.ServiceAccountName = in.ServiceAccountName
+	out.LogCapture = (*container.LogCapture)(unsafe.Pointer(in.LogCapture))
+	out.State = (*container.StateConfiguration)(unsafe.Pointer(in.State))
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -366,6 +550,16 @@ func autoConvert_container_ProviderConfiguration_To_v1alpha1_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.Volumes = *(*[]v1.Volume)(unsafe.Pointer(&in.Volumes))
+	out.VolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.VolumeMounts))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.SecurityContext = (*v1.PodSecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.ServiceAccountName = in.ServiceAccountName
+	out.LogCapture = (*LogCapture)(unsafe.Pointer(in.LogCapture))
+	out.State = (*StateConfiguration)(unsafe.Pointer(in.State))
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -382,6 +576,7 @@ func Convert_container_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(i
 func autoConvert_v1alpha1_ProviderStatus_To_container_ProviderStatus(in *ProviderStatus, out *container.ProviderStatus, s conversion.Scope) error {
 	out.LastOperation = in.LastOperation
 	out.PodStatus = (*container.PodStatus)(unsafe.Pointer(in.PodStatus))
+	out.CapturedLog = (*container.CapturedLogReference)(unsafe.Pointer(in.CapturedLog))
 	return nil
 }
 
@@ -393,6 +588,7 @@ func Convert_v1alpha1_ProviderStatus_To_container_ProviderStatus(in *ProviderSta
 func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.LastOperation = in.LastOperation
 	out.PodStatus = (*PodStatus)(unsafe.Pointer(in.PodStatus))
+	out.CapturedLog = (*CapturedLogReference)(unsafe.Pointer(in.CapturedLog))
 	return nil
 }
 
@@ -400,3 +596,73 @@ func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *contain
 func Convert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	return autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_StateConfiguration_To_container_StateConfiguration(in *StateConfiguration, out *container.StateConfiguration, s conversion.Scope) error {
+	out.Backend = container.StateBackendType(in.Backend)
+	out.OCI = (*container.OCIStateBackend)(unsafe.Pointer(in.OCI))
+	out.Volume = (*container.VolumeStateBackend)(unsafe.Pointer(in.Volume))
+	out.Encryption = (*container.StateEncryption)(unsafe.Pointer(in.Encryption))
+	out.History = in.History
+	out.RestoreVersion = in.RestoreVersion
+	return nil
+}
+
+// Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_StateConfiguration_To_container_StateConfiguration(in *StateConfiguration, out *container.StateConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StateConfiguration_To_container_StateConfiguration(in, out, s)
+}
+
+func autoConvert_container_StateConfiguration_To_v1alpha1_StateConfiguration(in *container.StateConfiguration, out *StateConfiguration, s conversion.Scope) error {
+	out.Backend = StateBackendType(in.Backend)
+	out.OCI = (*OCIStateBackend)(unsafe.Pointer(in.OCI))
+	out.Volume = (*VolumeStateBackend)(unsafe.Pointer(in.Volume))
+	out.Encryption = (*StateEncryption)(unsafe.Pointer(in.Encryption))
+	out.History = in.History
+	out.RestoreVersion = in.RestoreVersion
+	return nil
+}
+
+// Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration is an autogenerated conversion function.
+func Convert_container_StateConfiguration_To_v1alpha1_StateConfiguration(in *container.StateConfiguration, out *StateConfiguration, s conversion.Scope) error {
+	return autoConvert_container_StateConfiguration_To_v1alpha1_StateConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_StateEncryption_To_container_StateEncryption(in *StateEncryption, out *container.StateEncryption, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_StateEncryption_To_container_StateEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_StateEncryption_To_container_StateEncryption(in *StateEncryption, out *container.StateEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StateEncryption_To_container_StateEncryption(in, out, s)
+}
+
+func autoConvert_container_StateEncryption_To_v1alpha1_StateEncryption(in *container.StateEncryption, out *StateEncryption, s conversion.Scope) error {
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_container_StateEncryption_To_v1alpha1_StateEncryption is an autogenerated conversion function.
+func Convert_container_StateEncryption_To_v1alpha1_StateEncryption(in *container.StateEncryption, out *StateEncryption, s conversion.Scope) error {
+	return autoConvert_container_StateEncryption_To_v1alpha1_StateEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeStateBackend_To_container_VolumeStateBackend(in *VolumeStateBackend, out *container.VolumeStateBackend, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	return nil
+}
+
+// Convert_v1alpha1_VolumeStateBackend_To_container_VolumeStateBackend is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeStateBackend_To_container_VolumeStateBackend(in *VolumeStateBackend, out *container.VolumeStateBackend, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeStateBackend_To_container_VolumeStateBackend(in, out, s)
+}
+
+func autoConvert_container_VolumeStateBackend_To_v1alpha1_VolumeStateBackend(in *container.VolumeStateBackend, out *VolumeStateBackend, s conversion.Scope) error {
+	out.ClaimName = in.ClaimName
+	return nil
+}
+
+// Convert_container_VolumeStateBackend_To_v1alpha1_VolumeStateBackend is an autogenerated conversion function.
+func Convert_container_VolumeStateBackend_To_v1alpha1_VolumeStateBackend(in *container.VolumeStateBackend, out *VolumeStateBackend, s conversion.Scope) error {
+	return autoConvert_container_VolumeStateBackend_To_v1alpha1_VolumeStateBackend(in, out, s)
+}
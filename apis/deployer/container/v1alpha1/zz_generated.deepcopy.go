@@ -13,6 +13,7 @@ package v1alpha1
 import (
 	json "encoding/json"
 
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
@@ -20,6 +21,22 @@ import (
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapturedLogReference) DeepCopyInto(out *CapturedLogReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapturedLogReference.
+func (in *CapturedLogReference) DeepCopy() *CapturedLogReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CapturedLogReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -40,6 +57,7 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 	in.InitContainer.DeepCopyInto(&out.InitContainer)
 	in.WaitContainer.DeepCopyInto(&out.WaitContainer)
 	out.GarbageCollection = in.GarbageCollection
+	in.PodPolicy.DeepCopyInto(&out.PodPolicy)
 	if in.DebugOptions != nil {
 		in, out := &in.DebugOptions, &out.DebugOptions
 		*out = new(DebugOptions)
@@ -164,6 +182,43 @@ func (in *GarbageCollection) DeepCopy() *GarbageCollection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCapture) DeepCopyInto(out *LogCapture) {
+	*out = *in
+	if in.Lines != nil {
+		in, out := &in.Lines, &out.Lines
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCapture.
+func (in *LogCapture) DeepCopy() *LogCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIStateBackend) DeepCopyInto(out *OCIStateBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIStateBackend.
+func (in *OCIStateBackend) DeepCopy() *OCIStateBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIStateBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodStatus) DeepCopyInto(out *PodStatus) {
 	*out = *in
@@ -192,6 +247,42 @@ func (in *PodStatus) DeepCopy() *PodStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPolicy) DeepCopyInto(out *PodPolicy) {
+	*out = *in
+	if in.AllowedServiceAccountNames != nil {
+		in, out := &in.AllowedServiceAccountNames, &out.AllowedServiceAccountNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSecretNames != nil {
+		in, out := &in.AllowedSecretNames, &out.AllowedSecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedConfigMapNames != nil {
+		in, out := &in.AllowedConfigMapNames, &out.AllowedConfigMapNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedVolumeTypes != nil {
+		in, out := &in.AllowedVolumeTypes, &out.AllowedVolumeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPolicy.
+func (in *PodPolicy) DeepCopy() *PodPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -206,6 +297,61 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogCapture != nil {
+		in, out := &in.LogCapture, &out.LogCapture
+		*out = new(LogCapture)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(StateConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ImportValues != nil {
 		in, out := &in.ImportValues, &out.ImportValues
 		*out = make(json.RawMessage, len(*in))
@@ -261,6 +407,11 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 		*out = new(PodStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapturedLog != nil {
+		in, out := &in.CapturedLog, &out.CapturedLog
+		*out = new(CapturedLogReference)
+		**out = **in
+	}
 	return
 }
 
@@ -281,3 +432,67 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateConfiguration) DeepCopyInto(out *StateConfiguration) {
+	*out = *in
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIStateBackend)
+		**out = **in
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(VolumeStateBackend)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(StateEncryption)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateConfiguration.
+func (in *StateConfiguration) DeepCopy() *StateConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StateConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateEncryption) DeepCopyInto(out *StateEncryption) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateEncryption.
+func (in *StateEncryption) DeepCopy() *StateEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(StateEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStateBackend) DeepCopyInto(out *VolumeStateBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStateBackend.
+func (in *VolumeStateBackend) DeepCopy() *VolumeStateBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStateBackend)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -13,6 +13,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 
 	lscore "github.com/gardener/landscaper/apis/core"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
@@ -74,6 +75,11 @@ type ProviderConfiguration struct {
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
 
+	// DriftDetection configures the periodic detection of changes to the managed resources
+	// that were made outside of the landscaper.
+	// +optional
+	DriftDetection *dd.DriftDetectionSpec `json:"driftDetection,omitempty"`
+
 	// HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating.
 	// Default is true.
 	// +optional
@@ -82,6 +88,52 @@ type ProviderConfiguration struct {
 	// HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.
 	// +optional
 	HelmDeploymentConfig *HelmDeploymentConfiguration `json:"helmDeploymentConfig,omitempty"`
+
+	// DryRun defines that the rendered manifests are only applied with a server-side dry-run.
+	// The computed changes are written into the provider status instead of installing or upgrading the chart.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// RollbackPolicy configures when the release is rolled back to its last successful revision.
+	// Only relevant if HelmDeployment is true.
+	// +optional
+	RollbackPolicy *RollbackPolicy `json:"rollbackPolicy,omitempty"`
+
+	// Tests configures the execution of the test hooks of the chart after an install or upgrade.
+	// The result of the tests is part of the readiness of the deploy item.
+	// Only relevant if HelmDeployment is true.
+	// +optional
+	Tests *TestConfiguration `json:"tests,omitempty"`
+}
+
+// TestConfiguration defines how the test hooks of a helm chart are executed.
+type TestConfiguration struct {
+	// Enabled defines that the test hooks of the chart are executed after the readiness checks succeeded.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Timeout is the time to wait for each test hook to complete.
+	// Defaults to 5 minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+
+	// Filter restricts the executed test hooks to the hooks with the given names.
+	// All test hooks are executed if empty.
+	// +optional
+	Filter []string `json:"filter,omitempty"`
+}
+
+// RollbackPolicy defines when a helm release is rolled back to its last successful revision.
+type RollbackPolicy struct {
+	// OnReadinessFailure defines that the release is rolled back to its last successful revision
+	// if the readiness checks fail after an upgrade.
+	// +optional
+	OnReadinessFailure bool `json:"onReadinessFailure,omitempty"`
+
+	// Timeout is the timeout of the rollback operation.
+	// Defaults to 5 minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -174,6 +226,54 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+
+	// DryRunResult contains the changes computed by the last dry-run.
+	// +optional
+	DryRunResult *managedresource.DryRunResult `json:"dryRunResult,omitempty"`
+
+	// Release contains the live revision and the revision history of the helm release.
+	// Only set if HelmDeployment is true.
+	// +optional
+	Release *ReleaseStatus `json:"release,omitempty"`
+}
+
+// ReleaseStatus describes the live revision and the revision history of a helm release.
+type ReleaseStatus struct {
+	// Revision is the live revision of the release.
+	Revision int `json:"revision,omitempty"`
+
+	// LastSuccessfulRevision is the last revision of the release whose resources passed the readiness checks.
+	// +optional
+	LastSuccessfulRevision int `json:"lastSuccessfulRevision,omitempty"`
+
+	// History contains the revisions of the release, the latest revision first.
+	// +optional
+	History []ReleaseRevision `json:"history,omitempty"`
+}
+
+// ReleaseRevision describes one revision of a helm release.
+type ReleaseRevision struct {
+	// Revision is the number of the revision.
+	Revision int `json:"revision"`
+
+	// Status is the helm status of the revision, e.g. "deployed", "superseded" or "failed".
+	Status string `json:"status"`
+
+	// Chart is the name and version of the chart of the revision.
+	// +optional
+	Chart string `json:"chart,omitempty"`
+
+	// AppVersion is the app version of the chart of the revision.
+	// +optional
+	AppVersion string `json:"appVersion,omitempty"`
+
+	// Description is the helm description of the revision, e.g. "Upgrade complete" or "Rollback to 2".
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Updated is the time when the revision was deployed.
+	// +optional
+	Updated *metav1.Time `json:"updated,omitempty"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
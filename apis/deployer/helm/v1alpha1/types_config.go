@@ -21,6 +21,16 @@ const ManagedInstanceLabel = "helm.deployer.landscaper.gardener.cloud/instance"
 // to define its source deploy item.
 const ManagedDeployItemLabel = "helm.deployer.landscaper.gardener.cloud/deployitem"
 
+// RollbackAnnotation is the annotation of a deploy item that requests a rollback of its helm release
+// during the next reconciliation of the deploy item.
+// The value is either the revision to roll back to or "last-successful" for the last revision
+// whose resources passed the readiness checks.
+const RollbackAnnotation = "helm.deployer.landscaper.gardener.cloud/rollback"
+
+// RollbackToLastSuccessfulRevision is the value of the RollbackAnnotation that requests a rollback
+// to the last successful revision of the release.
+const RollbackToLastSuccessfulRevision = "last-successful"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Configuration is the helm deployer configuration that configures the controller
@@ -13,6 +13,7 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
 
@@ -30,6 +31,9 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateChart(field.NewPath("chart"), config.Chart)...)
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
+	allErrs = append(allErrs, ValidateRollbackPolicy(field.NewPath("rollbackPolicy"), config.RollbackPolicy, config.HelmDeployment)...)
+	allErrs = append(allErrs, ValidateTestConfiguration(field.NewPath("tests"), config.Tests, config.HelmDeployment)...)
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -137,6 +141,43 @@ func validateHelmArguments(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJS
 	return allErrs
 }
 
+// ValidateRollbackPolicy validates the rollback policy of a helm release.
+// A rollback policy is only allowed if helm is used as deployment mechanism.
+func ValidateRollbackPolicy(fldPath *field.Path, policy *helmv1alpha1.RollbackPolicy, helmDeployment *bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	if helmDeployment != nil && !*helmDeployment {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "a rollback policy requires helm as deployment mechanism"))
+	}
+	if policy.Timeout != nil {
+		allErrs = append(allErrs, ValidateTimeout(fldPath.Child("timeout"), policy.Timeout)...)
+	}
+	return allErrs
+}
+
+// ValidateTestConfiguration validates the configuration of the test hooks of a helm chart.
+// Test hooks can only be executed if helm is used as deployment mechanism.
+func ValidateTestConfiguration(fldPath *field.Path, tests *helmv1alpha1.TestConfiguration, helmDeployment *bool) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if tests == nil || !tests.Enabled {
+		return allErrs
+	}
+	if helmDeployment != nil && !*helmDeployment {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "test hooks require helm as deployment mechanism"))
+	}
+	if tests.Timeout != nil {
+		allErrs = append(allErrs, ValidateTimeout(fldPath.Child("timeout"), tests.Timeout)...)
+	}
+	for i, name := range tests.Filter {
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("filter").Index(i), "must not be empty"))
+		}
+	}
+	return allErrs
+}
+
 // ValidateArchive validates the archive access for a helm chart.
 func ValidateArchive(fldPath *field.Path, archive *helmv1alpha1.ArchiveAccess) field.ErrorList {
 	allErrs := field.ErrorList{}
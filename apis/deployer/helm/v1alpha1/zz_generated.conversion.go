@@ -14,6 +14,7 @@ import (
 	json "encoding/json"
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
@@ -22,6 +23,7 @@ import (
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helm "github.com/gardener/landscaper/apis/deployer/helm"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -162,6 +164,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseRevision)(nil), (*helm.ReleaseRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(a.(*ReleaseRevision), b.(*helm.ReleaseRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseRevision)(nil), (*ReleaseRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(a.(*helm.ReleaseRevision), b.(*ReleaseRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReleaseStatus)(nil), (*helm.ReleaseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(a.(*ReleaseStatus), b.(*helm.ReleaseStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ReleaseStatus)(nil), (*ReleaseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(a.(*helm.ReleaseStatus), b.(*ReleaseStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteArchiveAccess)(nil), (*helm.RemoteArchiveAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(a.(*RemoteArchiveAccess), b.(*helm.RemoteArchiveAccess), scope)
 	}); err != nil {
@@ -182,6 +204,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RollbackPolicy)(nil), (*helm.RollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollbackPolicy_To_helm_RollbackPolicy(a.(*RollbackPolicy), b.(*helm.RollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.RollbackPolicy)(nil), (*RollbackPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_RollbackPolicy_To_v1alpha1_RollbackPolicy(a.(*helm.RollbackPolicy), b.(*RollbackPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TestConfiguration)(nil), (*helm.TestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestConfiguration_To_helm_TestConfiguration(a.(*TestConfiguration), b.(*helm.TestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.TestConfiguration)(nil), (*TestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_TestConfiguration_To_v1alpha1_TestConfiguration(a.(*helm.TestConfiguration), b.(*TestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -460,8 +502,12 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.DryRun = in.DryRun
+	out.RollbackPolicy = (*helm.RollbackPolicy)(unsafe.Pointer(in.RollbackPolicy))
+	out.Tests = (*helm.TestConfiguration)(unsafe.Pointer(in.Tests))
 	return nil
 }
 
@@ -485,8 +531,12 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.DryRun = in.DryRun
+	out.RollbackPolicy = (*RollbackPolicy)(unsafe.Pointer(in.RollbackPolicy))
+	out.Tests = (*TestConfiguration)(unsafe.Pointer(in.Tests))
 	return nil
 }
 
@@ -497,6 +547,8 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.DryRunResult = (*managedresource.DryRunResult)(unsafe.Pointer(in.DryRunResult))
+	out.Release = (*helm.ReleaseStatus)(unsafe.Pointer(in.Release))
 	return nil
 }
 
@@ -507,6 +559,8 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.DryRunResult = (*managedresource.DryRunResult)(unsafe.Pointer(in.DryRunResult))
+	out.Release = (*ReleaseStatus)(unsafe.Pointer(in.Release))
 	return nil
 }
 
@@ -515,6 +569,60 @@ func Convert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderSta
 	return autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(in *ReleaseRevision, out *helm.ReleaseRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Status = in.Status
+	out.Chart = in.Chart
+	out.AppVersion = in.AppVersion
+	out.Description = in.Description
+	out.Updated = (*v1.Time)(unsafe.Pointer(in.Updated))
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(in *ReleaseRevision, out *helm.ReleaseRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseRevision_To_helm_ReleaseRevision(in, out, s)
+}
+
+func autoConvert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(in *helm.ReleaseRevision, out *ReleaseRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.Status = in.Status
+	out.Chart = in.Chart
+	out.AppVersion = in.AppVersion
+	out.Description = in.Description
+	out.Updated = (*v1.Time)(unsafe.Pointer(in.Updated))
+	return nil
+}
+
+// Convert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision is an autogenerated conversion function.
+func Convert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(in *helm.ReleaseRevision, out *ReleaseRevision, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseRevision_To_v1alpha1_ReleaseRevision(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(in *ReleaseStatus, out *helm.ReleaseStatus, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.LastSuccessfulRevision = in.LastSuccessfulRevision
+	out.History = *(*[]helm.ReleaseRevision)(unsafe.Pointer(&in.History))
+	return nil
+}
+
+// Convert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(in *ReleaseStatus, out *helm.ReleaseStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReleaseStatus_To_helm_ReleaseStatus(in, out, s)
+}
+
+func autoConvert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(in *helm.ReleaseStatus, out *ReleaseStatus, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.LastSuccessfulRevision = in.LastSuccessfulRevision
+	out.History = *(*[]ReleaseRevision)(unsafe.Pointer(&in.History))
+	return nil
+}
+
+// Convert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus is an autogenerated conversion function.
+func Convert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(in *helm.ReleaseStatus, out *ReleaseStatus, s conversion.Scope) error {
+	return autoConvert_helm_ReleaseStatus_To_v1alpha1_ReleaseStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteArchiveAccess_To_helm_RemoteArchiveAccess(in *RemoteArchiveAccess, out *helm.RemoteArchiveAccess, s conversion.Scope) error {
 	out.URL = in.URL
 	return nil
@@ -556,3 +664,49 @@ func autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *
 func Convert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *helm.RemoteChartReference, out *RemoteChartReference, s conversion.Scope) error {
 	return autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in, out, s)
 }
+
+func autoConvert_v1alpha1_RollbackPolicy_To_helm_RollbackPolicy(in *RollbackPolicy, out *helm.RollbackPolicy, s conversion.Scope) error {
+	out.OnReadinessFailure = in.OnReadinessFailure
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_RollbackPolicy_To_helm_RollbackPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RollbackPolicy_To_helm_RollbackPolicy(in *RollbackPolicy, out *helm.RollbackPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollbackPolicy_To_helm_RollbackPolicy(in, out, s)
+}
+
+func autoConvert_helm_RollbackPolicy_To_v1alpha1_RollbackPolicy(in *helm.RollbackPolicy, out *RollbackPolicy, s conversion.Scope) error {
+	out.OnReadinessFailure = in.OnReadinessFailure
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_helm_RollbackPolicy_To_v1alpha1_RollbackPolicy is an autogenerated conversion function.
+func Convert_helm_RollbackPolicy_To_v1alpha1_RollbackPolicy(in *helm.RollbackPolicy, out *RollbackPolicy, s conversion.Scope) error {
+	return autoConvert_helm_RollbackPolicy_To_v1alpha1_RollbackPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestConfiguration_To_helm_TestConfiguration(in *TestConfiguration, out *helm.TestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Filter = *(*[]string)(unsafe.Pointer(&in.Filter))
+	return nil
+}
+
+// Convert_v1alpha1_TestConfiguration_To_helm_TestConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_TestConfiguration_To_helm_TestConfiguration(in *TestConfiguration, out *helm.TestConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestConfiguration_To_helm_TestConfiguration(in, out, s)
+}
+
+func autoConvert_helm_TestConfiguration_To_v1alpha1_TestConfiguration(in *helm.TestConfiguration, out *TestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Filter = *(*[]string)(unsafe.Pointer(&in.Filter))
+	return nil
+}
+
+// Convert_helm_TestConfiguration_To_v1alpha1_TestConfiguration is an autogenerated conversion function.
+func Convert_helm_TestConfiguration_To_v1alpha1_TestConfiguration(in *helm.TestConfiguration, out *TestConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_TestConfiguration_To_v1alpha1_TestConfiguration(in, out, s)
+}
@@ -11,6 +11,7 @@ import (
 
 	lscore "github.com/gardener/landscaper/apis/core"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
 )
 
@@ -41,6 +42,14 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// DriftDetection configures the periodic detection of changes to the managed resources
+	// that were made outside of the landscaper.
+	// +optional
+	DriftDetection *dd.DriftDetectionSpec `json:"driftDetection,omitempty"`
+	// DryRun defines that the manifests are only applied with a server-side dry-run.
+	// The computed changes are written into the provider status instead of applying the manifests.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -60,6 +69,9 @@ type ProviderStatus struct {
 	metav1.TypeMeta `json:",inline"`
 	// ManagedResources contains all kubernetes resources that are deployed by the deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+	// DryRunResult contains the changes computed by the last dry-run.
+	// +optional
+	DryRunResult *managedresource.DryRunResult `json:"dryRunResult,omitempty"`
 	// AnnotateBeforeCreate defines annotations that are being set before the manifest is being created.
 	// +optional
 	AnnotateBeforeCreate map[string]string `json:"annotateBeforeCreate,omitempty"`
@@ -17,5 +17,10 @@ func Convert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(in *manifest.Pro
 	} else {
 		out.ManagedResources = nil
 	}
+	if in.DryRunResult != nil {
+		out.DryRunResult = in.DryRunResult.DeepCopy()
+	} else {
+		out.DryRunResult = nil
+	}
 	return nil
 }
@@ -20,6 +20,7 @@ import (
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -165,6 +166,8 @@ func autoConvert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguratio
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.DryRun = in.DryRun
 	return nil
 }
 
@@ -181,6 +184,8 @@ func autoConvert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguratio
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.DryRun = in.DryRun
 	return nil
 }
 
@@ -191,6 +196,7 @@ func Convert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in
 
 func autoConvert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStatus, out *manifest.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.DryRunResult = (*managedresource.DryRunResult)(unsafe.Pointer(in.DryRunResult))
 	return nil
 }
 
@@ -201,6 +207,7 @@ func Convert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStat
 
 func autoConvert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(in *manifest.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.DryRunResult = (*managedresource.DryRunResult)(unsafe.Pointer(in.DryRunResult))
 	// WARNING: in.AnnotateBeforeCreate requires manual conversion: does not exist in peer-type
 	// WARNING: in.AnnotateBeforeDelete requires manual conversion: does not exist in peer-type
 	return nil
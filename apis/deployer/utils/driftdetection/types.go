@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection
+
+import (
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// DriftDetectedCondition is the condition type of a deploy item that indicates
+// whether its managed resources have been changed outside of the landscaper.
+const DriftDetectedCondition lsv1alpha1.ConditionType = "DriftDetected"
+
+// DriftPolicy defines how drifted resources are handled.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReport defines that drifted resources are only reported.
+	DriftPolicyReport DriftPolicy = "report"
+	// DriftPolicyCorrect defines that drifted resources are reported and applied again.
+	DriftPolicyCorrect DriftPolicy = "correct"
+)
+
+// DriftDetectionSpec configures the periodic comparison of the live state of the managed resources
+// with their last applied manifests.
+type DriftDetectionSpec struct {
+	// Every specifies the interval of the drift detection.
+	Every *lsv1alpha1.Duration `json:"every,omitempty"`
+
+	// Policy defines whether drifted resources are only reported or also corrected.
+	// Defaults to "report".
+	// +optional
+	Policy DriftPolicy `json:"policy,omitempty"`
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+)
+
+var supportedDriftPolicies = []string{
+	string(dd.DriftPolicyReport),
+	string(dd.DriftPolicyCorrect),
+}
+
+// ValidateDriftDetectionSpec validates a drift detection spec.
+// A value of nil is considered valid.
+func ValidateDriftDetectionSpec(fldPath *field.Path, spec *dd.DriftDetectionSpec) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	if spec.Every == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("every"), "an interval has to be specified"))
+	} else if spec.Every.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("every"), spec.Every, "specified duration has to be greater than zero"))
+	}
+	if len(spec.Policy) != 0 && spec.Policy != dd.DriftPolicyReport && spec.Policy != dd.DriftPolicyCorrect {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), spec.Policy, supportedDriftPolicies))
+	}
+	return allErrs
+}
+
+// DriftDetectionSpecIsEmpty returns true if the given spec is either nil or defines no interval.
+func DriftDetectionSpecIsEmpty(spec *dd.DriftDetectionSpec) bool {
+	return spec == nil || spec.Every == nil || spec.Every.Duration == 0
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validation Test Suite")
+}
+
+var _ = Describe("Validation", func() {
+
+	Context("DriftDetectionSpec", func() {
+
+		It("should accept a valid spec", func() {
+			spec := &dd.DriftDetectionSpec{
+				Every:  &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+				Policy: dd.DriftPolicyCorrect,
+			}
+			Expect(ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)).To(HaveLen(0))
+			Expect(ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), nil)).To(HaveLen(0))
+		})
+
+		It("should deny a spec without interval", func() {
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), &dd.DriftDetectionSpec{})
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("driftDetection.every"),
+			}))))
+		})
+
+		It("should deny a negative interval and an unknown policy", func() {
+			spec := &dd.DriftDetectionSpec{
+				Every:  &lsv1alpha1.Duration{Duration: -time.Minute},
+				Policy: "ignore",
+			}
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("driftDetection.every"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("driftDetection.policy"),
+				})),
+			))
+		})
+	})
+})
@@ -6,6 +6,7 @@ package managedresource
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -84,6 +85,40 @@ type ManagedResourceStatus struct {
 	Resource corev1.ObjectReference `json:"resource"`
 }
 
+// DryRunResult describes the changes that a server-side dry-run of the managed resources computed.
+type DryRunResult struct {
+	// Time is the time when the dry-run was executed.
+	Time metav1.Time `json:"time"`
+	// Resources contains the computed changes for all resources that would be created, updated or deleted.
+	// +optional
+	Resources []ResourceDiff `json:"resources,omitempty"`
+}
+
+// ResourceDiffAction describes how a resource would be changed by an apply.
+type ResourceDiffAction string
+
+const (
+	// ResourceDiffActionCreate indicates that the resource does not exist yet and would be created.
+	ResourceDiffActionCreate ResourceDiffAction = "create"
+	// ResourceDiffActionUpdate indicates that the live state of the resource would be changed.
+	ResourceDiffActionUpdate ResourceDiffAction = "update"
+	// ResourceDiffActionDelete indicates that the resource is orphaned and would be deleted.
+	ResourceDiffActionDelete ResourceDiffAction = "delete"
+	// ResourceDiffActionNone indicates that the live state of the resource would not be changed.
+	ResourceDiffActionNone ResourceDiffAction = "none"
+)
+
+// ResourceDiff describes the difference between the live state of a resource and its desired state.
+type ResourceDiff struct {
+	// Resource describes the kubernetes resource.
+	Resource corev1.ObjectReference `json:"resource"`
+	// Action describes how the resource would be changed.
+	Action ResourceDiffAction `json:"action"`
+	// ChangedFields contains the paths of all fields whose values would be changed by an update.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
+}
+
 // Exports describes one export that is read from a resource.
 type Exports struct {
 	// DefaultTimeout defines the default timeout for all exports
@@ -36,6 +36,9 @@ const (
 	// This is the legacy deprecated artifact media type.
 	JSONSchemaArtifactsMediaTypeV1 = "application/vnd.gardener.landscaper.jsonschema.layer.v1.json"
 
+	// CueArtifactsMediaTypeV1 is the reserved media type for a CUE definition that is stored as layer in an oci artifact.
+	CueArtifactsMediaTypeV1 = "application/vnd.gardener.landscaper.cue.layer.v1.cue"
+
 	// GZipCompression is the identifier for a gzip compressed file.
 	GZipCompression = "gzip"
 
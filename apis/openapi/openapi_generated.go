@@ -55,6 +55,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.OCICacheConfiguration":                                     schema_gardener_landscaper_apis_config_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.OCIConfiguration":                                          schema_gardener_landscaper_apis_config_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.RegistryConfiguration":                                     schema_gardener_landscaper_apis_config_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.SharedBlueprintCache":                                      schema_gardener_landscaper_apis_config_SharedBlueprintCache(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.AgentConfiguration":                               schema_landscaper_apis_config_v1alpha1_AgentConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore":                                   schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig":                           schema_landscaper_apis_config_v1alpha1_CommonControllerConfig(ref),
@@ -77,6 +78,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCICacheConfiguration":                            schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.SharedBlueprintCache":                             schema_landscaper_apis_config_v1alpha1_SharedBlueprintCache(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON":                                            schema_landscaper_apis_core_v1alpha1_AnyJSON(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile":                                 schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus":                           schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref),
@@ -95,6 +97,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ConfigMapReference":                                 schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Context":                                            schema_landscaper_apis_core_v1alpha1_Context(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ContextList":                                        schema_landscaper_apis_core_v1alpha1_ContextList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition":                                      schema_landscaper_apis_core_v1alpha1_CueDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DataExport":                                         schema_landscaper_apis_core_v1alpha1_DataExport(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport":                                         schema_landscaper_apis_core_v1alpha1_DataImport(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject":                                         schema_landscaper_apis_core_v1alpha1_DataObject(ref),
@@ -102,10 +105,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Default":                                            schema_landscaper_apis_core_v1alpha1_Default(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger":                                 schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem":                                         schema_landscaper_apis_core_v1alpha1_DeployItem(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemApproval":                                 schema_landscaper_apis_core_v1alpha1_DeployItemApproval(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemList":                                     schema_landscaper_apis_core_v1alpha1_DeployItemList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemRetryPolicy":                              schema_landscaper_apis_core_v1alpha1_DeployItemRetryPolicy(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemRetryStatus":                              schema_landscaper_apis_core_v1alpha1_DeployItemRetryStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec":                                     schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus":                                   schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate":                                 schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTimeouts":                                 schema_landscaper_apis_core_v1alpha1_DeployItemTimeouts(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation":                                schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInstallationTemplate":                       schema_landscaper_apis_core_v1alpha1_DeployerInstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployerRegistration":                               schema_landscaper_apis_core_v1alpha1_DeployerRegistration(ref),
@@ -113,6 +120,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployerRegistrationSpec":                           schema_landscaper_apis_core_v1alpha1_DeployerRegistrationSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.DeployerRegistrationStatus":                         schema_landscaper_apis_core_v1alpha1_DeployerRegistrationStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Duration":                                           schema_landscaper_apis_core_v1alpha1_Duration(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.EffectiveDeployItemTimeouts":                        schema_landscaper_apis_core_v1alpha1_EffectiveDeployItemTimeouts(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Environment":                                        schema_landscaper_apis_core_v1alpha1_Environment(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.EnvironmentList":                                    schema_landscaper_apis_core_v1alpha1_EnvironmentList(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.EnvironmentSpec":                                    schema_landscaper_apis_core_v1alpha1_EnvironmentSpec(ref),
@@ -129,9 +137,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus":                                       schema_landscaper_apis_core_v1alpha1_ImportStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint":                                    schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Installation":                                       schema_landscaper_apis_core_v1alpha1_Installation(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationBlocker":                                schema_landscaper_apis_core_v1alpha1_InstallationBlocker(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports":                                schema_landscaper_apis_core_v1alpha1_InstallationExports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports":                                schema_landscaper_apis_core_v1alpha1_InstallationImports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationList":                                   schema_landscaper_apis_core_v1alpha1_InstallationList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan":                                   schema_landscaper_apis_core_v1alpha1_InstallationPlan(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec":                                   schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus":                                 schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplate":                               schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref),
@@ -141,13 +151,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck":                                      schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheckList":                                  schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenancePolicy":                                  schema_landscaper_apis_core_v1alpha1_MaintenancePolicy(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceStatus":                                  schema_landscaper_apis_core_v1alpha1_MaintenanceStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow":                                  schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference":                               schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference":                                    schema_landscaper_apis_core_v1alpha1_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig":                                     schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange":                                      schema_landscaper_apis_core_v1alpha1_PlannedChange(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference":                           schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResourceReference":                                  schema_landscaper_apis_core_v1alpha1_ResourceReference(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RetryBackoff":                                       schema_landscaper_apis_core_v1alpha1_RetryBackoff(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RetryRule":                                          schema_landscaper_apis_core_v1alpha1_RetryRule(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.Rollout":                                            schema_landscaper_apis_core_v1alpha1_Rollout(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutList":                                        schema_landscaper_apis_core_v1alpha1_RolloutList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutSpec":                                        schema_landscaper_apis_core_v1alpha1_RolloutSpec(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus":                                      schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy":                                    schema_landscaper_apis_core_v1alpha1_RolloutStrategy(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef":                             schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference":                                    schema_landscaper_apis_core_v1alpha1_SecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataSource":                                   schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref),
@@ -173,15 +194,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedNamedObjectReference":                      schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference":                           schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedResourceReference":                         schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.CapturedLogReference":                 schema_apis_deployer_container_v1alpha1_CapturedLogReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Configuration":                        schema_apis_deployer_container_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec":                        schema_apis_deployer_container_v1alpha1_ContainerSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus":                      schema_apis_deployer_container_v1alpha1_ContainerStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller":                           schema_apis_deployer_container_v1alpha1_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions":                         schema_apis_deployer_container_v1alpha1_DebugOptions(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection":                    schema_apis_deployer_container_v1alpha1_GarbageCollection(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.LogCapture":                           schema_apis_deployer_container_v1alpha1_LogCapture(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.OCIStateBackend":                      schema_apis_deployer_container_v1alpha1_OCIStateBackend(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus":                            schema_apis_deployer_container_v1alpha1_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderConfiguration":                schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderStatus":                       schema_apis_deployer_container_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateConfiguration":                   schema_apis_deployer_container_v1alpha1_StateConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateEncryption":                      schema_apis_deployer_container_v1alpha1_StateEncryption(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.VolumeStateBackend":                   schema_apis_deployer_container_v1alpha1_VolumeStateBackend(ref),
 		"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.Owner":                                     schema_apis_deployer_core_v1alpha1_Owner(ref),
 		"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerList":                                 schema_apis_deployer_core_v1alpha1_OwnerList(ref),
 		"github.com/gardener/landscaper/apis/deployer/core/v1alpha1.OwnerSpec":                                 schema_apis_deployer_core_v1alpha1_OwnerSpec(ref),
@@ -199,8 +226,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmUninstallConfiguration":                schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ProviderStatus":                            schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseRevision":                           schema_apis_deployer_helm_v1alpha1_ReleaseRevision(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseStatus":                             schema_apis_deployer_helm_v1alpha1_ReleaseStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess":                       schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference":                      schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RollbackPolicy":                            schema_apis_deployer_helm_v1alpha1_RollbackPolicy(ref),
+		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.TestConfiguration":                         schema_apis_deployer_helm_v1alpha1_TestConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Configuration":                         schema_apis_deployer_manifest_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.Controller":                            schema_apis_deployer_manifest_v1alpha1_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest/v1alpha1.ExportConfiguration":                   schema_apis_deployer_manifest_v1alpha1_ExportConfiguration(ref),
@@ -214,11 +245,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.Configuration":                             schema_apis_deployer_mock_v1alpha1_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/mock/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_mock_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec":       schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec":                 schema_apis_deployer_utils_driftdetection_DriftDetectionSpec(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DryRunResult":                      schema_apis_deployer_utils_managedresource_DryRunResult(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export":                            schema_apis_deployer_utils_managedresource_Export(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports":                           schema_apis_deployer_utils_managedresource_Exports(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference":               schema_apis_deployer_utils_managedresource_FromObjectReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus":             schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest":                          schema_apis_deployer_utils_managedresource_Manifest(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceDiff":                      schema_apis_deployer_utils_managedresource_ResourceDiff(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.CustomReadinessCheckConfiguration": schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.LabelSelectorSpec":                 schema_apis_deployer_utils_readinesschecks_LabelSelectorSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration":       schema_apis_deployer_utils_readinesschecks_ReadinessCheckConfiguration(ref),
@@ -1185,12 +1219,18 @@ func schema_gardener_landscaper_apis_config_BlueprintStore(ref common.ReferenceC
 							Ref:     ref("github.com/gardener/landscaper/apis/config.GarbageCollectionConfiguration"),
 						},
 					},
+					"SharedCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedCache configures a content-addressed cache of blueprint blobs that survives restarts. The cache can be shared between multiple landscaper instances, e.g. by mounting the same persistent volume. Blueprints that are not found in the local store are read from the shared cache before they are fetched from the registry.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.SharedBlueprintCache"),
+						},
+					},
 				},
 				Required: []string{"Path", "DisableCache", "GarbageCollectionConfiguration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.GarbageCollectionConfiguration"},
+			"github.com/gardener/landscaper/apis/config.GarbageCollectionConfiguration", "github.com/gardener/landscaper/apis/config.SharedBlueprintCache"},
 	}
 }
 
@@ -1510,6 +1550,13 @@ func schema_gardener_landscaper_apis_config_ExecutionsController(ref common.Refe
 							Ref:     ref("github.com/gardener/landscaper/apis/config.CommonControllerConfig"),
 						},
 					},
+					"MaxParallelDeployItemsPerTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItemsPerTarget limits the number of deploy items that are processed in parallel for the same target across all executions. If not set, the number of deploy items per target is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"CommonControllerConfig"},
 			},
@@ -1937,6 +1984,36 @@ func schema_gardener_landscaper_apis_config_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_gardener_landscaper_apis_config_SharedBlueprintCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedBlueprintCache contains the configuration for the shared blueprint cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path defines the root path of the shared cache.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the maximal size of the shared cache. When the usage reaches the gc high threshold of the store, the least recently used blueprints are evicted until the gc low threshold is reached. If the value is 0 or empty there is no limit and no blueprints are evicted. See the kubernetes quantity docs for detailed description of the format https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"Path"},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_AgentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2046,12 +2123,18 @@ func schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref common.ReferenceC
 							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.GarbageCollectionConfiguration"),
 						},
 					},
+					"sharedCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedCache configures a content-addressed cache of blueprint blobs that survives restarts. The cache can be shared between multiple landscaper instances, e.g. by mounting the same persistent volume. Blueprints that are not found in the local store are read from the shared cache before they are fetched from the registry.",
+							Ref:         ref("github.com/gardener/landscaper/apis/config/v1alpha1.SharedBlueprintCache"),
+						},
+					},
 				},
 				Required: []string{"path", "disableCache", "GarbageCollectionConfiguration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.GarbageCollectionConfiguration"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.GarbageCollectionConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.SharedBlueprintCache"},
 	}
 }
 
@@ -2371,6 +2454,13 @@ func schema_landscaper_apis_config_v1alpha1_ExecutionsController(ref common.Refe
 							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
+					"maxParallelDeployItemsPerTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItemsPerTarget limits the number of deploy items that are processed in parallel for the same target across all executions. If not set, the number of deploy items per target is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"CommonControllerConfig"},
 			},
@@ -2802,6 +2892,35 @@ func schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_SharedBlueprintCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedBlueprintCache contains the configuration for the shared blueprint cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path defines the root path of the shared cache.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the maximal size of the shared cache. When the usage reaches the gc high threshold of the store, the least recently used blueprints are evicted until the gc low threshold is reached. If the value is 0 or empty there is no limit and no blueprints are evicted. See the kubernetes quantity docs for detailed description of the format https://github.com/kubernetes/apimachinery/blob/master/pkg/api/resource/quantity.go",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3041,6 +3160,13 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 							},
 						},
 					},
+					"maxParallelDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItems limits the number of deploy items of the blueprint that are processed in parallel. It overwrites the limit of the context of the installation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -3604,11 +3730,30 @@ func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"maintenancePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenancePolicy restricts the times at which changes and automatic reconciles of the root installations that use this context are rolled out. It is overwritten by the maintenance policy of an installation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenancePolicy"),
+						},
+					},
+					"deployItemTimeouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItemTimeouts overwrites the default deploy item timeouts of the landscaper configuration for all deploy items that use this context.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTimeouts"),
+						},
+					},
+					"maxParallelDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItems limits the number of deploy items of an execution that are processed in parallel for all installations that use this context. It is overwritten by the limit of the blueprint.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTimeouts", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenancePolicy", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3661,6 +3806,40 @@ func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallba
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_CueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CueDefinition defines a CUE definition that is used to validate an im- or exported value. Defaults defined in the CUE definition are applied to imported values.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"definition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Definition defines the CUE definition inline.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref references a CUE definition that is either stored in the blueprint filesystem (\"blueprint://<path>\") or as resource of the component descriptor (\"cd://componentReferences/<name>/resources/<name>\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the CUE value the im- or exported value is unified with, e.g. \"#Config\". The whole CUE definition is used if no path is given.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3920,6 +4099,53 @@ func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallbac
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_DeployItemApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemApproval describes the manual approval of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the approved deploy item template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedBy is the identity of the approver.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvalTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalTime is the time when the approval has been consumed by the execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job for which the deploy item has been approved.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "approvedBy", "approvalTime", "jobID"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3969,6 +4195,101 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_DeployItemRetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemRetryPolicy configures automatic retries of a failed deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numberOfRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfRetries specifies the maximal number of retries. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent retries. If not set, a default of 1 minute is used. If a backoff is configured, the interval is the initial interval that is increased after every retry.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff configures an exponentially increasing interval between two subsequent retries. If not set, the interval is constant.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RetryBackoff"),
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules decide based on the last error of the deploy item whether it is retried. The first rule that matches the last error is applied. If no rule matches, the deploy item is retried until the maximal number of retries is reached.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RetryRule"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.RetryBackoff", "github.com/gardener/landscaper/apis/core/v1alpha1.RetryRule"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemRetryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemRetryStatus describes the automatic retries of a failed deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the retried deploy item template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job in which the deploy item has been retried.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"numberOfRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfRetries is the number of retries of the deploy item in the job.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastRetryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRetryTime is the time of the last retry.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "jobID", "numberOfRetries", "lastRetryTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4151,6 +4472,12 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"effectiveTimeouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EffectiveTimeouts contains the pickup and progressing timeouts that are applied to the current job of the deploy item. They are derived from the deploy item, its context and the landscaper configuration.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.EffectiveDeployItemTimeouts"),
+						},
+					},
 					"deployItemPhase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
@@ -4163,7 +4490,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.EffectiveDeployItemTimeouts", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -4252,43 +4579,83 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.Referenc
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
 						},
 					},
+					"requiresApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiresApproval specifies that the deploy item is only triggered after a manual approval. The execution stops before the deploy item until the approval annotation is set on the execution.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"retry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retry configures automatic retries of the deploy item if it fails, without reconciling the whole installation again.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemRetryPolicy"),
+						},
+					},
 				},
 				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemRetryPolicy", "github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_DeployItemTimeouts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Description: "DeployItemTimeouts contains the default timeouts for the deploy items of a context.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"identity": {
+					"pickup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity describes the unique identity of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Pickup defines how long a deployer can take to react on changes to a deploy item before the landscaper will mark it as failed. Allowed values are 'none' (to disable pickup timeout detection) and anything that is understood by golang's time.ParseDuration method. Defaults to the pickup timeout of the landscaper configuration.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"name": {
+					"progressingDefault": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ProgressingDefault specifies how long the deployer may take to apply a deploy item by default. The value can be overwritten per deploy item in 'spec.timeout'. Allowed values are 'none' (to disable progressing timeout detection) and anything that is understood by golang's time.ParseDuration method. Defaults to the progressing timeout of the landscaper configuration.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the deployer.",
-							Default:     "",
-							Type:        []string{"string"},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Identity describes the unique identity of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -4518,6 +4885,33 @@ func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback)
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_EffectiveDeployItemTimeouts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EffectiveDeployItemTimeouts describes the timeouts that are applied to a deploy item. A duration of 'none' means that the timeout detection is disabled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pickup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pickup is the time a deployer may take to react on changes to the deploy item.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"progressing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progressing is the time a deployer may take to apply the deploy item.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_Environment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4895,6 +5289,13 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCall
 							Format:      "byte",
 						},
 					},
+					"maxParallelDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItems limits the number of deploy items of the execution that are processed in parallel. Further runnable deploy items are started as soon as running deploy items have finished. If not set, all runnable deploy items are started at once.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
 							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
@@ -5000,6 +5401,12 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"jobIDGenerationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ExecutionPhase is the current phase of the execution.",
@@ -5013,11 +5420,39 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCa
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"approvals": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approvals contains the latest approval of the deploy items that require a manual approval.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemApproval"),
+									},
+								},
+							},
+						},
+					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries contains the automatic retries of failed deploy items in the current job.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemRetryStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionGeneration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.VersionedNamedObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemApproval", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemRetryStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionGeneration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.VersionedNamedObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5042,6 +5477,12 @@ func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
+					"cue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cue defines the imported value as CUE definition. It can be used as alternative or in addition to the jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition"),
+						},
+					},
 					"targetType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetType defines the type of the imported target.",
@@ -5061,7 +5502,7 @@ func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
@@ -5081,15 +5522,35 @@ func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCa
 					},
 					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used. If a backoff is configured, the interval is the initial interval that is increased after every repeated reconciliation.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff configures an exponentially increasing interval between two subsequent repeated reconciliations. If not set, the interval is constant.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RetryBackoff"),
+						},
+					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules decide based on the last error whether a failed installation is reconciled again. The first rule that matches the last error is applied. If no rule matches, the installation is reconciled again until the maximal number of repeated reconciliations is reached.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.RetryRule"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.RetryBackoff", "github.com/gardener/landscaper/apis/core/v1alpha1.RetryRule"},
 	}
 }
 
@@ -5114,6 +5575,12 @@ func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.Refere
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
+					"cue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cue defines the imported value as CUE definition. It can be used as alternative or in addition to the jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition"),
+						},
+					},
 					"targetType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetType defines the type of the imported target.",
@@ -5126,7 +5593,7 @@ func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
@@ -5151,6 +5618,12 @@ func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
 						},
 					},
+					"cue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cue defines the imported value as CUE definition. It can be used as alternative or in addition to the jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition"),
+						},
+					},
 					"targetType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetType defines the type of the imported target.",
@@ -5198,7 +5671,7 @@ func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CueDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
@@ -5361,6 +5834,58 @@ func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallb
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_InstallationBlocker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationBlocker describes an object that prevents the processing of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the blocking object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the blocking object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"import": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Import is the name of the import that references the blocking object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the object blocks the installation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable description of the blocker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "reason"},
+			},
+		},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5496,6 +6021,77 @@ func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceC
 	}
 }
 
+func schema_landscaper_apis_core_v1alpha1_InstallationPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationPlan contains the result of a plan operation of an installation. It describes which subinstallations and deploy items would be created, updated or deleted if the installation was reconciled.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"planTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PlanTime is the time when the plan was computed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the installation for which the plan was computed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error describes the error that occurred during the computation of the plan.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"subinstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subinstallations describes the planned changes of the subinstallations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange"),
+									},
+								},
+							},
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems describes the planned changes of the deploy items of the execution.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange"),
+									},
+								},
+							},
+						},
+					},
+					"resultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResultReference references the secret that contains the rendered imports, subinstallations and deploy items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"planTime", "observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.PlannedChange", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5585,12 +6181,18 @@ func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceC
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
 						},
 					},
+					"maintenancePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenancePolicy restricts the times at which changes and automatic reconciles of the installation are rolled out. It overwrites the maintenance policy of the context. Only relevant for root installations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenancePolicy"),
+						},
+					},
 				},
 				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenancePolicy", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
@@ -5685,6 +6287,12 @@ func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"jobIDGenerationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Description: "InstallationPhase is the current phase of the installation.",
@@ -5725,12 +6333,38 @@ func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.Referenc
 							},
 						},
 					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan contains the result of the last plan operation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan"),
+						},
+					},
+					"maintenanceStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceStatus describes a requested reconcile that is postponed by the maintenance policy.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceStatus"),
+						},
+					},
+					"blockedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BlockedBy lists the sibling installations, data objects and targets the installation is waiting for before it can start its processing. It is updated on every reconcile of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationBlocker"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"observedGeneration", "configGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationBlocker", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -6029,133 +6663,667 @@ func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.Reference
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_MaintenancePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Description: "MaintenancePolicy restricts the times at which changes of an installation are rolled out.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"paused": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Paused stops the rollout of changes and automatic reconciles until it is set to false again. Deletions are not affected.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"ref": {
+					"windows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-						},
-					},
-				},
-				Required: []string{"name", "ref"},
+							Description: "Windows defines the time windows in which changes and automatic reconciles are rolled out. If empty, they are rolled out at any time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_MaintenanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceStatus describes a requested reconcile of an installation that is postponed by the maintenance policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the reconcile is postponed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable description of the postponed reconcile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pendingSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingSince is the time since when the reconcile is postponed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextWindowStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextWindowStart is the start of the next maintenance window. It is not set if the maintenance policy is paused.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"reason", "pendingSince"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow defines a recurring time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression that defines the start of the window, e.g. \"0 22 * * 1-5\". The expression is evaluated in UTC unless it is prefixed with a time zone, e.g. \"CRON_TZ=Europe/Berlin 0 22 * * *\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the window.",
+							Default:     0,
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamedObjectReference is a named reference to a specific resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectReference is the reference to a kubernetes object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipUninstallIfClusterRemoved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_PlannedChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlannedChange describes the planned change of a subinstallation or deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the subinstallation or deploy item as defined in the blueprint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action describes how the object would be changed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields lists the paths of all fields whose values differ between the current and the rendered specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "action"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RetryBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryBackoff configures an exponentially increasing interval between two subsequent retries. The n-th retry is delayed by interval * factor^(n-1), but at most by the max interval.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"factor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Factor is the factor by which the interval is multiplied after every retry. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInterval is the upper limit of the interval between two subsequent retries. If not set, the interval is not limited.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"jitterPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JitterPercent randomly varies the interval by up to the given percentage in both directions, so that objects which failed at the same time are not retried at the same time. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RetryRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryRule decides whether a failed object is retried based on its last error. A rule matches an error if the error has at least one of the codes and one of the reasons of the rule. Empty codes or reasons match all errors.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Codes are the error codes that are matched by the rule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"reasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reasons are the error reasons that are matched by the rule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action that is applied if the rule matches.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"action"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Rollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Rollout updates the component version of a set of root installations in waves. The next wave is only started if all installations of the previous waves have been processed successfully. A rollout is cluster-scoped, so that it can update the installations of many namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_RolloutList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutList contains a list of Rollouts",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Rollout"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Rollout", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RolloutSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "RolloutSpec contains the specification for a Rollout.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"namespaceSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "NamespaceSelector selects the namespaces whose root installations are updated. An empty selector selects all namespaces.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the root installations in the selected namespaces.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName is the name of the component that is rolled out. Only selected installations that reference this component are updated.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Version is the version of the component that is rolled out.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy defines how the selected installations are updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy"),
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"selector", "componentName", "version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.RolloutStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Description: "RolloutStatus contains the status of a Rollout.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the rollout.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
-							Default:     "",
+							Description: "Message describes the current state of the rollout.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
+					"wave": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Wave is the number of waves that have been started so far.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"operator": {
+					"totalInstallations": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "TotalInstallations is the number of installations that are selected by the rollout.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"values": {
+					"updatedInstallations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Description: "UpdatedInstallations is the number of selected installations that use the context of the rollout for the current version.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"succeededInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SucceededInstallations is the number of updated installations that have been processed successfully.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedInstallations contains the namespaces and names (<namespace>/<name>) of the updated installations that failed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -6168,68 +7336,35 @@ func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallba
 							},
 						},
 					},
-				},
-				Required: []string{"key", "operator"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
-						},
-					},
-					"content": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastUpdateTime is the last time the status was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"target", "content"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "RolloutStrategy defines how the selected installations of a rollout are updated.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"resourceName": {
+					"waveSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
+							Description: "WaveSize is the number of installations that are updated in one wave. It could be an absolute number (e.g. \"5\") or a percentage of the selected installations (e.g. \"10%\"). Percentages are rounded up. Defaults to \"1\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName"},
 			},
 		},
 	}
@@ -6980,6 +8115,33 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"clusterNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterNameExpression defines the names of Cluster API clusters (cluster.x-k8s.io/v1beta1 Cluster) for which targets are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. The kubeconfig of a cluster is read from the secret \"<cluster name>-kubeconfig\" that is maintained by Cluster API. if not set no targets for Cluster API clusters are created",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapNameExpression defines the names of the configmaps containing a kubeconfig which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no configmaps are synced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapKey is the key of the kubeconfig in the synced configmaps. Defaults to \"kubeconfig\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector restricts the synced secrets, shoots, Cluster API clusters and configmaps to those with matching labels. It is applied in addition to the name expressions.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
 					"tokenRotation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
@@ -6991,7 +8153,7 @@ func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -7330,6 +8492,60 @@ func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.
 	}
 }
 
+func schema_apis_deployer_container_v1alpha1_CapturedLogReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CapturedLogReference references the object in the namespace of the deploy item that contains the captured log of a run of the main container.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the object, either \"Secret\" or \"ConfigMap\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the key of the log in the data of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the JobID of the deploy item the run belongs to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "namespace", "key", "jobID"},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7605,7 +8821,7 @@ func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.Refere
 				Properties: map[string]spec.Schema{
 					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disable disables the garbage collector and the resources clean-up.",
+							Description: "Disable disables the garbage collector and the resources clean-up. The retention of the captured logs is enforced nonetheless.",
 							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
@@ -7627,8 +8843,65 @@ func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.Refere
 							Format:      "int32",
 						},
 					},
+					"capturedLogsRetention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapturedLogsRetention is the number of captured logs of the main container that are kept per deploy item. Older captured logs are deleted. Defaults to 5.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"disable", "worker", "requeueTimeSeconds", "capturedLogsRetention"},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_LogCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogCapture defines how the log of the main container is captured.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Lines is the number of lines at the end of the main container's log that are captured. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the object the captured log is stored in. Can be \"Secret\" or \"ConfigMap\". Defaults to \"Secret\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_OCIStateBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCIStateBackend configures the backend that stores the state as oci artifact.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Repository is the oci repository the state is pushed to, e.g. \"example.com/landscaper/states\". The versions of the state of a deploy item are pushed to \"<repository>/<deploy item namespace>/<deploy item name>\" with the version as tag.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"disable", "worker", "requeueTimeSeconds"},
+				Required: []string{"repository"},
 			},
 		},
 	}
@@ -7750,6 +9023,109 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 							},
 						},
 					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources defines the compute resources of the main container. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env defines additional environment variables of the main container. Values could also be read from secrets and configmaps in the namespace of the pod. The environment variables set by the container deployer cannot be overwritten.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes defines additional volumes of the pod. The volumes are only mounted into the main container if a respective volume mount is defined.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts defines the mounts of the additional volumes into the main container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations defines the tolerations of the pod. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityContext defines the security context of the pod. If not set, the pod runs with user 1000, group 3000 and fs group 2000.",
+							Ref:         ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountName is the name of the service account that is used to run the pod. The token of the service account is not mounted automatically.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logCapture": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogCapture configures the capturing of the main container's log after each run. The log is not captured if this is not provided.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.LogCapture"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State configures how the state of the main container is stored between runs. By default, the state is stored in secrets in the host cluster.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateConfiguration"),
+						},
+					},
 					"importValues": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ImportValues contains the import values for the container.",
@@ -7793,7 +9169,7 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.LogCapture", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -7832,12 +9208,117 @@ func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.Reference
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
 						},
 					},
+					"capturedLog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapturedLog references the captured log of the main container of the last run.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.CapturedLogReference"),
+						},
+					},
 				},
 				Required: []string{"lastOperation"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.CapturedLogReference", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_StateConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateConfiguration defines how the state of the main container is stored. Every run stores a new version of the state, and the latest version is restored before the next run.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backend is the type of the backend that stores the state. Can be \"Secret\", \"OCI\" or \"Volume\". Defaults to \"Secret\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci backend. Required if the oci backend is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.OCIStateBackend"),
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume configures the volume backend. Required if the volume backend is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.VolumeStateBackend"),
+						},
+					},
+					"encryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encryption configures the encryption of the state at rest.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateEncryption"),
+						},
+					},
+					"history": {
+						SchemaProps: spec.SchemaProps{
+							Description: "History is the number of state versions that are kept. Older versions are deleted when the state is restored. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restoreVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestoreVersion is the version of the state that is restored before the next run. Defaults to the latest version.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.OCIStateBackend", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.StateEncryption", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.VolumeStateBackend"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_StateEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StateEncryption configures the encryption of the state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references the passphrase that is used to encrypt the state. The secret has to exist in the namespace of the deploy item in the landscaper cluster. The namespace defaults to the namespace of the deploy item.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"),
+						},
+					},
+				},
+				Required: []string{"secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"},
+	}
+}
+
+func schema_apis_deployer_container_v1alpha1_VolumeStateBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeStateBackend configures the backend that stores the state in a persistent volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the persistent volume claim in the namespace of the pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"claimName"},
+			},
+		},
 	}
 }
 
@@ -8473,97 +9954,236 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 					},
 					"createNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the values that are used for templating.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"exportsFromManifests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+						},
+					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic detection of changes to the managed resources that were made outside of the landscaper.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec"),
+						},
+					},
+					"helmDeployment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"helmDeploymentConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun defines that the rendered manifests are only applied with a server-side dry-run. The computed changes are written into the provider status instead of installing or upgrading the chart.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"rollbackPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollbackPolicy configures when the release is rolled back to its last successful revision. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RollbackPolicy"),
+						},
+					},
+					"tests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tests configures the execution of the test hooks of the chart after an install or upgrade. The result of the tests is part of the readiness of the deploy item. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.TestConfiguration"),
+						},
+					},
+				},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RollbackPolicy", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.TestConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderStatus is the helm provider specific status",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"values": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Values are the values that are used for templating.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
-							Format:      "byte",
+							Format:      "",
 						},
 					},
-					"exportsFromManifests": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+					"dryRunResult": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
+							Description: "DryRunResult contains the changes computed by the last dry-run.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DryRunResult"),
 						},
 					},
-					"continuousReconcile": {
+					"release": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "Release contains the live revision and the revision history of the helm release. Only set if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseStatus"),
 						},
 					},
-					"helmDeployment": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseStatus", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DryRunResult", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_ReleaseRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReleaseRevision describes one revision of a helm release.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
-							Type:        []string{"boolean"},
+							Description: "Revision is the number of the revision.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the helm status of the revision, e.g. \"deployed\", \"superseded\" or \"failed\".",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"helmDeploymentConfig": {
+					"chart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
+							Description: "Chart is the name and version of the chart of the revision.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppVersion is the app version of the chart of the revision.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the helm description of the revision, e.g. \"Upgrade complete\" or \"Rollback to 2\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"updated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Updated is the time when the revision was deployed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
+				Required: []string{"revision", "status"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ReleaseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ReleaseStatus describes the live revision and the revision history of a helm release.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Revision is the live revision of the release.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiVersion": {
+					"lastSuccessfulRevision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastSuccessfulRevision is the last revision of the release whose resources passed the readiness checks.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"managedResources": {
+					"history": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "History contains the revisions of the release, the latest revision first.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseRevision"),
 									},
 								},
 							},
@@ -8573,7 +10193,7 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ReleaseRevision"},
 	}
 }
 
@@ -8633,6 +10253,77 @@ func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.Referenc
 	}
 }
 
+func schema_apis_deployer_helm_v1alpha1_RollbackPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackPolicy defines when a helm release is rolled back to its last successful revision.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"onReadinessFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnReadinessFailure defines that the release is rolled back to its last successful revision if the readiness checks fail after an upgrade.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the timeout of the rollback operation. Defaults to 5 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_TestConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TestConfiguration defines how the test hooks of a helm chart are executed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled defines that the test hooks of the chart are executed after the readiness checks succeeded.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time to wait for each test hook to complete. Defaults to 5 minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter restricts the executed test hooks to the hooks with the given names. All test hooks are executed if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_apis_deployer_manifest_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9041,11 +10732,24 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures the periodic detection of changes to the managed resources that were made outside of the landscaper.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun defines that the manifests are only applied with a server-side dry-run. The computed changes are written into the provider status instead of applying the manifests.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/driftdetection.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -9084,11 +10788,17 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderStatus(ref common.ReferenceC
 							},
 						},
 					},
+					"dryRunResult": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRunResult contains the changes computed by the last dry-run.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DryRunResult"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DryRunResult", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
@@ -9232,6 +10942,71 @@ func schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref
 	}
 }
 
+func schema_apis_deployer_utils_driftdetection_DriftDetectionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftDetectionSpec configures the periodic comparison of the live state of the managed resources with their last applied manifests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"every": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Every specifies the interval of the drift detection.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines whether drifted resources are only reported or also corrected. Defaults to \"report\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_utils_managedresource_DryRunResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DryRunResult describes the changes that a server-side dry-run of the managed resources computed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time when the dry-run was executed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the computed changes for all resources that would be created, updated or deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceDiff"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"time"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceDiff", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9460,6 +11235,52 @@ func schema_apis_deployer_utils_managedresource_Manifest(ref common.ReferenceCal
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_ResourceDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceDiff describes the difference between the live state of a resource and its desired state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource describes the kubernetes resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action describes how the resource would be changed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields contains the paths of all fields whose values would be changed by an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"resource", "action"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference"},
+	}
+}
+
 func schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -95,6 +95,10 @@ oci:
 targetSelector:
 {{ toYaml . }}
 {{- end }}
+{{- with .Values.deployer.podPolicy }}
+podPolicy:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.deployer.controller }}
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
@@ -123,6 +123,16 @@ registry:
         path: /app/ls/oci-cache/
         useInMemoryOverlay: {{ .Values.landscaper.registryConfig.cache.useInMemoryOverlay | default false }}
 {{ end }}
+{{- if .Values.landscaper.blueprintStore }}
+{{- if .Values.landscaper.blueprintStore.sharedCache }}
+blueprintStore:
+    sharedCache:
+      path: /app/ls/blueprint-cache
+      {{- if .Values.landscaper.blueprintStore.sharedCache.size }}
+      size: {{ .Values.landscaper.blueprintStore.sharedCache.size | quote }}
+      {{- end }}
+{{- end }}
+{{- end }}
 {{- if .Values.landscaper.metrics }}
 metrics:
   port: {{ .Values.landscaper.metrics.port | default 8080 }}
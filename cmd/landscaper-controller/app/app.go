@@ -44,6 +44,7 @@ import (
 	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	rolloutctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/rollout"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
 
 	"github.com/gardener/landscaper/pkg/landscaper/crdmanager"
@@ -201,6 +202,10 @@ func (o *Options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := rolloutctrl.AddControllerToManager(ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to register rollout controller: %w", err)
+	}
+
 	setupLogger.Info("starting the controllers")
 	eg, ctx := errgroup.WithContext(ctx)
 
@@ -131,6 +131,8 @@ func registerWebhooks(ctx context.Context,
 		ServiceNamespace:   o.webhook.webhookServiceNamespace,
 		WebhookURL:         o.webhookURL,
 		WebhookedResources: o.webhook.enabledWebhooks,
+
+		DeepInstallationValidation: o.webhook.deepInstallationValidation,
 	}
 
 	// generate certificates
@@ -11,6 +11,7 @@ import (
 	flag "github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	webhook "github.com/gardener/landscaper/pkg/utils/webhook"
 )
@@ -38,6 +39,26 @@ func defaultWebhookedResources() map[string]webhook.WebhookedResourceDefinition
 			APIVersions:  []string{"v1alpha1"},
 			ResourceName: "targets",
 		},
+		"dataobjects": {
+			APIGroup:     "landscaper.gardener.cloud",
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "dataobjects",
+		},
+		"contexts": {
+			APIGroup:     "landscaper.gardener.cloud",
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "contexts",
+		},
+		"targetsyncs": {
+			APIGroup:     "landscaper.gardener.cloud",
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "targetsyncs",
+		},
+		"componentversionoverwrites": {
+			APIGroup:     "landscaper.gardener.cloud",
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "componentversionoverwrites",
+		},
 	}
 }
 
@@ -49,6 +70,10 @@ type options struct {
 	webhookServicePort          int32  // port of the webhook service
 	webhookURL                  string // URL referring to the webhook service running externally
 	certificatesNamespace       string // the namespace in which the webhook credentials are being created/updated
+	deepInstallationValidation  bool   // resolve the blueprints of installations to validate their imports
+	ociConfigFiles              []string
+	ociAllowPlainHttp           bool
+	ociInsecureSkipVerify       bool
 
 	webhook webhookOptions
 }
@@ -60,6 +85,8 @@ type webhookOptions struct {
 	webhookServicePort      int32                                 // port of the webhook service
 	certificatesNamespace   string                                // the certificate namespace
 	enabledWebhooks         []webhook.WebhookedResourceDefinition // which resources should be watched by the webhook
+	// deepInstallationValidation contains the options for the deep validation of installations, nil if it is disabled
+	deepInstallationValidation *webhook.DeepInstallationValidationOptions
 }
 
 func NewOptions() *options {
@@ -75,6 +102,10 @@ func (o *options) AddFlags(fs *flag.FlagSet) {
 	fs.Int32Var(&o.webhookServicePort, "webhook-service-port", 9443, "Specify the port of the webhook service")
 	fs.StringVar(&o.webhookURL, "webhook-url", "", "Specify the URL of the external webhook service (scheme://host:port")
 	fs.StringVar(&o.certificatesNamespace, "certificates-namespace", "", "Specify the namespace in which the certificates are being stored")
+	fs.BoolVar(&o.deepInstallationValidation, "deep-installation-validation", false, "Resolve the blueprints of root installations and validate their imports against the blueprint")
+	fs.StringSliceVar(&o.ociConfigFiles, "oci-config-files", nil, "Specify docker config files that are used to access oci registries during the deep installation validation")
+	fs.BoolVar(&o.ociAllowPlainHttp, "oci-allow-plain-http", false, "Allow plain http connections to oci registries during the deep installation validation")
+	fs.BoolVar(&o.ociInsecureSkipVerify, "oci-insecure-skip-verify", false, "Skip the tls verification of oci registries during the deep installation validation")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -102,6 +133,15 @@ func (o *options) Complete() error {
 		o.webhook.webhookServiceName = webhookService[1]
 	}
 	o.webhook.certificatesNamespace = getCertificateNamespace(o)
+	if o.deepInstallationValidation {
+		o.webhook.deepInstallationValidation = &webhook.DeepInstallationValidationOptions{
+			OCIConfig: &config.OCIConfiguration{
+				ConfigFiles:        o.ociConfigFiles,
+				AllowPlainHttp:     o.ociAllowPlainHttp,
+				InsecureSkipVerify: o.ociInsecureSkipVerify,
+			},
+		}
+	}
 	return allErrs.ToAggregate()
 }
 
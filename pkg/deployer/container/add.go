@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,11 +38,16 @@ func AddControllerToManager(logger logging.Logger, hostMgr, lsMgr manager.Manage
 	if err != nil {
 		return fmt.Errorf("unable to create direct client for the host cluster: %w", err)
 	}
+	hostClientset, err := kubernetes.NewForConfig(hostMgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("unable to create clientset for the host cluster: %w", err)
+	}
 	deployer, err := NewDeployer(
 		log,
 		lsMgr.GetClient(),
 		hostMgr.GetClient(),
 		directHostClient,
+		hostClientset,
 		config)
 	if err != nil {
 		return err
@@ -84,15 +90,20 @@ func AddControllerToManager(logger logging.Logger, hostMgr, lsMgr manager.Manage
 		return err
 	}
 
-	if config.GarbageCollection.Disable {
-		log.Info("GarbageCollector disabled")
-		return nil
-	}
-	return NewGarbageCollector(log.WithName("garbageCollector"),
+	gc := NewGarbageCollector(log.WithName("garbageCollector"),
 		lsMgr.GetClient(),
 		hostMgr.GetClient(),
 		config.Identity,
 		config.Namespace,
-		config.GarbageCollection).
-		Add(hostMgr, config.DebugOptions != nil && config.DebugOptions.KeepPod)
+		config.GarbageCollection)
+	// the retention of the captured logs is also enforced if the garbage collector is disabled.
+	if err := gc.AddCapturedLogs(lsMgr); err != nil {
+		return err
+	}
+
+	if config.GarbageCollection.Disable {
+		log.Info("GarbageCollector disabled")
+		return nil
+	}
+	return gc.Add(hostMgr, config.DebugOptions != nil && config.DebugOptions.KeepPod)
 }
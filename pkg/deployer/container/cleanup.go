@@ -107,6 +107,8 @@ func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, l
 		ImagePullSecretName(deployItem.Namespace, deployItem.Name),
 		ComponentDescriptorPullSecretName(deployItem.Namespace, deployItem.Name),
 		BluePrintPullSecretName(deployItem.Namespace, deployItem.Name),
+		StatePushSecretName(deployItem.Namespace, deployItem.Name),
+		StateEncryptionSecretName(deployItem.Namespace, deployItem.Name),
 	}
 
 	for _, secretName := range secrets {
@@ -121,11 +123,10 @@ func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, l
 	}
 
 	// cleanup state
+	// the state of the other backends is deleted by the wait container of the delete operation.
 	if err := state.CleanupState(ctx,
 		log,
-		hostClient,
-		hostNamespace,
-		lsv1alpha1helper.ObjectReferenceFromObject(deployItem)); err != nil {
+		state.NewSecretBackend(hostClient, hostNamespace, lsv1alpha1helper.ObjectReferenceFromObject(deployItem))); err != nil {
 		return err
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/gardener/component-cli/ociclient/cache"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -49,7 +50,9 @@ type Container struct {
 	// directHostClient is non-cached client that directly interact with the apiserver.
 	// it is mainly used for secret and rbac resources
 	directHostClient client.Client
-	Configuration    containerv1alpha1.Configuration
+	// hostClientset is used to read the logs of the pods in the host cluster.
+	hostClientset kubernetes.Interface
+	Configuration containerv1alpha1.Configuration
 
 	DeployItem            *lsv1alpha1.DeployItem
 	Context               *lsv1alpha1.Context
@@ -67,6 +70,7 @@ type Container struct {
 func New(lsClient,
 	hostClient,
 	directHostClient client.Client,
+	hostClientset kubernetes.Interface,
 	config containerv1alpha1.Configuration,
 	item *lsv1alpha1.DeployItem,
 	lsCtx *lsv1alpha1.Context,
@@ -85,6 +89,10 @@ func New(lsClient,
 		return nil, lserrors.NewWrappedError(err,
 			"Init", "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
+	if err := container1alpha1validation.ValidatePodPolicy(providerConfig, config.PodPolicy); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			"Init", "ValidatePodPolicy", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
 
 	status, err := DecodeProviderStatus(item.Status.ProviderStatus)
 	if err != nil {
@@ -96,6 +104,7 @@ func New(lsClient,
 		lsClient:              lsClient,
 		hostClient:            hostClient,
 		directHostClient:      directHostClient,
+		hostClientset:         hostClientset,
 		Configuration:         config,
 		DeployItem:            item,
 		Context:               lsCtx,
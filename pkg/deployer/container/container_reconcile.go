@@ -99,11 +99,17 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 				operationName, "SyncTarget", err.Error())
 		}
 
-		imagePullSecret, blueprintSecret, componentDescriptorSecret, err := c.parseAndSyncSecrets(ctx, defaultLabels)
+		imagePullSecret, blueprintSecret, componentDescriptorSecret, statePushSecret, err := c.parseAndSyncSecrets(ctx, defaultLabels)
 		if err != nil {
 			return lserrors.NewWrappedError(err,
 				operationName, "ParseAndSyncSecrets", err.Error())
 		}
+
+		stateEncryptionSecret, err := c.SyncStateEncryptionKey(ctx, defaultLabels)
+		if err != nil {
+			return lserrors.NewWrappedError(err,
+				operationName, "SyncStateEncryptionKey", err.Error())
+		}
 		// ensure new pod
 		serviceAccountSecrets, err := EnsureServiceAccounts(ctx, c.directHostClient, c.DeployItem, c.Configuration.Namespace, defaultLabels)
 		if err != nil {
@@ -127,6 +133,9 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			ImagePullSecret:               imagePullSecret,
 			BluePrintPullSecret:           blueprintSecret,
 			ComponentDescriptorPullSecret: componentDescriptorSecret,
+			StatePushSecret:               statePushSecret,
+			StateEncryptionSecret:         stateEncryptionSecret,
+			AllowPlainHttp:                c.Configuration.OCI != nil && c.Configuration.OCI.AllowPlainHttp,
 
 			Name:                 c.DeployItem.Name,
 			Namespace:            c.Configuration.Namespace,
@@ -186,6 +195,7 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 		}
 
 		c.ProviderStatus.LastOperation = string(operation)
+		c.ProviderStatus.CapturedLog = c.captureLog(ctx, pod)
 		if err := c.collectAndSetPodStatus(pod, podSucceeded); err != nil {
 			return lserrors.NewWrappedError(err,
 				"Reconcile", "UpdatePodStatus", err.Error())
@@ -481,8 +491,9 @@ func (c *Container) syncSecrets(ctx context.Context,
 	return authSecret.Name, nil
 }
 
-// parseAndSyncSecrets parses and synchronizes relevant pull secrets for container image, blueprint & component descriptor secrets from the landscaper and host cluster.
-func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[string]string) (imagePullSecret, blueprintSecret, componentDescriptorSecret string, erro error) {
+// parseAndSyncSecrets parses and synchronizes relevant pull secrets for container image, blueprint & component descriptor secrets
+// as well as the push secret of the oci state backend from the landscaper and host cluster.
+func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[string]string) (imagePullSecret, blueprintSecret, componentDescriptorSecret, statePushSecret string, erro error) {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 	// find the secrets that match our image, our blueprint and our componentdescriptor
 	ociKeyring := credentials.New()
@@ -552,6 +563,16 @@ func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[s
 		return
 	}
 
+	// sync push secret for the oci state backend
+	if c.ProviderConfiguration.State != nil && c.ProviderConfiguration.State.OCI != nil &&
+		c.ProviderConfiguration.State.Backend == containerv1alpha1.StateBackendOCI {
+		statePushSecret, err = c.syncSecrets(ctx, StatePushSecretName(c.DeployItem.Namespace, c.DeployItem.Name), c.ProviderConfiguration.State.OCI.Repository, ociKeyring, defaultLabels)
+		if err != nil {
+			erro = fmt.Errorf("unable to obtain and sync state push secret to host cluster: %w", err)
+			return
+		}
+	}
+
 	// sync pull secrets for Component Descriptor
 	// sync pull secrets for oci registry repositories
 	if c.ProviderConfiguration.ComponentDescriptor != nil &&
@@ -647,6 +668,46 @@ func (c *Container) SyncConfiguration(ctx context.Context, defaultLabels map[str
 	return nil
 }
 
+// SyncStateEncryptionKey syncs the configured state encryption key from the landscaper cluster as secret to the host cluster.
+// The name of the synced secret is returned or an empty string if no encryption is configured.
+func (c *Container) SyncStateEncryptionKey(ctx context.Context, defaultLabels map[string]string) (string, error) {
+	if c.ProviderConfiguration.State == nil || c.ProviderConfiguration.State.Encryption == nil {
+		return "", nil
+	}
+	// the key must be read from the namespace of the deploy item, otherwise secrets of other namespaces could be exposed.
+	secretRef := c.ProviderConfiguration.State.Encryption.SecretRef
+	if len(secretRef.Namespace) == 0 {
+		secretRef.Namespace = c.DeployItem.Namespace
+	}
+	if secretRef.Namespace != c.DeployItem.Namespace {
+		return "", fmt.Errorf("state encryption secret %s must be located in the namespace %q of the deploy item",
+			secretRef.NamespacedName().String(), c.DeployItem.Namespace)
+	}
+	keySecret := &corev1.Secret{}
+	if err := c.lsClient.Get(ctx, secretRef.NamespacedName(), keySecret); err != nil {
+		return "", fmt.Errorf("unable to get state encryption secret %s: %w", secretRef.NamespacedName().String(), err)
+	}
+	key, ok := keySecret.Data[secretRef.Key]
+	if !ok || len(key) == 0 {
+		return "", fmt.Errorf("state encryption secret %s has no key %q", secretRef.NamespacedName().String(), secretRef.Key)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = StateEncryptionSecretName(c.DeployItem.Namespace, c.DeployItem.Name)
+	secret.Namespace = c.Configuration.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.directHostClient, secret, func() error {
+		InjectDefaultLabels(secret, defaultLabels)
+		kutil.SetMetaDataLabel(&secret.ObjectMeta, container.ContainerDeployerTypeLabel, "state-encryption")
+		secret.Data = map[string][]byte{
+			container.StateEncryptionKeyFilename: key,
+		}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("unable to sync state encryption key to host cluster: %w", err)
+	}
+	return secret.Name, nil
+}
+
 // SyncTarget syncs the deployitem's target content as secret to the host cluster.
 func (c *Container) SyncTarget(ctx context.Context, defaultLabels map[string]string) error {
 	secret := &corev1.Secret{}
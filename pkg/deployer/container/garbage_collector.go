@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -17,17 +18,23 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
@@ -56,6 +63,7 @@ func NewGarbageCollector(log logging.Logger,
 	deployerID,
 	hostNamespace string,
 	config containerv1alpha1.GarbageCollection) *GarbageCollector {
+	containerv1alpha1.SetDefaults_GarbageCollection(&config)
 	return &GarbageCollector{
 		log:           log,
 		deployerID:    deployerID,
@@ -124,6 +132,49 @@ func (gc *GarbageCollector) Add(hostMgr manager.Manager, keepPods bool) error {
 	return nil
 }
 
+// AddCapturedLogs configures the watches for the captured logs of the main containers in the landscaper cluster.
+// Only the configured number of captured logs is kept per deploy item.
+// The secrets and configmaps are read from a separate cache that only contains the captured logs of this deployer.
+func (gc *GarbageCollector) AddCapturedLogs(lsMgr manager.Manager) error {
+	capturedLogsCache, err := cache.New(lsMgr.GetConfig(), cache.Options{
+		Scheme: lsMgr.GetScheme(),
+		Mapper: lsMgr.GetRESTMapper(),
+		DefaultSelector: cache.ObjectSelector{
+			Label: labels.SelectorFromSet(gc.capturedLogLabels()),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create cache for captured logs: %w", err)
+	}
+	if err := lsMgr.Add(capturedLogsCache); err != nil {
+		return fmt.Errorf("unable to add cache for captured logs: %w", err)
+	}
+
+	pred := predicate.NewPredicateFuncs(gc.isCapturedLog)
+
+	objectsToClean := map[client.Object]client.ObjectList{
+		&corev1.Secret{}:    &corev1.SecretList{},
+		&corev1.ConfigMap{}: &corev1.ConfigMapList{},
+	}
+
+	for obj, list := range objectsToClean {
+		name := fmt.Sprintf("captured-log-%s", strings.ToLower(reflect.TypeOf(obj).Elem().Name()))
+		err := ctrl.NewControllerManagedBy(lsMgr).
+			Named(name).
+			Watches(source.NewKindWithCache(obj, capturedLogsCache), &handler.EnqueueRequestForObject{}, builder.WithPredicates(pred)).
+			WithOptions(controller.Options{
+				MaxConcurrentReconciles: gc.config.Worker,
+			}).
+			WithLogConstructor(func(r *reconcile.Request) logr.Logger { return gc.log.WithName(name).Logr() }).
+			Complete(gc.cleanupCapturedLogs(capturedLogsCache, obj.DeepCopyObject().(client.Object), list.DeepCopyObject().(client.ObjectList)))
+		if err != nil {
+			return err
+		}
+		gc.log.Info("Registered container garbage collector", lc.KeyResourceKind, reflect.TypeOf(obj).String())
+	}
+	return nil
+}
+
 func (gc *GarbageCollector) cleanupRBACResources(obj client.Object) reconcile.Reconciler {
 	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 		obj := obj.DeepCopyObject().(client.Object)
@@ -229,6 +280,65 @@ func (gc *GarbageCollector) cleanupPod(ctx context.Context, req reconcile.Reques
 	return reconcile.Result{}, nil
 }
 
+// cleanupCapturedLogs deletes the oldest captured logs of the deploy item of the reconciled object
+// if there are more captured logs than configured.
+// The objects are read with the given reader and deleted with the landscaper cluster client.
+func (gc *GarbageCollector) cleanupCapturedLogs(reader client.Reader, obj client.Object, list client.ObjectList) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		obj := obj.DeepCopyObject().(client.Object)
+		if err := reader.Get(ctx, req.NamespacedName, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return reconcile.Result{}, nil
+			}
+			return reconcile.Result{}, err
+		}
+
+		list := list.DeepCopyObject().(client.ObjectList)
+		if err := reader.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingLabels{
+			container.ContainerDeployerTypeLabel:                container.CapturedLogType,
+			container.ContainerDeployerDeployItemNameLabel:      obj.GetLabels()[container.ContainerDeployerDeployItemNameLabel],
+			container.ContainerDeployerDeployItemNamespaceLabel: obj.GetLabels()[container.ContainerDeployerDeployItemNamespaceLabel],
+		}); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		objects := make([]client.Object, 0)
+		if err := meta.EachListItem(list, func(o runtime.Object) error {
+			objects = append(objects, o.(client.Object))
+			return nil
+		}); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, CleanupCapturedLogs(ctx, gc.lsClient, objects, gc.config.CapturedLogsRetention)
+	})
+}
+
+// capturedLogLabels returns the labels that all captured logs managed by this deployer have.
+func (gc *GarbageCollector) capturedLogLabels() map[string]string {
+	capturedLogLabels := map[string]string{
+		container.ContainerDeployerTypeLabel: container.CapturedLogType,
+	}
+	if len(gc.deployerID) != 0 {
+		capturedLogLabels[container.ContainerDeployerIDLabel] = gc.deployerID
+	}
+	return capturedLogLabels
+}
+
+// isCapturedLog checks whether the object contains a captured log that is managed by this deployer.
+func (gc *GarbageCollector) isCapturedLog(obj client.Object) bool {
+	objLabels := obj.GetLabels()
+	if objLabels[container.ContainerDeployerTypeLabel] != container.CapturedLogType {
+		return false
+	}
+	if _, ok := objLabels[container.ContainerDeployerDeployItemNameLabel]; !ok {
+		return false
+	}
+	if len(gc.deployerID) != 0 && objLabels[container.ContainerDeployerIDLabel] != gc.deployerID {
+		return false
+	}
+	return true
+}
+
 // isLatestPod cleans returns if the current pod is the latest executed pod.
 func (gc *GarbageCollector) isLatestPod(ctx context.Context, pod *corev1.Pod) (bool, error) {
 	var (
@@ -170,7 +170,15 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	log.Info("Copied target content to shared volume.")
 
 	log.Info("Restoring state")
-	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StateDirPath).WithFs(fs).Restore(ctx); err != nil {
+	stateConfig, err := state.ParseConfiguration(opts.StateConfiguration)
+	if err != nil {
+		return err
+	}
+	s, err := state.NewFromConfiguration(ctx, kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StateDirPath, stateConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create state backend: %w", err)
+	}
+	if err := s.WithFs(fs).Restore(ctx); err != nil {
 		return err
 	}
 	log.Info("State has been successfully restored")
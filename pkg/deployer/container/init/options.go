@@ -30,6 +30,8 @@ type options struct {
 	ContentDirPath              string
 	StateDirPath                string
 	RegistrySecretBasePath      string
+	// StateConfiguration is the json encoded configuration of the state backend.
+	StateConfiguration string
 
 	podNamespace string
 
@@ -49,6 +51,7 @@ func (o *options) Complete() {
 	o.ContentDirPath = os.Getenv(container.ContentPathName)
 	o.StateDirPath = os.Getenv(container.StatePathName)
 	o.RegistrySecretBasePath = os.Getenv(container.RegistrySecretBasePathName)
+	o.StateConfiguration = os.Getenv(container.StateConfigurationName)
 
 	o.podNamespace = os.Getenv(container.PodNamespaceName)
 	o.deployItemName = os.Getenv(container.DeployItemName)
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// maxCapturedLogBytes is the maximum size of a captured log.
+// It keeps the object that stores the log well below the size limit of secrets and configmaps.
+const maxCapturedLogBytes int64 = 256 * 1024
+
+// CapturedLogName generates the name of the object that contains the captured log of the run with the given JobID.
+func CapturedLogName(deployItemName, jobID string) string {
+	return fmt.Sprintf("%s-log-%s", deployItemName, jobID)
+}
+
+// captureLog reads the last lines of the main container's log of a finished pod and stores them
+// in a secret or configmap in the namespace of the deploy item.
+// Capturing the log is best effort, so that errors are only logged and nil is returned.
+func (c *Container) captureLog(ctx context.Context, pod *corev1.Pod) *containerv1alpha1.CapturedLogReference {
+	logCapture := c.ProviderConfiguration.LogCapture
+	if logCapture == nil || c.hostClientset == nil || len(c.DeployItem.Status.JobID) == 0 {
+		return nil
+	}
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	lines := container.DefaultCapturedLogLines
+	if logCapture.Lines != nil {
+		lines = *logCapture.Lines
+	}
+	log, err := c.hostClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container:  container.MainContainerName,
+		TailLines:  &lines,
+		LimitBytes: pointer.Int64(maxCapturedLogBytes),
+	}).DoRaw(ctx)
+	if err != nil {
+		logger.Error(err, "unable to read the log of the main container", lc.KeyResource, kutil.ObjectKeyFromObject(pod).String())
+		return nil
+	}
+
+	ref := &containerv1alpha1.CapturedLogReference{
+		Kind:      logCapture.Kind,
+		Name:      CapturedLogName(c.DeployItem.Name, c.DeployItem.Status.JobID),
+		Namespace: c.DeployItem.Namespace,
+		Key:       c.DeployItem.Status.JobID,
+		JobID:     c.DeployItem.Status.JobID,
+	}
+	if len(ref.Kind) == 0 {
+		ref.Kind = containerv1alpha1.LogCaptureKindSecret
+	}
+
+	var obj client.Object
+	switch ref.Kind {
+	case containerv1alpha1.LogCaptureKindConfigMap:
+		obj = &corev1.ConfigMap{}
+	default:
+		obj = &corev1.Secret{}
+	}
+	obj.SetName(ref.Name)
+	obj.SetNamespace(ref.Namespace)
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.lsClient, obj, func() error {
+		InjectDefaultLabels(obj, DefaultLabels(c.Configuration.Identity, c.DeployItem.Name, c.DeployItem.Name, c.DeployItem.Namespace))
+		kutil.SetMetaDataLabel(obj, container.ContainerDeployerTypeLabel, container.CapturedLogType)
+		switch o := obj.(type) {
+		case *corev1.ConfigMap:
+			o.Data = map[string]string{ref.Key: string(log)}
+		case *corev1.Secret:
+			o.Data = map[string][]byte{ref.Key: log}
+		}
+		return controllerutil.SetControllerReference(c.DeployItem, obj, api.LandscaperScheme)
+	}); err != nil {
+		logger.Error(err, "unable to store the captured log of the main container", lc.KeyResource, kutil.ObjectKeyFromObject(obj).String())
+		return nil
+	}
+	return ref
+}
+
+// CleanupCapturedLogs deletes the oldest captured logs of a deploy item so that at most retention logs are kept.
+// The given list has to contain the secrets or configmaps of the deploy item with captured logs.
+func CleanupCapturedLogs(ctx context.Context, kubeClient client.Client, objects []client.Object, retention int) error {
+	if len(objects) <= retention {
+		return nil
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		ti, tj := objects[i].GetCreationTimestamp(), objects[j].GetCreationTimestamp()
+		if ti.Equal(&tj) {
+			return objects[i].GetName() > objects[j].GetName()
+		}
+		return tj.Before(&ti)
+	})
+	for _, obj := range objects[retention:] {
+		if err := kubeClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete captured log %s: %w", kutil.ObjectKeyFromObject(obj).String(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("Captured Logs", func() {
+
+	Context("Capture", func() {
+
+		var (
+			ctx        context.Context
+			server     *httptest.Server
+			logQuery   string
+			lsClient   client.Client
+			deployItem *lsv1alpha1.DeployItem
+			pod        *corev1.Pod
+		)
+
+		newContainer := func(logCapture *containerv1alpha1.LogCapture) *Container {
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+			Expect(err).ToNot(HaveOccurred())
+			return &Container{
+				lsClient:      lsClient,
+				hostClientset: clientset,
+				Configuration: containerv1alpha1.Configuration{Identity: "my-deployer"},
+				DeployItem:    deployItem,
+				ProviderConfiguration: &containerv1alpha1.ProviderConfiguration{
+					LogCapture: logCapture,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctx = logging.NewContextWithDiscard(context.Background())
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/namespaces/host/pods/my-pod/log" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				logQuery = r.URL.RawQuery
+				_, _ = w.Write([]byte("main container log"))
+			}))
+			logQuery = ""
+
+			deployItem = &lsv1alpha1.DeployItem{}
+			deployItem.Name = "my-di"
+			deployItem.Namespace = "default"
+			deployItem.UID = "abc"
+			deployItem.Status.JobID = "job-1"
+			lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(deployItem).Build()
+
+			pod = &corev1.Pod{}
+			pod.Name = "my-pod"
+			pod.Namespace = "host"
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should store the log of the main container in a secret", func() {
+			ref := newContainer(&containerv1alpha1.LogCapture{}).captureLog(ctx, pod)
+			Expect(ref).To(Equal(&containerv1alpha1.CapturedLogReference{
+				Kind:      containerv1alpha1.LogCaptureKindSecret,
+				Name:      "my-di-log-job-1",
+				Namespace: "default",
+				Key:       "job-1",
+				JobID:     "job-1",
+			}))
+			Expect(logQuery).To(ContainSubstring("container=" + container.MainContainerName))
+			Expect(logQuery).To(ContainSubstring("tailLines=100"))
+
+			secret := &corev1.Secret{}
+			Expect(lsClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("job-1", []byte("main container log")))
+			Expect(secret.Labels).To(HaveKeyWithValue(container.ContainerDeployerTypeLabel, container.CapturedLogType))
+			Expect(secret.Labels).To(HaveKeyWithValue(container.ContainerDeployerIDLabel, "my-deployer"))
+			Expect(secret.Labels).To(HaveKeyWithValue(container.ContainerDeployerDeployItemNameLabel, "my-di"))
+			Expect(metav1.IsControlledBy(secret, deployItem)).To(BeTrue())
+		})
+
+		It("should store the configured number of lines in a configmap", func() {
+			ref := newContainer(&containerv1alpha1.LogCapture{
+				Kind:  containerv1alpha1.LogCaptureKindConfigMap,
+				Lines: pointer.Int64(10),
+			}).captureLog(ctx, pod)
+			Expect(ref).ToNot(BeNil())
+			Expect(ref.Kind).To(Equal(containerv1alpha1.LogCaptureKindConfigMap))
+			Expect(logQuery).To(ContainSubstring("tailLines=10"))
+
+			cm := &corev1.ConfigMap{}
+			Expect(lsClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, cm)).To(Succeed())
+			Expect(cm.Data).To(HaveKeyWithValue("job-1", "main container log"))
+		})
+
+		It("should not capture the log if it is not configured", func() {
+			Expect(newContainer(nil).captureLog(ctx, pod)).To(BeNil())
+			Expect(logQuery).To(BeEmpty())
+		})
+
+		It("should not reference a log if the log cannot be read", func() {
+			pod.Name = "unknown"
+			Expect(newContainer(&containerv1alpha1.LogCapture{}).captureLog(ctx, pod)).To(BeNil())
+
+			secrets := &corev1.SecretList{}
+			Expect(lsClient.List(ctx, secrets)).To(Succeed())
+			Expect(secrets.Items).To(BeEmpty())
+		})
+	})
+
+	newCapturedLog := func(jobID string, created time.Time) *corev1.Secret {
+		secret := &corev1.Secret{}
+		secret.Name = CapturedLogName("my-di", jobID)
+		secret.Namespace = "default"
+		secret.CreationTimestamp = metav1.NewTime(created)
+		secret.Data = map[string][]byte{jobID: []byte("log")}
+		return secret
+	}
+
+	It("should delete the oldest captured logs that exceed the retention", func() {
+		ctx := context.Background()
+		now := time.Now()
+		logs := []client.Object{
+			newCapturedLog("job-2", now.Add(-2*time.Minute)),
+			newCapturedLog("job-4", now),
+			newCapturedLog("job-1", now.Add(-3*time.Minute)),
+			newCapturedLog("job-3", now.Add(-1*time.Minute)),
+		}
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(logs...).Build()
+
+		Expect(CleanupCapturedLogs(ctx, kubeClient, logs, 2)).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		names := []string{}
+		for _, secret := range secrets.Items {
+			names = append(names, secret.Name)
+		}
+		Expect(names).To(ConsistOf(
+			CapturedLogName("my-di", "job-3"),
+			CapturedLogName("my-di", "job-4"),
+		))
+	})
+
+	It("should not delete captured logs within the retention", func() {
+		ctx := context.Background()
+		logs := []client.Object{
+			newCapturedLog("job-1", time.Now()),
+		}
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(logs...).Build()
+
+		Expect(CleanupCapturedLogs(ctx, kubeClient, logs, 5)).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/container/state"
 )
 
 // PodTokenPath is the path in the pod that contains the service account token.
@@ -81,6 +82,18 @@ func ComponentDescriptorPullSecretName(deployItemNamespace, deployItemName strin
 	return fmt.Sprintf("%s-%s-cdpullsec", deployItemNamespace, deployItemName)
 }
 
+// StatePushSecretName generates the secret name for the push secret of the oci state backend.
+// todo: use container identity
+func StatePushSecretName(deployItemNamespace, deployItemName string) string {
+	return fmt.Sprintf("%s-%s-statepushsec", deployItemNamespace, deployItemName)
+}
+
+// StateEncryptionSecretName generates the secret name for the state encryption key.
+// todo: use container identity
+func StateEncryptionSecretName(deployItemNamespace, deployItemName string) string {
+	return fmt.Sprintf("%s-%s-stateenc", deployItemNamespace, deployItemName)
+}
+
 // DefaultLabels returns the default labels for a resource generated by the container deployer.
 func DefaultLabels(deployerId, deployerName, diName, diNamespace string) map[string]string {
 	return map[string]string{
@@ -117,6 +130,12 @@ type PodOptions struct {
 	ImagePullSecret                   string
 	BluePrintPullSecret               string
 	ComponentDescriptorPullSecret     string
+	// StatePushSecret is the name of the secret with the credentials for the oci state backend.
+	StatePushSecret string
+	// StateEncryptionSecret is the name of the secret that contains the state encryption key.
+	StateEncryptionSecret string
+	// AllowPlainHttp allows the oci state backend to fall back to http.
+	AllowPlainHttp bool
 
 	Name                 string
 	Namespace            string
@@ -124,8 +143,9 @@ type PodOptions struct {
 	DeployItemNamespace  string
 	DeployItemGeneration int64
 
-	Operation       container.OperationType
-	encBlueprintRef []byte
+	Operation         container.OperationType
+	encBlueprintRef   []byte
+	encStateConfigRef []byte
 
 	Debug bool
 }
@@ -139,6 +159,16 @@ func (o *PodOptions) Complete() error {
 		}
 		o.encBlueprintRef = raw
 	}
+	if o.ProviderConfiguration.State != nil {
+		raw, err := json.Marshal(state.Configuration{
+			StateConfiguration: *o.ProviderConfiguration.State,
+			AllowPlainHttp:     o.AllowPlainHttp,
+		})
+		if err != nil {
+			return err
+		}
+		o.encStateConfigRef = raw
+	}
 	return nil
 }
 
@@ -241,6 +271,10 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 			Name:  container.DeployItemNamespaceName,
 			Value: opts.DeployItemNamespace,
 		},
+		{
+			Name:  container.OperationName,
+			Value: string(opts.Operation),
+		},
 	}
 	additionalEnvVars := []corev1.EnvVar{
 		{
@@ -282,6 +316,21 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		})
 	}
 
+	waitMounts := []corev1.VolumeMount{waitServiceAccountMount, sharedVolumeMount}
+	if len(opts.encStateConfigRef) != 0 {
+		stateEnvVar := corev1.EnvVar{
+			Name:  container.StateConfigurationName,
+			Value: string(opts.encStateConfigRef),
+		}
+		additionalInitEnvVars = append(additionalInitEnvVars, stateEnvVar)
+		additionalSidecarEnvVars = append(additionalSidecarEnvVars, stateEnvVar)
+
+		stateVolumes, stateMounts := stateVolumesAndMounts(opts)
+		volumes = append(volumes, stateVolumes...)
+		initMounts = append(initMounts, stateMounts...)
+		waitMounts = append(waitMounts, stateMounts...)
+	}
+
 	initContainer := corev1.Container{
 		Name:                     container.InitContainerName,
 		Image:                    opts.InitContainer.Image,
@@ -303,22 +352,27 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		Resources:                corev1.ResourceRequirements{},
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          opts.WaitContainer.ImagePullPolicy,
-		VolumeMounts: []corev1.VolumeMount{
-			waitServiceAccountMount,
-			sharedVolumeMount,
-		},
+		VolumeMounts:             waitMounts,
+	}
+
+	mainEnvVars := append(append([]corev1.EnvVar{}, container.DefaultEnvVars...), additionalEnvVars...)
+	mainEnvVars = append(mainEnvVars, opts.ProviderConfiguration.Env...)
+	mainResources := corev1.ResourceRequirements{}
+	if opts.ProviderConfiguration.Resources != nil {
+		mainResources = *opts.ProviderConfiguration.Resources
 	}
+	volumes = append(volumes, opts.ProviderConfiguration.Volumes...)
 
 	mainContainer := corev1.Container{
 		Name:                     container.MainContainerName,
 		Image:                    opts.ProviderConfiguration.Image,
 		Command:                  opts.ProviderConfiguration.Command,
 		Args:                     opts.ProviderConfiguration.Args,
-		Env:                      append(container.DefaultEnvVars, additionalEnvVars...),
-		Resources:                corev1.ResourceRequirements{},
+		Env:                      mainEnvVars,
+		Resources:                mainResources,
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          corev1.PullIfNotPresent,
-		VolumeMounts:             []corev1.VolumeMount{sharedVolumeMount},
+		VolumeMounts:             append([]corev1.VolumeMount{sharedVolumeMount}, opts.ProviderConfiguration.VolumeMounts...),
 	}
 
 	if opts.Debug {
@@ -337,11 +391,10 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
 	pod.Spec.TerminationGracePeriodSeconds = pointer.Int64(300)
 	pod.Spec.Volumes = volumes
-	pod.Spec.SecurityContext = &corev1.PodSecurityContext{
-		RunAsUser:  pointer.Int64(1000),
-		RunAsGroup: pointer.Int64(3000),
-		FSGroup:    pointer.Int64(2000),
-	}
+	pod.Spec.SecurityContext = mergeSecurityContext(opts.ProviderConfiguration.SecurityContext)
+	pod.Spec.ServiceAccountName = opts.ProviderConfiguration.ServiceAccountName
+	pod.Spec.NodeSelector = opts.ProviderConfiguration.NodeSelector
+	pod.Spec.Tolerations = opts.ProviderConfiguration.Tolerations
 	pod.Spec.InitContainers = []corev1.Container{initContainer}
 	pod.Spec.Containers = []corev1.Container{mainContainer, waitContainer}
 	if len(opts.ImagePullSecret) != 0 {
@@ -354,6 +407,85 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	return pod, nil
 }
 
+// mergeSecurityContext merges the security context of the provider configuration onto the default pod security context.
+// The pod runs with user 1000, group 3000 and fs group 2000 unless the provider configuration defines other ids.
+func mergeSecurityContext(secCtx *corev1.PodSecurityContext) *corev1.PodSecurityContext {
+	merged := &corev1.PodSecurityContext{
+		RunAsUser:  pointer.Int64(1000),
+		RunAsGroup: pointer.Int64(3000),
+		FSGroup:    pointer.Int64(2000),
+	}
+	if secCtx == nil {
+		return merged
+	}
+	userSecCtx := secCtx.DeepCopy()
+	if userSecCtx.RunAsUser == nil {
+		userSecCtx.RunAsUser = merged.RunAsUser
+	}
+	if userSecCtx.RunAsGroup == nil {
+		userSecCtx.RunAsGroup = merged.RunAsGroup
+	}
+	if userSecCtx.FSGroup == nil {
+		userSecCtx.FSGroup = merged.FSGroup
+	}
+	return userSecCtx
+}
+
+// stateVolumesAndMounts returns the volumes and the volume mounts that are needed by the init and wait container
+// to access the configured state backend.
+func stateVolumesAndMounts(opts PodOptions) ([]corev1.Volume, []corev1.VolumeMount) {
+	var (
+		stateConfig = opts.ProviderConfiguration.State
+		volumes     []corev1.Volume
+		mounts      []corev1.VolumeMount
+	)
+	if stateConfig.Backend == containerv1alpha1.StateBackendVolume && stateConfig.Volume != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "state-volume",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: stateConfig.Volume.ClaimName,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "state-volume",
+			MountPath: container.StateVolumePath,
+		})
+	}
+	if len(opts.StatePushSecret) != 0 {
+		volumes = append(volumes, corev1.Volume{
+			Name: "state-push-secret",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: opts.StatePushSecret,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "state-push-secret",
+			ReadOnly:  true,
+			MountPath: filepath.Join(container.RegistrySecretBasePath, "state-push-secret"),
+		})
+	}
+	if len(opts.StateEncryptionSecret) != 0 {
+		volumes = append(volumes, corev1.Volume{
+			Name: "state-encryption",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: opts.StateEncryptionSecret,
+				},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      "state-encryption",
+			ReadOnly:  true,
+			MountPath: filepath.Dir(container.StateEncryptionKeyPath),
+		})
+	}
+	return volumes, mounts
+}
+
 // getPod returns the latest executed pod.
 // Pods that have no finalizer are ignored.
 func (c *Container) getPod(ctx context.Context) (*corev1.Pod, error) {
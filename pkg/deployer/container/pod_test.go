@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+var _ = Describe("Pod", func() {
+
+	newPodOptions := func(secCtx *corev1.PodSecurityContext) PodOptions {
+		return PodOptions{
+			ProviderConfiguration: &containerv1alpha1.ProviderConfiguration{
+				Image:           "example.com/image:v1",
+				SecurityContext: secCtx,
+			},
+			Name:                "my-pod",
+			Namespace:           "default",
+			DeployItemName:      "my-di",
+			DeployItemNamespace: "default",
+		}
+	}
+
+	It("should run the pod with the default security context", func() {
+		pod, err := generatePod(newPodOptions(nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+			RunAsUser:  pointer.Int64(1000),
+			RunAsGroup: pointer.Int64(3000),
+			FSGroup:    pointer.Int64(2000),
+		}))
+	})
+
+	It("should merge the security context of the provider configuration onto the defaults", func() {
+		pod, err := generatePod(newPodOptions(&corev1.PodSecurityContext{
+			RunAsUser:    pointer.Int64(1001),
+			RunAsNonRoot: pointer.Bool(true),
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+			RunAsUser:    pointer.Int64(1001),
+			RunAsGroup:   pointer.Int64(3000),
+			FSGroup:      pointer.Int64(2000),
+			RunAsNonRoot: pointer.Bool(true),
+		}))
+	})
+
+})
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/landscaper/apis/deployer/container"
 
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
@@ -34,6 +35,7 @@ func NewDeployer(log logging.Logger,
 	lsKubeClient client.Client,
 	hostKubeClient client.Client,
 	directHostClient client.Client,
+	hostClientset kubernetes.Interface,
 	config containerv1alpha1.Configuration) (*deployer, error) {
 
 	var sharedCache cache.Cache
@@ -50,6 +52,7 @@ func NewDeployer(log logging.Logger,
 		lsClient:         lsKubeClient,
 		hostClient:       hostKubeClient,
 		directHostClient: directHostClient,
+		hostClientset:    hostClientset,
 		config:           config,
 		sharedCache:      sharedCache,
 		hooks:            extension.ReconcileExtensionHooks{},
@@ -63,13 +66,14 @@ type deployer struct {
 	lsClient         client.Client
 	hostClient       client.Client
 	directHostClient client.Client
+	hostClientset    kubernetes.Interface
 	config           containerv1alpha1.Configuration
 	sharedCache      cache.Cache
 	hooks            extension.ReconcileExtensionHooks
 }
 
 func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
-	containerOp, err := New(d.lsClient, d.hostClient, d.directHostClient, d.config, di, lsCtx, d.sharedCache, rt)
+	containerOp, err := New(d.lsClient, d.hostClient, d.directHostClient, d.hostClientset, d.config, di, lsCtx, d.sharedCache, rt)
 	if err != nil {
 		return err
 	}
@@ -78,7 +82,7 @@ func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di
 }
 
 func (d deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
-	containerOp, err := New(d.lsClient, d.hostClient, d.directHostClient, d.config, di, lsCtx, d.sharedCache, rt)
+	containerOp, err := New(d.lsClient, d.hostClient, d.directHostClient, d.hostClientset, d.config, di, lsCtx, d.sharedCache, rt)
 	if err != nil {
 		return err
 	}
@@ -97,7 +101,7 @@ func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
 
 func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1alpha1.DeployItem) (*time.Time, error) {
 	// TODO: parse provider configuration directly and do not init the container helper struct
-	containerOp, err := New(d.lsClient, d.hostClient, d.directHostClient, d.config, di, nil, d.sharedCache, nil)
+	containerOp, err := New(d.lsClient, d.hostClient, d.directHostClient, d.hostClientset, d.config, di, nil, d.sharedCache, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+// Backend stores the versions of the packaged state of a deploy item.
+type Backend interface {
+	// Store stores the packaged state as new version.
+	Store(ctx context.Context, version string, data []byte) error
+	// Load returns the packaged state of a version.
+	Load(ctx context.Context, version string) ([]byte, error)
+	// Versions returns all stored versions ordered from the oldest to the latest version.
+	Versions(ctx context.Context) ([]string, error)
+	// DeleteVersion deletes a version.
+	DeleteVersion(ctx context.Context, version string) error
+	// Delete deletes all versions of the deploy item.
+	Delete(ctx context.Context) error
+}
+
+// NewVersion generates a new version of the state.
+// Versions are ordered by their creation time when they are sorted lexically.
+func NewVersion() string {
+	return fmt.Sprintf("%s-%s", time.Now().UTC().Format("20060102150405.000000000"), uuid.New().String()[:8])
+}
+
+// Configuration is the configuration of the state that is passed to the init and wait container.
+type Configuration struct {
+	containerv1alpha1.StateConfiguration `json:",inline"`
+	// AllowPlainHttp allows the fallback to http if the registry of the oci backend does not support https.
+	AllowPlainHttp bool `json:"allowPlainHttp,omitempty"`
+}
+
+// ParseConfiguration parses the json encoded state configuration.
+// The default configuration is returned if the data is empty.
+func ParseConfiguration(data string) (*Configuration, error) {
+	cfg := &Configuration{}
+	if len(data) == 0 {
+		return cfg, nil
+	}
+	if err := json.Unmarshal([]byte(data), cfg); err != nil {
+		return nil, fmt.Errorf("unable to parse state configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// NewFromConfiguration creates a new state instance with the backend, the encryption
+// and the history of the given configuration.
+// The secret backend uses the given kubernetes client and namespace.
+func NewFromConfiguration(ctx context.Context,
+	kubeClient client.Client,
+	namespace string,
+	deployItemKey lsv1alpha1.ObjectReference,
+	statePath string,
+	cfg *Configuration) (*State, error) {
+	s := New(kubeClient, namespace, deployItemKey, statePath).
+		WithHistory(int(cfg.History)).
+		WithRestoreVersion(cfg.RestoreVersion)
+
+	switch cfg.Backend {
+	case "", containerv1alpha1.StateBackendSecret:
+	case containerv1alpha1.StateBackendOCI:
+		if cfg.OCI == nil {
+			return nil, fmt.Errorf("no oci backend configured")
+		}
+		backend, err := NewOCIBackend(ctx, osfs.New(), container.RegistrySecretBasePath, cfg.OCI.Repository, cfg.AllowPlainHttp, deployItemKey)
+		if err != nil {
+			return nil, err
+		}
+		s.WithBackend(backend)
+	case containerv1alpha1.StateBackendVolume:
+		s.WithBackend(NewVolumeBackend(osfs.New(), container.StateVolumePath, deployItemKey))
+	default:
+		return nil, fmt.Errorf("unknown state backend %q", cfg.Backend)
+	}
+
+	if cfg.Encryption != nil {
+		key, err := vfs.ReadFile(osfs.New(), container.StateEncryptionKeyPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("state encryption is configured but no key is available at %s", container.StateEncryptionKeyPath)
+			}
+			return nil, fmt.Errorf("unable to read state encryption key: %w", err)
+		}
+		s.WithEncryptionKey(key)
+	}
+	return s, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// encryptedStatePrefix marks an encrypted state.
+// States without the prefix are unencrypted, which allows to enable the encryption for existing states.
+var encryptedStatePrefix = []byte("lsstate:aes-gcm:v1:")
+
+const (
+	// saltSize is the size of the random salt that is used to derive the key from the passphrase.
+	saltSize = 16
+	// the scrypt parameters recommended for interactive logins as of 2017.
+	scryptN      = 32768
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// encrypt encrypts the data with AES-256-GCM.
+// The key is derived from the passphrase with scrypt and a random salt.
+// The encrypted data has the format "<prefix><salt><nonce><ciphertext>".
+func encrypt(passphrase, data []byte) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("unable to generate salt: %w", err)
+	}
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+
+	result := append([]byte{}, encryptedStatePrefix...)
+	result = append(result, salt...)
+	result = append(result, nonce...)
+	return gcm.Seal(result, nonce, data, nil), nil
+}
+
+// decrypt decrypts data that has been encrypted with encrypt.
+func decrypt(passphrase, data []byte) ([]byte, error) {
+	data = bytes.TrimPrefix(data, encryptedStatePrefix)
+	if len(data) < saltSize {
+		return nil, fmt.Errorf("encrypted state is too short")
+	}
+	salt, data := data[:saltSize], data[saltSize:]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("encrypted state is too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// isEncrypted checks whether the data has been encrypted with encrypt.
+func isEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, encryptedStatePrefix)
+}
+
+func newGCM(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
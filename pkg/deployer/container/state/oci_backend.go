@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/gardener/component-cli/ociclient/cache"
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// StateLayerMediaType is the media type of the layer that contains the packaged state.
+const StateLayerMediaType = "application/vnd.gardener.landscaper.container.state.v1.tar+gzip"
+
+// StateVersionAnnotation is the annotation of the manifest that contains the version of the state.
+// It ensures that the manifests of versions with the same content differ, so that deleting one version by its digest
+// never deletes another version.
+const StateVersionAnnotation = "landscaper.gardener.cloud/state-version"
+
+// emptyConfig is the config blob of the state artifacts.
+var emptyConfig = []byte("{}")
+
+// OCIBackend stores the state as oci artifacts in a registry.
+// The versions of a deploy item are stored as tags of the repository "<repository>/<deploy item namespace>/<deploy item name>".
+// Versions are deleted by deleting their manifests. If the registry does not support the deletion of manifests,
+// old versions have to be cleaned up by the retention policy of the registry.
+type OCIBackend struct {
+	client         ociclient.ExtendedClient
+	keyring        credentials.OCIKeyring
+	allowPlainHttp bool
+	ref            string
+}
+
+var _ Backend = &OCIBackend{}
+
+// NewOCIBackend creates a new backend that stores the state in the given oci repository.
+// The credentials are read from all docker config files in the given secrets directory.
+func NewOCIBackend(ctx context.Context,
+	fs vfs.FileSystem,
+	registrySecretsDir string,
+	repository string,
+	allowPlainHttp bool,
+	deployItem lsv1alpha1.ObjectReference) (*OCIBackend, error) {
+	log, _ := logging.FromContextOrNew(ctx, nil)
+
+	var configFiles []string
+	err := vfs.Walk(fs, registrySecretsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || info.Name() != corev1.DockerConfigJsonKey {
+			return nil
+		}
+		configFiles = append(configFiles, path)
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("unable to read registry secrets: %w", err)
+	}
+
+	keyring, err := credentials.CreateOCIRegistryKeyringFromFilesystem(nil, configFiles, fs)
+	if err != nil {
+		return nil, err
+	}
+	client, err := ociclient.NewClient(log.Logr(), ociclient.WithKeyring(keyring), ociclient.AllowPlainHttp(allowPlainHttp))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create oci client: %w", err)
+	}
+	return NewOCIBackendWithClient(client, keyring, repository, allowPlainHttp, deployItem), nil
+}
+
+// NewOCIBackendWithClient creates a new oci backend with the given oci client.
+// The keyring is used to authenticate the deletion of versions.
+func NewOCIBackendWithClient(client ociclient.ExtendedClient,
+	keyring credentials.OCIKeyring,
+	repository string,
+	allowPlainHttp bool,
+	deployItem lsv1alpha1.ObjectReference) *OCIBackend {
+	return &OCIBackend{
+		client:         client,
+		keyring:        keyring,
+		allowPlainHttp: allowPlainHttp,
+		ref:            fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(repository, "/"), deployItem.Namespace, deployItem.Name),
+	}
+}
+
+// Store pushes the data as single layer artifact with an empty config tagged with the version.
+func (b *OCIBackend) Store(ctx context.Context, version string, data []byte) error {
+	store := cache.NewInMemoryCache()
+	configDesc := ocispecv1.Descriptor{
+		MediaType: ocispecv1.MediaTypeImageConfig,
+		Digest:    digest.FromBytes(emptyConfig),
+		Size:      int64(len(emptyConfig)),
+	}
+	if err := store.Add(configDesc, io.NopCloser(bytes.NewReader(emptyConfig))); err != nil {
+		return fmt.Errorf("unable to add state config to the store: %w", err)
+	}
+	desc := ocispecv1.Descriptor{
+		MediaType: StateLayerMediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+	if err := store.Add(desc, io.NopCloser(bytes.NewReader(data))); err != nil {
+		return fmt.Errorf("unable to add state to the store: %w", err)
+	}
+
+	manifest := &ocispecv1.Manifest{
+		MediaType: ocispecv1.MediaTypeImageManifest,
+		Config:    configDesc,
+		Layers:    []ocispecv1.Descriptor{desc},
+		Annotations: map[string]string{
+			StateVersionAnnotation: version,
+		},
+	}
+	manifest.SchemaVersion = 2
+	if err := b.client.PushManifest(ctx, b.versionRef(version), manifest, ociclient.WithStore(store)); err != nil {
+		return fmt.Errorf("unable to push state to %s: %w", b.versionRef(version), err)
+	}
+	return nil
+}
+
+// Load fetches the state layer of the version.
+func (b *OCIBackend) Load(ctx context.Context, version string) ([]byte, error) {
+	ref := b.versionRef(version)
+	manifest, err := b.client.GetManifest(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get manifest of %s: %w", ref, err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != StateLayerMediaType {
+			continue
+		}
+		var data bytes.Buffer
+		if err := b.client.Fetch(ctx, ref, layer, &data); err != nil {
+			return nil, fmt.Errorf("unable to fetch state layer of %s: %w", ref, err)
+		}
+		return data.Bytes(), nil
+	}
+	return nil, fmt.Errorf("no layer with media type %q found in %s", StateLayerMediaType, ref)
+}
+
+// Versions returns the tags of the repository ordered by their name.
+// No versions are returned if the repository does not exist yet.
+func (b *OCIBackend) Versions(ctx context.Context) ([]string, error) {
+	tags, err := b.client.ListTags(ctx, b.ref)
+	if err != nil {
+		// the oci client does not return typed errors, therefore the not found response is detected by its message.
+		if strings.Contains(err.Error(), "status code 404") || strings.Contains(err.Error(), "NAME_UNKNOWN") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to list tags of %s: %w", b.ref, err)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// DeleteVersion deletes the manifest of the version.
+// The manifest is deleted by its tag. Registries that do not support the deletion by tag are asked to delete the
+// manifest by its digest. Registries that do not support the deletion of manifests at all are ignored.
+func (b *OCIBackend) DeleteVersion(ctx context.Context, version string) error {
+	var opts []name.Option
+	if b.allowPlainHttp {
+		opts = append(opts, name.Insecure)
+	}
+	ref, err := name.ParseReference(b.versionRef(version), opts...)
+	if err != nil {
+		return fmt.Errorf("unable to parse reference %s: %w", b.versionRef(version), err)
+	}
+
+	var auth authn.Authenticator = authn.Anonymous
+	if b.keyring != nil {
+		auth, err = b.keyring.ResolveWithContext(ctx, ref.Context())
+		if err != nil {
+			return fmt.Errorf("unable to get credentials for %s: %w", ref.Context().String(), err)
+		}
+	}
+	trp, err := transport.NewWithContext(ctx, ref.Context().Registry, auth, http.DefaultTransport,
+		[]string{ref.Scope(transport.DeleteScope)})
+	if err != nil {
+		return fmt.Errorf("unable to create transport for %s: %w", ref.Context().String(), err)
+	}
+	httpClient := &http.Client{Transport: trp}
+
+	statusCode, err := deleteManifest(ctx, httpClient, ref.Context(), version)
+	if err != nil {
+		return fmt.Errorf("unable to delete %s: %w", ref.String(), err)
+	}
+	if statusCode == http.StatusBadRequest || statusCode == http.StatusMethodNotAllowed {
+		_, desc, err := b.client.Resolve(ctx, ref.String())
+		if err != nil {
+			return fmt.Errorf("unable to resolve %s: %w", ref.String(), err)
+		}
+		statusCode, err = deleteManifest(ctx, httpClient, ref.Context(), desc.Digest.String())
+		if err != nil {
+			return fmt.Errorf("unable to delete %s: %w", ref.String(), err)
+		}
+	}
+
+	switch statusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNotFound:
+		return nil
+	case http.StatusMethodNotAllowed:
+		logging.FromContextOrDiscard(ctx).Info("Registry does not support the deletion of manifests", "ref", ref.String())
+		return nil
+	default:
+		return fmt.Errorf("unable to delete %s: unexpected status code %d", ref.String(), statusCode)
+	}
+}
+
+// deleteManifest deletes the manifest with the given tag or digest and returns the status code of the registry.
+func deleteManifest(ctx context.Context, httpClient *http.Client, repo name.Repository, reference string) (int, error) {
+	u := url.URL{
+		Scheme: repo.Registry.Scheme(),
+		Host:   repo.RegistryStr(),
+		Path:   fmt.Sprintf("/v2/%s/manifests/%s", repo.RepositoryStr(), reference),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// Delete deletes the manifests of all versions.
+func (b *OCIBackend) Delete(ctx context.Context) error {
+	versions, err := b.Versions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		if err := b.DeleteVersion(ctx, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *OCIBackend) versionRef(version string) string {
+	return fmt.Sprintf("%s:%s", b.ref, version)
+}
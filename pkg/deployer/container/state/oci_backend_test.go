@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state_test
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/container/state"
+	"github.com/gardener/landscaper/test/utils"
+)
+
+var _ = Describe("OCI Backend", func() {
+
+	var (
+		ctx        context.Context
+		server     *httptest.Server
+		client     ociclient.ExtendedClient
+		backend    *state.OCIBackend
+		repository string
+		deployItem = lsv1alpha1.ObjectReference{Name: "testname", Namespace: "testns"}
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		server = httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+		u, err := url.Parse(server.URL)
+		utils.ExpectNoError(err)
+		repository = u.Host + "/states"
+
+		client, err = ociclient.NewClient(logging.Discard().Logr(), ociclient.AllowPlainHttp(true))
+		utils.ExpectNoError(err)
+		backend = state.NewOCIBackendWithClient(client, nil, repository, true, deployItem)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should store a version as valid oci image manifest and load it", func() {
+		utils.ExpectNoError(backend.Store(ctx, "v1", []byte("state")))
+
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(ConsistOf("v1"))
+
+		manifest, err := client.GetManifest(ctx, repository+"/testns/testname:v1")
+		utils.ExpectNoError(err)
+		Expect(manifest.Config.MediaType).To(Equal(ocispecv1.MediaTypeImageConfig))
+		Expect(manifest.Config.Size).To(Equal(int64(2)))
+		Expect(manifest.Layers).To(HaveLen(1))
+		Expect(manifest.Layers[0].MediaType).To(Equal(state.StateLayerMediaType))
+
+		data, err := backend.Load(ctx, "v1")
+		utils.ExpectNoError(err)
+		Expect(string(data)).To(Equal("state"))
+	})
+
+	It("should return no versions if the repository does not exist", func() {
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(BeEmpty())
+	})
+
+	It("should delete a version without deleting versions with the same content", func() {
+		utils.ExpectNoError(backend.Store(ctx, "v1", []byte("state")))
+		utils.ExpectNoError(backend.Store(ctx, "v2", []byte("state")))
+
+		utils.ExpectNoError(backend.DeleteVersion(ctx, "v1"))
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(ConsistOf("v2"))
+
+		data, err := backend.Load(ctx, "v2")
+		utils.ExpectNoError(err)
+		Expect(string(data)).To(Equal("state"))
+	})
+
+	It("should delete all versions", func() {
+		utils.ExpectNoError(backend.Store(ctx, "v1", []byte("state1")))
+		utils.ExpectNoError(backend.Store(ctx, "v2", []byte("state2")))
+
+		utils.ExpectNoError(state.CleanupState(ctx, logging.Discard(), backend))
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(BeEmpty())
+	})
+
+	It("should backup and restore an encrypted state", func() {
+		var (
+			fs           = memoryfs.New()
+			resFs        = memoryfs.New()
+			testDir      = "/mystate"
+			testFilePath = path.Join(testDir, "my-file")
+		)
+		utils.ExpectNoError(fs.MkdirAll(testDir, os.ModePerm))
+		utils.ExpectNoError(vfs.WriteFile(fs, testFilePath, []byte("text"), os.ModePerm))
+
+		s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithEncryptionKey([]byte("my-key"))
+		utils.ExpectNoError(s.WithFs(fs).Backup(ctx))
+		utils.ExpectNoError(s.WithFs(resFs).Restore(ctx))
+
+		resData, err := vfs.ReadFile(resFs, testFilePath)
+		utils.ExpectNoError(err)
+		Expect(string(resData)).To(Equal("text"))
+	})
+
+})
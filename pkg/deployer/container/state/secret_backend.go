@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+)
+
+// SecretBackend stores the state in secrets in the host cluster.
+// As the size of a secret is limited, the state is split into chunks of 1MB.
+// All chunks of a version have the version as uuid annotation.
+type SecretBackend struct {
+	kubeClient client.Client
+	// namespace is the namespace where the state secrets should be created.
+	namespace  string
+	deployItem lsv1alpha1.ObjectReference
+}
+
+var _ Backend = &SecretBackend{}
+
+// NewSecretBackend creates a new backend that stores the state in secrets.
+func NewSecretBackend(kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference) *SecretBackend {
+	return &SecretBackend{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		deployItem: deployItem,
+	}
+}
+
+// Store splits the data into chunks of 1MB and uploads them as secrets.
+func (b *SecretBackend) Store(ctx context.Context, version string, data []byte) error {
+	const chunkSize = corev1.MaxSecretSize // 1 MB
+	for count := 0; count*chunkSize < len(data); count++ {
+		end := (count + 1) * chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		secret := &corev1.Secret{}
+		secret.GenerateName = fmt.Sprintf("state-%s-%s-", b.deployItem.Namespace, b.deployItem.Name)
+		secret.Namespace = b.namespace
+		secret.Labels = map[string]string{
+			container.ContainerDeployerDeployItemNameLabel:      b.deployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: b.deployItem.Namespace,
+			container.ContainerDeployerTypeLabel:                "state", // todo: make const
+		}
+		secret.Annotations = map[string]string{
+			container.ContainerDeployerStateUUIDAnnotation: version,
+			container.ContainerDeployerStateNumAnnotation:  strconv.Itoa(count),
+		}
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: data[count*chunkSize : end],
+		}
+
+		if err := b.kubeClient.Create(ctx, secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load concatenates the chunks of a version.
+func (b *SecretBackend) Load(ctx context.Context, version string) ([]byte, error) {
+	secrets, err := b.listSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chunks := secrets[version]
+	if len(chunks) == 0 {
+		return nil, fmt.Errorf("no secrets found for state version %q", version)
+	}
+	sort.Sort(stateSecretsList(chunks))
+
+	var data bytes.Buffer
+	for _, secret := range chunks {
+		chunk, ok := secret.Data[lsv1alpha1.DataObjectSecretDataKey]
+		if !ok {
+			return nil, fmt.Errorf("expected chunk in secret %s", secret.Name)
+		}
+		data.Write(chunk)
+	}
+	return data.Bytes(), nil
+}
+
+// Versions returns the versions ordered by the creation time of their secrets.
+func (b *SecretBackend) Versions(ctx context.Context) ([]string, error) {
+	secrets, err := b.listSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created := map[string]metav1.Time{}
+	versions := make([]string, 0, len(secrets))
+	for version, chunks := range secrets {
+		versions = append(versions, version)
+		for _, secret := range chunks {
+			if t, ok := created[version]; !ok || t.Before(&secret.CreationTimestamp) {
+				created[version] = secret.CreationTimestamp
+			}
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		ti, tj := created[versions[i]], created[versions[j]]
+		if ti.Equal(&tj) {
+			return versions[i] < versions[j]
+		}
+		return ti.Before(&tj)
+	})
+	return versions, nil
+}
+
+// DeleteVersion deletes all secrets of a version.
+func (b *SecretBackend) DeleteVersion(ctx context.Context, version string) error {
+	secrets, err := b.listSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets[version] {
+		if err := b.kubeClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete state secret %s: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// Delete deletes all state secrets of the deploy item.
+func (b *SecretBackend) Delete(ctx context.Context) error {
+	secrets, err := b.listSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, chunks := range secrets {
+		for _, secret := range chunks {
+			if err := b.kubeClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("unable to delete state secret %s: %w", secret.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// listSecrets returns the state secrets of the deploy item grouped by their version.
+func (b *SecretBackend) listSecrets(ctx context.Context) (map[string][]*corev1.Secret, error) {
+	if len(b.namespace) == 0 {
+		return nil, fmt.Errorf("a target namespace has to be defined")
+	}
+	secretList := &corev1.SecretList{}
+	if err := b.kubeClient.List(ctx, secretList, StateSecretListOptions(b.namespace, b.deployItem)...); err != nil {
+		return nil, err
+	}
+	secrets := map[string][]*corev1.Secret{}
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		version := secret.Annotations[container.ContainerDeployerStateUUIDAnnotation]
+		secrets[version] = append(secrets[version], secret)
+	}
+	return secrets, nil
+}
+
+type stateSecretsList []*corev1.Secret
+
+func (s stateSecretsList) Len() int { return len(s) }
+
+func (s stateSecretsList) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s stateSecretsList) Less(i, j int) bool {
+	numI, _ := strconv.Atoi(s[i].Annotations[container.ContainerDeployerStateNumAnnotation])
+	numJ, _ := strconv.Atoi(s[j].Annotations[container.ContainerDeployerStateNumAnnotation])
+	return numI < numJ
+}
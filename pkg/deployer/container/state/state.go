@@ -8,48 +8,46 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"math"
 	"os"
-	"sort"
-	"strconv"
-	"sync"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/container"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
-	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/components/model/tar"
 )
 
 // State handles the backup and restore of state of container deploy item.
 type State struct {
 	deployItem lsv1alpha1.ObjectReference
-	// namespace is the namespace where the state secrets should be created.
-	namespace  string
-	kubeClient client.Client
+	backend    Backend
 	fs         vfs.FileSystem
 	path       string
+
+	// encryptionKey is the passphrase that is used to encrypt the state.
+	// The state is not encrypted if no key is set.
+	encryptionKey []byte
+	// history is the number of versions that are kept.
+	history int
+	// restoreVersion is the version that should be restored instead of the latest version.
+	restoreVersion string
 }
 
-// New creates a new state instance.
+// New creates a new state instance that stores the state in secrets in the given namespace.
 func New(kubeClient client.Client, namespace string, deployItemKey lsv1alpha1.ObjectReference, statePath string) *State {
 	return &State{
 		deployItem: deployItemKey,
-		namespace:  namespace,
-		kubeClient: kubeClient,
+		backend:    NewSecretBackend(kubeClient, namespace, deployItemKey),
 		fs:         osfs.New(),
 		path:       statePath,
+		history:    1,
 	}
 }
 
@@ -59,7 +57,35 @@ func (s *State) WithFs(fs vfs.FileSystem) *State {
 	return s
 }
 
-// Backup tars the content of the State directory and stores it in a secrets in the cluster.
+// WithBackend sets the backend that stores the versions of the state.
+func (s *State) WithBackend(backend Backend) *State {
+	s.backend = backend
+	return s
+}
+
+// WithEncryptionKey sets the passphrase that is used to encrypt and decrypt the state.
+func (s *State) WithEncryptionKey(key []byte) *State {
+	s.encryptionKey = key
+	return s
+}
+
+// WithHistory sets the number of versions that are kept.
+func (s *State) WithHistory(history int) *State {
+	if history < 1 {
+		history = 1
+	}
+	s.history = history
+	return s
+}
+
+// WithRestoreVersion sets the version that is restored.
+// The latest version is restored if the version is empty.
+func (s *State) WithRestoreVersion(version string) *State {
+	s.restoreVersion = version
+	return s
+}
+
+// Backup tars the content of the State directory and stores it as new version in the backend.
 func (s *State) Backup(ctx context.Context) error {
 	// do nothing if there is no State to persist
 	files, err := vfs.ReadDir(s.fs, s.path)
@@ -73,28 +99,24 @@ func (s *State) Backup(ctx context.Context) error {
 	}
 
 	// tar and gzip the State content
-
-	// todo: check if it would be possible to have the whole State in memory.
-	tmpFile, err := vfs.TempFile(s.fs, s.fs.FSTempDir(), "state-")
-	if err != nil {
-		return err
-	}
-	if err := tar.BuildTarGzip(s.fs, s.path, tmpFile); err != nil {
-		tmpFile.Close()
+	var data bytes.Buffer
+	if err := tar.BuildTarGzip(s.fs, s.path, &data); err != nil {
 		return errors.Wrap(err, "unable to tar and gzip State")
 	}
-	tmpFile.Close()
-	defer func() {
-		if err := os.Remove(tmpFile.Name()); err != nil {
-			log.Error(err, "Unable to remove tmp State file")
+
+	content := data.Bytes()
+	if len(s.encryptionKey) != 0 {
+		content, err = encrypt(s.encryptionKey, content)
+		if err != nil {
+			return errors.Wrap(err, "unable to encrypt State")
 		}
-	}()
+	}
 
-	// split the file in chunks of 1MB (Secret size limit)
-	_, err = s.splitFileAndUploadChunks(ctx, tmpFile.Name())
-	if err != nil {
-		return err
+	version := NewVersion()
+	if err := s.backend.Store(ctx, version, content); err != nil {
+		return fmt.Errorf("unable to store state version %q: %w", version, err)
 	}
+	log.Info("State has been stored", "version", version, "size", len(content))
 	return nil
 }
 
@@ -108,14 +130,12 @@ func StateSecretListOptions(namespace string, deployItem lsv1alpha1.ObjectRefere
 	return []client.ListOption{labelSelector, client.InNamespace(namespace)}
 }
 
-// Restore restores the latest state from the k8s cluster to the configured state path.
+// Restore restores the latest or the configured version of the state to the configured state path.
+// Afterwards, the versions that exceed the history are deleted.
 func (s *State) Restore(ctx context.Context) error {
 	if len(s.deployItem.Name) == 0 || len(s.deployItem.Namespace) == 0 {
 		return fmt.Errorf("a deployitem has to be defined")
 	}
-	if len(s.namespace) == 0 {
-		return fmt.Errorf("a target namespace has to be defined")
-	}
 
 	if _, err := s.fs.Stat(s.path); err != nil {
 		if !os.IsNotExist(err) {
@@ -126,148 +146,76 @@ func (s *State) Restore(ctx context.Context) error {
 		}
 	}
 
-	secretList := &corev1.SecretList{}
-	if err := s.kubeClient.List(ctx, secretList, StateSecretListOptions(s.namespace, s.deployItem)...); err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
+	versions, err := s.backend.Versions(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list state versions: %w", err)
+	}
+	if len(versions) == 0 {
+		if len(s.restoreVersion) != 0 {
+			return fmt.Errorf("state version %q not found", s.restoreVersion)
 		}
-		return err
+		return nil
 	}
 
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 
-	// the secrets are grouped by uuid and sorted by their creation date
-	log.Info("Restoring state from secrets", "secretCount", len(secretList.Items))
-	secrets := map[string][]*corev1.Secret{}
-	var newest *corev1.Secret
-	for _, secret := range secretList.Items {
-		if newest == nil || newest.CreationTimestamp.Before(&secret.CreationTimestamp) {
-			newest = &secret
+	version := versions[len(versions)-1]
+	if len(s.restoreVersion) != 0 {
+		if !containsVersion(versions, s.restoreVersion) {
+			return fmt.Errorf("state version %q not found", s.restoreVersion)
 		}
-		uuidStr := secret.Annotations[container.ContainerDeployerStateUUIDAnnotation]
-		secrets[uuidStr] = append(secrets[uuidStr], &secret)
-	}
-	if newest == nil {
-		return nil
-	}
-	newestUuid := newest.Annotations[container.ContainerDeployerStateUUIDAnnotation]
-	if err := s.restoreFromSecrets(secrets[newestUuid]); err != nil {
-		return err
+		version = s.restoreVersion
 	}
 
-	// garbage collect the old states
-	wg := sync.WaitGroup{}
-	for uuidStr, sc := range secrets {
-		if uuidStr == newestUuid {
-			continue
-		}
-		wg.Add(1)
-		go func(ctx context.Context, secrets []*corev1.Secret) {
-			defer wg.Done()
-			s.gcOldSecrets(ctx, secrets)
-		}(ctx, sc)
+	log.Info("Restoring state", "version", version, "versionCount", len(versions))
+	content, err := s.backend.Load(ctx, version)
+	if err != nil {
+		return fmt.Errorf("unable to load state version %q: %w", version, err)
 	}
-	wg.Wait()
-
-	return nil
-}
-
-func (s *State) restoreFromSecrets(secrets []*corev1.Secret) error {
-	sort.Sort(stateSecretsList(secrets))
-
-	// todo: need to write to filesystem
-	var data bytes.Buffer
-	for _, secret := range secrets {
-		chunk, ok := secret.Data[lsv1alpha1.DataObjectSecretDataKey]
-		if !ok {
-			return fmt.Errorf("expected chunk in secret %s", secret.Name)
+	if isEncrypted(content) {
+		if len(s.encryptionKey) == 0 {
+			return fmt.Errorf("state version %q is encrypted but no encryption key is configured", version)
 		}
-		data.Write(chunk)
-	}
-
-	return tar.ExtractTarGzip(context.TODO(), &data, s.fs, tar.ToPath(s.path))
-}
-
-func (s *State) gcOldSecrets(ctx context.Context, secrets []*corev1.Secret) {
-	log, ctx := logging.FromContextOrNew(ctx, nil)
-	for _, secret := range secrets {
-		if err := s.kubeClient.Delete(ctx, secret); err != nil {
-			log.Error(err, "Unable to delete old state secret %s in namespace %s", secret.Name, secret.Namespace)
+		content, err = decrypt(s.encryptionKey, content)
+		if err != nil {
+			return fmt.Errorf("unable to decrypt state version %q: %w", version, err)
 		}
-		log.Info("Successfully garbage collected", lc.KeyResource, secret.Name)
 	}
-}
-
-// splitFileAndUploadChunks splits the file given in the filepath into chunks of 1MB
-// and uploads the chunks as secrets to the configured k8s cluster as secrets
-func (s *State) splitFileAndUploadChunks(ctx context.Context, filePath string) ([]lsv1alpha1.ObjectReference, error) {
-	const bufSize = corev1.MaxSecretSize // 1 MB
-	file, err := s.fs.Open(filePath)
-	if err != nil {
-		return nil, err
+	if err := tar.ExtractTarGzip(ctx, bytes.NewReader(content), s.fs, tar.ToPath(s.path)); err != nil {
+		return fmt.Errorf("unable to extract state version %q: %w", version, err)
 	}
-	defer file.Close()
-
-	secrets := make([]lsv1alpha1.ObjectReference, 0)
-	uuidString := uuid.New().String()
-	buffer := make([]byte, bufSize)
-	count := 0
-	for {
-		if _, err := file.Read(buffer); err != nil {
-			if err == io.EOF {
-				return secrets, nil
-			}
-			return nil, err
-		}
-		// remove NULL characters
-		buffer = bytes.Trim(buffer, "\x00")
 
-		secret := &corev1.Secret{}
-		secret.GenerateName = fmt.Sprintf("state-%s-%s-", s.deployItem.Namespace, s.deployItem.Name)
-		secret.Namespace = s.namespace
-		secret.Labels = map[string]string{
-			container.ContainerDeployerDeployItemNameLabel:      s.deployItem.Name,
-			container.ContainerDeployerDeployItemNamespaceLabel: s.deployItem.Namespace,
-			container.ContainerDeployerTypeLabel:                "state", // todo: make const
-		}
-		secret.Annotations = map[string]string{
-			container.ContainerDeployerStateUUIDAnnotation: uuidString,
-			container.ContainerDeployerStateNumAnnotation:  strconv.Itoa(count),
+	// garbage collect the versions that exceed the history but never the restored version.
+	for i := 0; i < len(versions)-s.history; i++ {
+		if versions[i] == version {
+			continue
 		}
-		secret.Data = map[string][]byte{
-			lsv1alpha1.DataObjectSecretDataKey: buffer,
+		if err := s.backend.DeleteVersion(ctx, versions[i]); err != nil {
+			log.Error(err, "Unable to delete old state version", "version", versions[i])
+			continue
 		}
+		log.Info("Successfully garbage collected", "version", versions[i])
+	}
+	return nil
+}
 
-		if err := s.kubeClient.Create(ctx, secret); err != nil {
-			return secrets, err
+func containsVersion(versions []string, version string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
 		}
-		secrets = append(secrets, lsv1alpha1.ObjectReference{
-			Name:      secret.Name,
-			Namespace: secret.Namespace,
-		})
-		count++
 	}
+	return false
 }
 
-type stateSecretsList []*corev1.Secret
-
-func (s stateSecretsList) Len() int { return len(s) }
-
-func (s stateSecretsList) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
-
-func (s stateSecretsList) Less(i, j int) bool {
-	numI, _ := strconv.Atoi(s[i].Annotations[container.ContainerDeployerStateNumAnnotation])
-	numJ, _ := strconv.Atoi(s[j].Annotations[container.ContainerDeployerStateNumAnnotation])
-	return numI < numJ
+// Backend returns the backend that stores the versions of the state.
+func (s *State) Backend() Backend {
+	return s.backend
 }
 
-// CleanupState deletes all state secrets for a deployitem
-func CleanupState(ctx context.Context, log logging.Logger, kubeClient client.Client, namespace string, deployItem lsv1alpha1.ObjectReference) error {
-	secretList := &corev1.SecretList{}
-	if err := kubeClient.List(ctx, secretList, StateSecretListOptions(namespace, deployItem)...); err != nil {
-		return nil
-	}
-
+// CleanupState deletes all versions of the state of a deploy item from the given backend.
+// The deletion is retried until it succeeds.
+func CleanupState(ctx context.Context, log logging.Logger, backend Backend) error {
 	bo := wait.Backoff{
 		Duration: 10 * time.Second,
 		Factor:   1.2,
@@ -275,17 +223,11 @@ func CleanupState(ctx context.Context, log logging.Logger, kubeClient client.Cli
 		Steps:    math.MaxInt32,
 		Cap:      10 * time.Minute,
 	}
-	return wait.ExponentialBackoff(bo, func() (done bool, err error) {
-		completed := true
-		for _, secret := range secretList.Items {
-			if err := kubeClient.Delete(ctx, &secret); err != nil {
-				if apierrors.IsNotFound(err) {
-					continue
-				}
-				log.Error(err, "Unable to delete state secret")
-			}
-			completed = false
+	return wait.ExponentialBackoffWithContext(ctx, bo, func() (done bool, err error) {
+		if err := backend.Delete(ctx); err != nil {
+			log.Error(err, "Unable to delete state")
+			return false, nil
 		}
-		return completed, nil
+		return true, nil
 	})
 }
@@ -128,10 +128,10 @@ var _ = Describe("Container Deployer State", func() {
 
 		utils.ExpectNoError(s.Backup(ctx))
 
-		err := state.CleanupState(ctx, logging.Discard(), testenv.Client, testState.Namespace, lsv1alpha1.ObjectReference{
+		err := state.CleanupState(ctx, logging.Discard(), state.NewSecretBackend(testenv.Client, testState.Namespace, lsv1alpha1.ObjectReference{
 			Name:      "testname",
 			Namespace: "testns",
-		})
+		}))
 		utils.ExpectNoError(err)
 
 		secretList := &corev1.SecretList{}
@@ -140,3 +140,108 @@ var _ = Describe("Container Deployer State", func() {
 	})
 
 })
+
+var _ = Describe("Volume Backend", func() {
+
+	var (
+		ctx          context.Context
+		fs           vfs.FileSystem
+		backend      *state.VolumeBackend
+		testDir      = "/mystate"
+		testFilePath = path.Join(testDir, "my-file")
+		deployItem   = lsv1alpha1.ObjectReference{Name: "testname", Namespace: "testns"}
+	)
+
+	writeState := func(data string) {
+		utils.ExpectNoError(fs.MkdirAll(testDir, os.ModePerm))
+		utils.ExpectNoError(vfs.WriteFile(fs, testFilePath, []byte(data), os.ModePerm))
+	}
+
+	readState := func(s *state.State) string {
+		resFs := memoryfs.New()
+		utils.ExpectNoError(s.WithFs(resFs).Restore(ctx))
+		resData, err := vfs.ReadFile(resFs, testFilePath)
+		utils.ExpectNoError(err)
+		return string(resData)
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		fs = memoryfs.New()
+		backend = state.NewVolumeBackend(memoryfs.New(), "/data", deployItem)
+	})
+
+	It("should save a file and restore it", func() {
+		writeState("text")
+		s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFs(fs)
+		utils.ExpectNoError(s.Backup(ctx))
+
+		Expect(readState(s)).To(Equal("text"))
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(HaveLen(1))
+	})
+
+	It("should keep the configured number of versions and restore a specific version", func() {
+		s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithHistory(2)
+		for _, data := range []string{"v1", "v2", "v3"} {
+			writeState(data)
+			utils.ExpectNoError(s.WithFs(fs).Backup(ctx))
+		}
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(HaveLen(3))
+
+		Expect(readState(s)).To(Equal("v3"))
+		versions, err = backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(HaveLen(2))
+
+		s.WithRestoreVersion(versions[0])
+		Expect(readState(s)).To(Equal("v2"))
+
+		s.WithRestoreVersion("unknown")
+		Expect(s.WithFs(memoryfs.New()).Restore(ctx)).To(HaveOccurred())
+	})
+
+	It("should encrypt the state", func() {
+		writeState("secret-text")
+		s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFs(fs).WithEncryptionKey([]byte("my-key"))
+		utils.ExpectNoError(s.Backup(ctx))
+
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(HaveLen(1))
+		data, err := backend.Load(ctx, versions[0])
+		utils.ExpectNoError(err)
+		Expect(string(data)).ToNot(ContainSubstring("secret-text"))
+
+		Expect(readState(s)).To(Equal("secret-text"))
+
+		By("failing without or with a wrong key")
+		Expect(state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFs(memoryfs.New()).Restore(ctx)).To(HaveOccurred())
+		Expect(state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFs(memoryfs.New()).WithEncryptionKey([]byte("other")).Restore(ctx)).To(HaveOccurred())
+	})
+
+	It("should delete all versions", func() {
+		s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithHistory(2)
+		for _, data := range []string{"v1", "v2"} {
+			writeState(data)
+			utils.ExpectNoError(s.WithFs(fs).Backup(ctx))
+		}
+		utils.ExpectNoError(state.CleanupState(ctx, logging.Discard(), backend))
+
+		versions, err := backend.Versions(ctx)
+		utils.ExpectNoError(err)
+		Expect(versions).To(BeEmpty())
+	})
+
+	It("should restore unencrypted states if an encryption key is configured", func() {
+		writeState("text")
+		utils.ExpectNoError(state.New(nil, "", deployItem, testDir).WithBackend(backend).WithFs(fs).Backup(ctx))
+
+		s := state.New(nil, "", deployItem, testDir).WithBackend(backend).WithEncryptionKey([]byte("my-key"))
+		Expect(readState(s)).To(Equal("text"))
+	})
+
+})
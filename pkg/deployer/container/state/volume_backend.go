@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// stateFileExtension is the extension of the files of the volume backend.
+const stateFileExtension = ".tar.gz"
+
+// VolumeBackend stores the state as files in a filesystem, usually a mounted persistent volume.
+// The versions of a deploy item are stored as "<root>/<deploy item namespace>/<deploy item name>/<version>.tar.gz".
+type VolumeBackend struct {
+	fs  vfs.FileSystem
+	dir string
+}
+
+var _ Backend = &VolumeBackend{}
+
+// NewVolumeBackend creates a new backend that stores the state in the given root directory.
+func NewVolumeBackend(fs vfs.FileSystem, root string, deployItem lsv1alpha1.ObjectReference) *VolumeBackend {
+	return &VolumeBackend{
+		fs:  fs,
+		dir: filepath.Join(root, deployItem.Namespace, deployItem.Name),
+	}
+}
+
+// Store writes the data of the version to a new file.
+// The data is first written to a temporary file, so that incomplete versions are never listed.
+func (b *VolumeBackend) Store(_ context.Context, version string, data []byte) error {
+	if err := b.fs.MkdirAll(b.dir, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create state directory %q: %w", b.dir, err)
+	}
+	tmpPath := filepath.Join(b.dir, "."+version)
+	if err := vfs.WriteFile(b.fs, tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("unable to write state file: %w", err)
+	}
+	return b.fs.Rename(tmpPath, b.path(version))
+}
+
+// Load reads the file of a version.
+func (b *VolumeBackend) Load(_ context.Context, version string) ([]byte, error) {
+	return vfs.ReadFile(b.fs, b.path(version))
+}
+
+// Versions returns the versions of the files in the state directory ordered by their name.
+func (b *VolumeBackend) Versions(_ context.Context) ([]string, error) {
+	files, err := vfs.ReadDir(b.fs, b.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	versions := make([]string, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") || !strings.HasSuffix(file.Name(), stateFileExtension) {
+			continue
+		}
+		versions = append(versions, strings.TrimSuffix(file.Name(), stateFileExtension))
+	}
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// DeleteVersion removes the file of a version.
+func (b *VolumeBackend) DeleteVersion(_ context.Context, version string) error {
+	if err := b.fs.Remove(b.path(version)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Delete removes the state directory of the deploy item.
+func (b *VolumeBackend) Delete(_ context.Context) error {
+	return b.fs.RemoveAll(b.dir)
+}
+
+func (b *VolumeBackend) path(version string) string {
+	return filepath.Join(b.dir, version+stateFileExtension)
+}
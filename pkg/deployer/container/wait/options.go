@@ -22,6 +22,10 @@ type options struct {
 
 	ExportFilePath string
 	StatePath      string
+	// StateConfiguration is the json encoded configuration of the state backend.
+	StateConfiguration string
+	// Operation is the operation that is executed by the main container.
+	Operation container.OperationType
 
 	podName      string
 	podNamespace string
@@ -36,6 +40,8 @@ type options struct {
 func (o *options) Setup() {
 	o.ExportFilePath = os.Getenv(container.ExportsPathName)
 	o.StatePath = os.Getenv(container.StatePathName)
+	o.StateConfiguration = os.Getenv(container.StateConfigurationName)
+	o.Operation = container.OperationType(os.Getenv(container.OperationName))
 
 	o.podName = os.Getenv(container.PodName)
 	o.podNamespace = os.Getenv(container.PodNamespaceName)
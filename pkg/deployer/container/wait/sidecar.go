@@ -6,12 +6,15 @@ package wait
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
@@ -50,12 +53,29 @@ func Run(ctx context.Context) error {
 
 	// wait for the main container to finish.
 	// event if the exitcode != 0, the state is still backed up.
-	if err := WaitUntilMainContainerFinished(ctx, kubeClient, opts.PodKey.NamespacedName()); err != nil {
+	terminated, err := WaitUntilMainContainerFinished(ctx, kubeClient, opts.PodKey.NamespacedName())
+	if err != nil {
 		return withTerminationLog(log, err)
 	}
 
-	// backup state
-	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath).Backup(ctx); err != nil {
+	stateConfig, err := state.ParseConfiguration(opts.StateConfiguration)
+	if err != nil {
+		return withTerminationLog(log, err)
+	}
+	s, err := state.NewFromConfiguration(ctx, kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath, stateConfig)
+	if err != nil {
+		return withTerminationLog(log, err)
+	}
+	if opts.Operation == container.OperationDelete && terminated.ExitCode == 0 {
+		// the deploy item has been deleted successfully, therefore its state is removed.
+		// State secrets are deleted by the container deployer as the wait container is not allowed to delete secrets.
+		if stateConfig.Backend != "" && stateConfig.Backend != containerv1alpha1.StateBackendSecret {
+			if err := s.Backend().Delete(ctx); err != nil {
+				return withTerminationLog(log, fmt.Errorf("unable to delete state: %w", err))
+			}
+			log.Info("State has been deleted")
+		}
+	} else if err := s.Backup(ctx); err != nil {
 		return withTerminationLog(log, err)
 	}
 
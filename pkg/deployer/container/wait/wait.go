@@ -24,7 +24,8 @@ import (
 // For a comparison of different possibilities to wait for a container to finish
 // see the argo doc: https://github.com/argoproj/argo/blob/master/docs/workflow-executors.md
 // This method currently uses the k8s api method for simplicity and stability reasons.
-func WaitUntilMainContainerFinished(ctx context.Context, kubeClient client.Client, podKey client.ObjectKey) error {
+// The terminated state of the main container is returned.
+func WaitUntilMainContainerFinished(ctx context.Context, kubeClient client.Client, podKey client.ObjectKey) (*corev1.ContainerStateTerminated, error) {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
 	backoff := wait.Backoff{
 		Duration: 30 * time.Second,
@@ -32,8 +33,9 @@ func WaitUntilMainContainerFinished(ctx context.Context, kubeClient client.Clien
 		Steps:    math.MaxInt32,
 		Cap:      5 * time.Minute,
 	}
+	var terminated *corev1.ContainerStateTerminated
 	// no timeout is needed as we use the max active seconds of the pod to react on the timeout
-	return wait.ExponentialBackoff(backoff, func() (bool, error) {
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
 		pod := &corev1.Pod{}
 		if err := kubeClient.Get(ctx, podKey, pod); err != nil {
 			if apierrors.IsNotFound(err) {
@@ -56,6 +58,8 @@ func WaitUntilMainContainerFinished(ctx context.Context, kubeClient client.Clien
 			log.Debug("Main container is still running...")
 			return false, nil
 		}
+		terminated = mainContainerStatus.State.Terminated
 		return true, nil
 	})
+	return terminated, err
 }
@@ -14,6 +14,8 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
@@ -119,3 +121,23 @@ func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1al
 	next := schedule.Next(last)
 	return &next, nil
 }
+
+func (d *deployer) DriftDetection(_ context.Context, di *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error) {
+	helm, err := New(d.config, d.lsClient, d.hostClient, di, nil, nil, d.sharedCache)
+	if err != nil {
+		return nil, err
+	}
+	if helm.ProviderConfiguration.DryRun {
+		// nothing has been applied that could drift
+		return nil, nil
+	}
+	return helm.ProviderConfiguration.DriftDetection, nil
+}
+
+func (d *deployer) DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget, correct bool) ([]managedresource.ResourceDiff, error) {
+	helm, err := New(d.config, d.lsClient, d.hostClient, di, rt, lsCtx, d.sharedCache)
+	if err != nil {
+		return nil, err
+	}
+	return helm.DetectDrift(ctx, correct)
+}
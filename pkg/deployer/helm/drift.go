@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/realhelmdeployer"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+)
+
+// DetectDrift returns the managed resources that have been changed outside of the landscaper.
+// The chart is templated again and the resulting manifests are compared with the live objects.
+// If correct is true and drifted resources were found, the chart is upgraded or the manifests are applied again,
+// depending on whether helm is used as deployment mechanism.
+// The updated provider status is written into the deploy item but not persisted.
+func (h *Helm) DetectDrift(ctx context.Context, correct bool) ([]managedresource.ResourceDiff, error) {
+	currOp := "DetectDriftHelm"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if h.ProviderStatus == nil || len(h.ProviderStatus.ManagedResources) == 0 {
+		return nil, nil
+	}
+
+	files, crds, _, ch, lsErr := h.Template(ctx)
+	if lsErr != nil {
+		return nil, lserrors.NewWrappedError(lsErr, currOp, "Template", lsErr.Error())
+	}
+
+	_, targetClient, targetClientSet, err := h.TargetClient(ctx)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "TargetClusterClient", err.Error())
+	}
+
+	manifests, err := h.createManifests(ctx, currOp, files, crds)
+	if err != nil {
+		return nil, err
+	}
+
+	drifted, err := resourcemanager.DetectDrift(ctx, h.applierOptions(targetClient, targetClientSet, manifests))
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "DetectDrift", err.Error())
+	}
+	if !correct || len(drifted) == 0 {
+		return drifted, nil
+	}
+
+	logger.Info("Correcting drifted resources", "count", len(drifted))
+	var deployErr error
+	if pointer.BoolDeref(h.ProviderConfiguration.HelmDeployment, true) {
+		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
+			h.TargetRestConfig, targetClientSet)
+		deployErr = realHelmDeployer.Deploy(ctx)
+		if deployErr == nil {
+			managedResources, err := realHelmDeployer.GetManagedResourcesStatus(ctx, manifests)
+			if err != nil {
+				return drifted, err
+			}
+			h.ProviderStatus.ManagedResources = managedResources
+		}
+		h.updateReleaseStatus(ctx, realHelmDeployer)
+	} else {
+		_, deployErr = h.applyManifests(ctx, targetClient, targetClientSet, manifests)
+	}
+
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if deployErr != nil {
+		return drifted, lserrors.NewWrappedError(deployErr, currOp, "CorrectDrift", deployErr.Error())
+	}
+	if err != nil {
+		return drifted, lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	return drifted, nil
+}
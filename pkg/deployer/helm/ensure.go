@@ -72,18 +72,30 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 
 	shouldUseRealHelmDeployer := pointer.BoolDeref(h.ProviderConfiguration.HelmDeployment, true)
 
+	if h.ProviderConfiguration.DryRun {
+		return h.dryRunManifests(ctx, targetClient, targetClientSet, manifests)
+	}
+	h.ProviderStatus.DryRunResult = nil
+
+	var realHelmDeployer *realhelmdeployer.RealHelmDeployer
 	if shouldUseRealHelmDeployer {
-		// apply helm
-		// convert manifests in ManagedResourceStatusList
-		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
+		realHelmDeployer = realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
 			h.TargetRestConfig, targetClientSet)
-		deployErr = realHelmDeployer.Deploy(ctx)
-		if deployErr == nil {
-			managedResourceStatusList, err = realHelmDeployer.GetManagedResourcesStatus(ctx, manifests)
-			if err != nil {
-				return err
+		if value, ok := h.DeployItem.Annotations[helmv1alpha1.RollbackAnnotation]; ok {
+			// a requested rollback replaces the deployment of the chart
+			deployErr = h.rollbackOnRequest(ctx, realHelmDeployer, value)
+		} else {
+			// apply helm
+			// convert manifests in ManagedResourceStatusList
+			deployErr = realHelmDeployer.Deploy(ctx)
+			if deployErr == nil {
+				managedResourceStatusList, err = realHelmDeployer.GetManagedResourcesStatus(ctx, manifests)
+				if err != nil {
+					return err
+				}
+				h.ProviderStatus.ManagedResources = managedResourceStatusList
 			}
-			h.ProviderStatus.ManagedResources = managedResourceStatusList
+			h.updateReleaseStatus(ctx, realHelmDeployer)
 		}
 	} else {
 		var applier *resourcemanager.ManifestApplier
@@ -110,7 +122,15 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 		return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
 	}
 
-	if err := h.checkResourcesReady(ctx, targetClient, !shouldUseRealHelmDeployer); err != nil {
+	if err := h.checkReadiness(ctx, targetClient, realHelmDeployer); err != nil {
+		if shouldUseRealHelmDeployer {
+			err = h.rollbackOnReadinessFailure(ctx, realHelmDeployer, err)
+			var encodeErr error
+			h.DeployItem.Status.ProviderStatus, encodeErr = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+			if encodeErr != nil {
+				logger.Error(encodeErr, "unable to encode status")
+			}
+		}
 		return err
 	}
 
@@ -118,14 +138,67 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 		return err
 	}
 
+	if shouldUseRealHelmDeployer {
+		h.markReleaseSuccessful()
+		h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+		}
+	}
+
 	h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
 
 	return nil
 }
 
+// dryRunManifests applies the templated manifests with a server-side dry-run and writes the computed changes
+// into the provider status. This is done independently of whether helm is used as deployment mechanism,
+// as the chart is neither installed nor upgraded. The deploy item ends in phase DryRunSucceeded,
+// so that deploy items depending on it are not triggered.
+func (h *Helm) dryRunManifests(ctx context.Context, targetClient client.Client, targetClientSet kubernetes.Interface,
+	manifests []managedresource.Manifest) error {
+
+	currOp := "DryRunFiles"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	opts := h.applierOptions(targetClient, targetClientSet, manifests)
+	opts.DryRun = true
+	applier := resourcemanager.NewManifestApplier(opts)
+	deployErr := applier.Apply(ctx)
+	h.ProviderStatus.DryRunResult = applier.GetDryRunResult()
+
+	var err error
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		if deployErr != nil {
+			logger.Error(err, "unable to encode status")
+			return deployErr
+		}
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	if deployErr != nil {
+		return deployErr
+	}
+
+	logger.Info("Dry-run finished", "changes", len(h.ProviderStatus.DryRunResult.Resources))
+	h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.DryRunSucceeded
+	return nil
+}
+
 func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, targetClientSet kubernetes.Interface,
 	manifests []managedresource.Manifest) (*resourcemanager.ManifestApplier, error) {
-	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
+	applier := resourcemanager.NewManifestApplier(h.applierOptions(targetClient, targetClientSet, manifests))
+
+	err := applier.Apply(ctx)
+	h.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
+
+	return applier, err
+}
+
+// applierOptions returns the options to apply the given manifests with the manifest applier.
+func (h *Helm) applierOptions(targetClient client.Client, targetClientSet kubernetes.Interface,
+	manifests []managedresource.Manifest) resourcemanager.ManifestApplierOptions {
+	return resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
 		KubeClient:       targetClient,
 		Clientset:        targetClientSet,
@@ -138,12 +211,7 @@ func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, t
 		Labels: map[string]string{
 			helmv1alpha1.ManagedDeployItemLabel: h.DeployItem.Name,
 		},
-	})
-
-	err := applier.Apply(ctx)
-	h.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
-
-	return applier, err
+	}
 }
 
 func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds map[string]string) ([]managedresource.Manifest, error) {
@@ -201,6 +269,19 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 	return manifests, nil
 }
 
+// checkReadiness checks if the managed resources are ready and, if configured, runs the test hooks of the chart.
+// The real helm deployer is nil if helm is not used as deployment mechanism.
+func (h *Helm) checkReadiness(ctx context.Context, client client.Client, realHelmDeployer *realhelmdeployer.RealHelmDeployer) error {
+	if err := h.checkResourcesReady(ctx, client, realHelmDeployer == nil); err != nil {
+		return err
+	}
+
+	if realHelmDeployer != nil && h.ProviderConfiguration.Tests != nil && h.ProviderConfiguration.Tests.Enabled {
+		return realHelmDeployer.Test(ctx)
+	}
+	return nil
+}
+
 // checkResourcesReady checks if the managed resources are Ready/Healthy.
 func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, failOnMissingObject bool) error {
 
@@ -46,6 +46,9 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
 )
 
+// maxHistory is the maximum number of revisions that are kept per release.
+const maxHistory = 10
+
 type RealHelmDeployer struct {
 	chart              *chart.Chart
 	decoder            runtime.Decoder
@@ -53,10 +56,15 @@ type RealHelmDeployer struct {
 	defaultNamespace   string
 	rawValues          json.RawMessage
 	helmConfig         *helmv1alpha1.HelmDeploymentConfiguration
+	rollbackPolicy     *helmv1alpha1.RollbackPolicy
+	testConfig         *helmv1alpha1.TestConfiguration
 	createNamespace    bool
 	targetRestConfig   *rest.Config
 	apiResourceHandler *resourcemanager.ApiResourceHandler
 	helmSecretManager  *HelmSecretManager
+	// actionConfig is used instead of the action configuration for the target cluster if set.
+	// It is only meant for testing.
+	actionConfig *action.Configuration
 }
 
 func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderConfiguration, targetRestConfig *rest.Config,
@@ -69,6 +77,8 @@ func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderC
 		defaultNamespace:   providerConfig.Namespace,
 		rawValues:          providerConfig.Values,
 		helmConfig:         providerConfig.HelmDeploymentConfig,
+		rollbackPolicy:     providerConfig.RollbackPolicy,
+		testConfig:         providerConfig.Tests,
 		createNamespace:    providerConfig.CreateNamespace,
 		targetRestConfig:   targetRestConfig,
 		apiResourceHandler: resourcemanager.CreateApiResourceHandler(clientset),
@@ -183,7 +193,7 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 
 	upgrade := action.NewUpgrade(actionConfig)
 	upgrade.Namespace = c.defaultNamespace
-	upgrade.MaxHistory = 10
+	upgrade.MaxHistory = maxHistory
 	upgrade.Atomic = upgradeConfig.Atomic
 	upgrade.Timeout = upgradeConfig.Timeout.Duration
 
@@ -241,6 +251,10 @@ func (c *RealHelmDeployer) deleteRelease(ctx context.Context) error {
 }
 
 func (c *RealHelmDeployer) initActionConfig(ctx context.Context) (*action.Configuration, error) {
+	if c.actionConfig != nil {
+		return c.actionConfig, nil
+	}
+
 	logf := c.createLogFunc(ctx)
 
 	currOp := "InitHelmAction"
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"context"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserror "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// Rollback rolls the release back to the given revision.
+// Helm creates a new revision for the rollback, which is a copy of the given revision.
+func (c *RealHelmDeployer) Rollback(ctx context.Context, revision int) error {
+	currOp := "RollbackHelmRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	logger.Info(fmt.Sprintf("rolling back release %s in namespace %s to revision %d", c.releaseName, c.defaultNamespace, revision))
+
+	// Validate that the release actually belongs to the namespace
+	if _, err := c.getRelease(ctx); err != nil {
+		return err
+	}
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	rollback := action.NewRollback(actionConfig)
+	rollback.Version = revision
+	rollback.MaxHistory = maxHistory
+	rollback.Timeout = defaultTimeout
+	if c.rollbackPolicy != nil && c.rollbackPolicy.Timeout != nil {
+		rollback.Timeout = c.rollbackPolicy.Timeout.Duration
+	}
+
+	if err := rollback.Run(c.releaseName); err != nil {
+		c.unblockPendingHelmRelease(ctx, logger)
+
+		message := fmt.Sprintf("unable to roll back helm chart release to revision %d: %s", revision, err.Error())
+		logger.Info(message)
+		return lserror.NewWrappedError(err, currOp, "Rollback", message)
+	}
+
+	logger.Info(fmt.Sprintf("%s successfully rolled back to revision %d in %s", c.releaseName, revision, c.defaultNamespace))
+
+	return nil
+}
+
+// GetReleaseStatus returns the live revision and the revision history of the release.
+// The live revision is the revision helm considers as deployed, or the latest revision if there is no such revision.
+func (c *RealHelmDeployer) GetReleaseStatus(ctx context.Context, lastSuccessfulRevision int) (*helmv1alpha1.ReleaseStatus, error) {
+	currOp := "GetHelmReleaseHistory"
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := action.NewHistory(actionConfig).Run(c.releaseName)
+	if err != nil {
+		return nil, lserror.NewWrappedError(err, currOp, "History", err.Error())
+	}
+	return newReleaseStatus(releases, lastSuccessfulRevision), nil
+}
+
+// newReleaseStatus creates the release status from the revisions of a release.
+// Only the latest maxHistory revisions are contained in the history of the status.
+func newReleaseStatus(releases []*release.Release, lastSuccessfulRevision int) *helmv1alpha1.ReleaseStatus {
+	releaseutil.Reverse(releases, releaseutil.SortByRevision)
+	if len(releases) > maxHistory {
+		releases = releases[:maxHistory]
+	}
+
+	status := &helmv1alpha1.ReleaseStatus{
+		LastSuccessfulRevision: lastSuccessfulRevision,
+		History:                make([]helmv1alpha1.ReleaseRevision, 0, len(releases)),
+	}
+	for _, rls := range releases {
+		if status.Revision == 0 && rls.Info != nil && rls.Info.Status == release.StatusDeployed {
+			status.Revision = rls.Version
+		}
+		status.History = append(status.History, releaseRevision(rls))
+	}
+	if status.Revision == 0 && len(releases) != 0 {
+		status.Revision = releases[0].Version
+	}
+	return status
+}
+
+func releaseRevision(rls *release.Release) helmv1alpha1.ReleaseRevision {
+	rev := helmv1alpha1.ReleaseRevision{
+		Revision: rls.Version,
+	}
+	if rls.Info != nil {
+		rev.Status = rls.Info.Status.String()
+		rev.Description = rls.Info.Description
+		if !rls.Info.LastDeployed.IsZero() {
+			updated := metav1.NewTime(rls.Info.LastDeployed.Time)
+			rev.Updated = &updated
+		}
+	}
+	if rls.Chart != nil && rls.Chart.Metadata != nil {
+		rev.Chart = fmt.Sprintf("%s-%s", rls.Chart.Metadata.Name, rls.Chart.Metadata.Version)
+		rev.AppVersion = rls.Chart.Metadata.AppVersion
+	}
+	return rev
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+var _ = Describe("Release Status", func() {
+
+	newRelease := func(version int, status release.Status) *release.Release {
+		return &release.Release{
+			Name:    "my-release",
+			Version: version,
+			Info: &release.Info{
+				Status:       status,
+				Description:  fmt.Sprintf("revision %d", version),
+				LastDeployed: helmtime.Now(),
+			},
+			Chart: &chart.Chart{
+				Metadata: &chart.Metadata{Name: "my-chart", Version: "1.0.0", AppVersion: "2.0.0"},
+			},
+		}
+	}
+
+	It("should use the deployed revision as live revision and keep the last successful revision", func() {
+		status := newReleaseStatus([]*release.Release{
+			newRelease(1, release.StatusSuperseded),
+			newRelease(3, release.StatusFailed),
+			newRelease(2, release.StatusDeployed),
+		}, 1)
+
+		Expect(status.Revision).To(Equal(2))
+		Expect(status.LastSuccessfulRevision).To(Equal(1))
+		Expect(status.History).To(HaveLen(3))
+		Expect(status.History[0].Revision).To(Equal(3))
+		Expect(status.History[0].Status).To(Equal(release.StatusFailed.String()))
+		Expect(status.History[0].Chart).To(Equal("my-chart-1.0.0"))
+		Expect(status.History[0].AppVersion).To(Equal("2.0.0"))
+		Expect(status.History[0].Description).To(Equal("revision 3"))
+		Expect(status.History[0].Updated).ToNot(BeNil())
+		Expect(status.History[1].Revision).To(Equal(2))
+		Expect(status.History[2].Revision).To(Equal(1))
+	})
+
+	It("should use the latest revision as live revision if no revision is deployed", func() {
+		status := newReleaseStatus([]*release.Release{
+			newRelease(1, release.StatusFailed),
+			newRelease(2, release.StatusPendingUpgrade),
+		}, 0)
+
+		Expect(status.Revision).To(Equal(2))
+		Expect(status.LastSuccessfulRevision).To(Equal(0))
+	})
+
+	It("should only keep the latest revisions in the history", func() {
+		releases := make([]*release.Release, 0)
+		for i := 1; i <= maxHistory+5; i++ {
+			releases = append(releases, newRelease(i, release.StatusSuperseded))
+		}
+		releases[len(releases)-1].Info.Status = release.StatusDeployed
+
+		status := newReleaseStatus(releases, maxHistory+5)
+
+		Expect(status.Revision).To(Equal(maxHistory + 5))
+		Expect(status.History).To(HaveLen(maxHistory))
+		Expect(status.History[0].Revision).To(Equal(maxHistory + 5))
+		Expect(status.History[maxHistory-1].Revision).To(Equal(6))
+	})
+
+	It("should return an empty status for a release without revisions", func() {
+		Expect(newReleaseStatus(nil, 0)).To(Equal(&helmv1alpha1.ReleaseStatus{
+			History: []helmv1alpha1.ReleaseRevision{},
+		}))
+	})
+})
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	lserror "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// maxTestLogLines is the number of log lines of a failed test pod that are added to the error.
+const maxTestLogLines = 20
+
+// Test executes the test hooks of the release, similar to "helm test".
+// If a test fails, the returned error contains the last log lines of the failed test pods.
+func (c *RealHelmDeployer) Test(ctx context.Context) error {
+	currOp := "TestHelmRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	logger.Info(fmt.Sprintf("testing release %s in namespace %s", c.releaseName, c.defaultNamespace))
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	test := action.NewReleaseTesting(actionConfig)
+	test.Namespace = c.defaultNamespace
+	test.Timeout = defaultTimeout
+	if c.testConfig != nil {
+		if c.testConfig.Timeout != nil {
+			test.Timeout = c.testConfig.Timeout.Duration
+		}
+		if len(c.testConfig.Filter) != 0 {
+			test.Filters["name"] = c.testConfig.Filter
+		}
+	}
+
+	rel, err := test.Run(c.releaseName)
+	if err != nil {
+		message := fmt.Sprintf("test of helm chart release failed: %s", err.Error())
+		if rel != nil {
+			clientset, clientErr := actionConfig.KubernetesClientSet()
+			if clientErr != nil {
+				logger.Error(clientErr, "unable to get clientset to fetch the logs of failed test pods")
+			} else if logs := c.failedTestLogs(ctx, clientset, rel); len(logs) != 0 {
+				message = fmt.Sprintf("%s\n%s", message, logs)
+			}
+		}
+		logger.Info(message)
+		return lserror.NewWrappedError(err, currOp, "Test", message)
+	}
+
+	logger.Info(fmt.Sprintf("%s successfully tested in %s", c.releaseName, c.defaultNamespace))
+
+	return nil
+}
+
+// failedTestLogs returns the last log lines of all failed test pods of the given release.
+func (c *RealHelmDeployer) failedTestLogs(ctx context.Context, clientset kubernetes.Interface, rel *release.Release) string {
+	sb := strings.Builder{}
+	for _, hook := range rel.Hooks {
+		if !isTestHook(hook) || hook.Kind != "Pod" || hook.LastRun.Phase != release.HookPhaseFailed {
+			continue
+		}
+
+		namespace := hookNamespace(hook, c.defaultNamespace)
+		fmt.Fprintf(&sb, "logs of test pod %s/%s:\n", namespace, hook.Name)
+		req := clientset.CoreV1().Pods(namespace).GetLogs(hook.Name, &corev1.PodLogOptions{
+			TailLines: pointer.Int64(maxTestLogLines),
+		})
+		logs, err := req.Stream(ctx)
+		if err != nil {
+			fmt.Fprintf(&sb, "unable to get logs: %s\n", err.Error())
+			continue
+		}
+		_, err = io.Copy(&sb, logs)
+		_ = logs.Close()
+		if err != nil {
+			fmt.Fprintf(&sb, "unable to read logs: %s\n", err.Error())
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// hookNamespace returns the namespace of the resource of a hook.
+// Resources without a namespace in their manifest are created in the namespace of the release.
+func hookNamespace(hook *release.Hook, releaseNamespace string) string {
+	obj := metav1.PartialObjectMetadata{}
+	if err := yaml.Unmarshal([]byte(hook.Manifest), &obj); err == nil && len(obj.Namespace) != 0 {
+		return obj.Namespace
+	}
+	return releaseNamespace
+}
+
+func isTestHook(hook *release.Hook) bool {
+	for _, event := range hook.Events {
+		if event == release.HookTest {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/kube"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// timeoutRecordingKubeClient records the timeout that is used to wait for the test hooks.
+type timeoutRecordingKubeClient struct {
+	*kubefake.FailingKubeClient
+	timeout time.Duration
+}
+
+func (c *timeoutRecordingKubeClient) WatchUntilReady(resources kube.ResourceList, timeout time.Duration) error {
+	c.timeout = timeout
+	return c.FailingKubeClient.WatchUntilReady(resources, timeout)
+}
+
+var _ = Describe("Test", func() {
+
+	var (
+		ctx        context.Context
+		server     *httptest.Server
+		kubeClient *timeoutRecordingKubeClient
+		store      *storage.Storage
+		deployer   *RealHelmDeployer
+	)
+
+	newTestHook := func(name, manifest string) *release.Hook {
+		return &release.Hook{
+			Name:     name,
+			Kind:     "Pod",
+			Path:     "templates/tests/" + name + ".yaml",
+			Manifest: manifest,
+			Events:   []release.HookEvent{release.HookTest},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/namespaces/tests/pods/my-test/log":
+				_, _ = w.Write([]byte("connection refused"))
+			case "/api/v1/namespaces/default/pods/other-test/log":
+				_, _ = w.Write([]byte("assertion failed"))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		kubeClient = &timeoutRecordingKubeClient{
+			FailingKubeClient: &kubefake.FailingKubeClient{PrintingKubeClient: kubefake.PrintingKubeClient{Out: io.Discard}},
+		}
+		store = storage.Init(driver.NewMemory())
+		Expect(store.Create(&release.Release{
+			Name:      "my-release",
+			Namespace: "default",
+			Version:   1,
+			Info:      &release.Info{Status: release.StatusDeployed},
+			Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "my-chart", Version: "1.0.0"}},
+			Hooks: []*release.Hook{
+				newTestHook("my-test", "apiVersion: v1\nkind: Pod\nmetadata:\n  name: my-test\n  namespace: tests\n"),
+			},
+		})).To(Succeed())
+
+		deployer = &RealHelmDeployer{
+			releaseName:      "my-release",
+			defaultNamespace: "default",
+			actionConfig: &action.Configuration{
+				RESTClientGetter: newRemoteRESTClientGetter(&rest.Config{Host: server.URL}, "default"),
+				Releases:         store,
+				KubeClient:       kubeClient,
+				Log:              func(_ string, _ ...interface{}) {},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should succeed if all tests pass", func() {
+		Expect(deployer.Test(ctx)).To(Succeed())
+		Expect(kubeClient.timeout).To(Equal(defaultTimeout))
+
+		rel, err := store.Get("my-release", 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rel.Hooks[0].LastRun.Phase).To(Equal(release.HookPhaseSucceeded))
+	})
+
+	It("should return the logs of the failed test pod from the namespace of the hook", func() {
+		kubeClient.WatchUntilReadyError = io.ErrUnexpectedEOF
+
+		err := deployer.Test(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("test of helm chart release failed"))
+		Expect(err.Error()).To(ContainSubstring("logs of test pod tests/my-test:\nconnection refused"))
+	})
+
+	It("should fail if the tests do not finish within the configured timeout", func() {
+		deployer.testConfig = &helmv1alpha1.TestConfiguration{
+			Enabled: true,
+			Timeout: &lsv1alpha1.Duration{Duration: 2 * time.Minute},
+		}
+		kubeClient.WatchUntilReadyError = wait.ErrWaitTimeout
+
+		err := deployer.Test(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(wait.ErrWaitTimeout.Error()))
+		Expect(kubeClient.timeout).To(Equal(2 * time.Minute))
+
+		rel, err := store.Get("my-release", 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rel.Hooks[0].LastRun.Phase).To(Equal(release.HookPhaseFailed))
+	})
+
+	It("should read the logs of failed test pods without a namespace from the release namespace", func() {
+		failed := newTestHook("other-test", "apiVersion: v1\nkind: Pod\nmetadata:\n  name: other-test\n")
+		failed.LastRun.Phase = release.HookPhaseFailed
+		succeeded := newTestHook("my-test", "apiVersion: v1\nkind: Pod\nmetadata:\n  name: my-test\n  namespace: tests\n")
+		succeeded.LastRun.Phase = release.HookPhaseSucceeded
+
+		clientset, err := deployer.actionConfig.KubernetesClientSet()
+		Expect(err).ToNot(HaveOccurred())
+		logs := deployer.failedTestLogs(ctx, clientset, &release.Release{Hooks: []*release.Hook{failed, succeeded}})
+		Expect(logs).To(Equal("logs of test pod default/other-test:\nassertion failed"))
+	})
+})
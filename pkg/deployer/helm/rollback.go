@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// releaseRollbacker rolls back a helm release and reads the revision history of the release.
+// It is implemented by the real helm deployer.
+type releaseRollbacker interface {
+	Rollback(ctx context.Context, revision int) error
+	GetReleaseStatus(ctx context.Context, lastSuccessfulRevision int) (*helmv1alpha1.ReleaseStatus, error)
+}
+
+// updateReleaseStatus writes the live revision and the revision history of the release into the provider status.
+// Errors are only logged, as the release status is informational.
+func (h *Helm) updateReleaseStatus(ctx context.Context, realHelmDeployer releaseRollbacker) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "updateReleaseStatus"})
+
+	lastSuccessfulRevision := 0
+	if h.ProviderStatus.Release != nil {
+		lastSuccessfulRevision = h.ProviderStatus.Release.LastSuccessfulRevision
+	}
+	releaseStatus, err := realHelmDeployer.GetReleaseStatus(ctx, lastSuccessfulRevision)
+	if err != nil {
+		logger.Error(err, "unable to get release history")
+		return
+	}
+	h.ProviderStatus.Release = releaseStatus
+}
+
+// markReleaseSuccessful remembers the live revision of the release as last successful revision.
+func (h *Helm) markReleaseSuccessful() {
+	if h.ProviderStatus.Release != nil {
+		h.ProviderStatus.Release.LastSuccessfulRevision = h.ProviderStatus.Release.Revision
+	}
+}
+
+// rollbackOnReadinessFailure rolls the release back to its last successful revision if the readiness checks failed
+// and the rollback policy requests it. The returned error always contains the failed readiness check.
+func (h *Helm) rollbackOnReadinessFailure(ctx context.Context, realHelmDeployer releaseRollbacker,
+	readinessErr error) error {
+
+	currOp := "RollbackOnReadinessFailure"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	policy := h.ProviderConfiguration.RollbackPolicy
+	if policy == nil || !policy.OnReadinessFailure || h.ProviderStatus.Release == nil {
+		return readinessErr
+	}
+	revision := h.ProviderStatus.Release.LastSuccessfulRevision
+	if revision == 0 || revision == h.ProviderStatus.Release.Revision {
+		logger.Info("Readiness check failed, but there is no other successful revision to roll back to")
+		return readinessErr
+	}
+
+	codes := lserrors.CollectErrorCodes(readinessErr)
+	if err := h.rollback(ctx, realHelmDeployer, revision); err != nil {
+		message := fmt.Sprintf("readiness check failed: %s; unable to roll back to revision %d: %s",
+			readinessErr.Error(), revision, err.Error())
+		return lserrors.NewWrappedError(readinessErr, currOp, "Rollback", message, codes...)
+	}
+
+	message := fmt.Sprintf("readiness check failed, release rolled back to revision %d: %s", revision, readinessErr.Error())
+	return lserrors.NewWrappedError(readinessErr, currOp, "RolledBack", message, codes...)
+}
+
+// rollbackOnRequest rolls the release back to the revision that is requested by the rollback annotation of the
+// deploy item. The annotation is removed after a successful rollback.
+// As the live revision differs from the desired state afterwards, an error is returned in any case.
+func (h *Helm) rollbackOnRequest(ctx context.Context, realHelmDeployer releaseRollbacker, value string) error {
+	currOp := "RollbackOnRequest"
+
+	revision := 0
+	if value == helmv1alpha1.RollbackToLastSuccessfulRevision {
+		if h.ProviderStatus.Release != nil {
+			revision = h.ProviderStatus.Release.LastSuccessfulRevision
+		}
+		if revision == 0 {
+			err := fmt.Errorf("unable to roll back release %q as there is no successful revision", h.ProviderConfiguration.Name)
+			return lserrors.NewWrappedError(err, currOp, "LastSuccessfulRevision", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	} else {
+		var err error
+		revision, err = strconv.Atoi(value)
+		if err != nil || revision <= 0 {
+			err := fmt.Errorf("invalid value %q of annotation %s: must be a revision or %q",
+				value, helmv1alpha1.RollbackAnnotation, helmv1alpha1.RollbackToLastSuccessfulRevision)
+			return lserrors.NewWrappedError(err, currOp, "ParseRevision", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
+	if err := h.rollback(ctx, realHelmDeployer, revision); err != nil {
+		return err
+	}
+
+	// the update overwrites the status with the persisted one, which must not discard the changes of this reconcile
+	status := h.DeployItem.Status
+	delete(h.DeployItem.Annotations, helmv1alpha1.RollbackAnnotation)
+	if err := h.Writer().UpdateDeployItem(ctx, read_write_layer.W000153, h.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "RemoveRollbackAnnotation", err.Error())
+	}
+	h.DeployItem.Status = status
+
+	err := fmt.Errorf("release %q rolled back to revision %d on request", h.ProviderConfiguration.Name, revision)
+	return lserrors.NewWrappedError(err, currOp, "RolledBack", err.Error())
+}
+
+// rollback rolls the release back to the given revision and updates the release status.
+func (h *Helm) rollback(ctx context.Context, realHelmDeployer releaseRollbacker, revision int) error {
+	if err := realHelmDeployer.Rollback(ctx, revision); err != nil {
+		return err
+	}
+	h.updateReleaseStatus(ctx, realHelmDeployer)
+	return nil
+}
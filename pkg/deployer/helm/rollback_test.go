@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// fakeReleaseRollbacker simulates a helm release that creates a new revision on each rollback.
+type fakeReleaseRollbacker struct {
+	revision    int
+	rollbacks   []int
+	rollbackErr error
+}
+
+func (f *fakeReleaseRollbacker) Rollback(_ context.Context, revision int) error {
+	if f.rollbackErr != nil {
+		return f.rollbackErr
+	}
+	f.rollbacks = append(f.rollbacks, revision)
+	f.revision++
+	return nil
+}
+
+func (f *fakeReleaseRollbacker) GetReleaseStatus(_ context.Context, lastSuccessfulRevision int) (*helmv1alpha1.ReleaseStatus, error) {
+	return &helmv1alpha1.ReleaseStatus{
+		Revision:               f.revision,
+		LastSuccessfulRevision: lastSuccessfulRevision,
+	}, nil
+}
+
+var _ = Describe("Rollback", func() {
+
+	var (
+		ctx        context.Context
+		rollbacker *fakeReleaseRollbacker
+	)
+
+	newDeployItem := func(config string) *lsv1alpha1.DeployItem {
+		item := &lsv1alpha1.DeployItem{}
+		item.Name = "my-di"
+		item.Namespace = "default"
+		item.Spec.Type = Type
+		item.Spec.Configuration = &runtime.RawExtension{Raw: []byte(config)}
+		return item
+	}
+
+	newHelm := func(lsClient client.Client, policy *helmv1alpha1.RollbackPolicy, release *helmv1alpha1.ReleaseStatus) *Helm {
+		return &Helm{
+			lsKubeClient: lsClient,
+			DeployItem:   newDeployItem("{}"),
+			ProviderConfiguration: &helmv1alpha1.ProviderConfiguration{
+				Name:           "my-release",
+				RollbackPolicy: policy,
+			},
+			ProviderStatus: &helmv1alpha1.ProviderStatus{
+				Release: release,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+		rollbacker = &fakeReleaseRollbacker{revision: 3}
+	})
+
+	Context("Policy", func() {
+		It("should parse the rollback policy of the provider configuration", func() {
+			h, err := New(helmv1alpha1.Configuration{}, nil, nil, newDeployItem(`{
+				"apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+				"kind": "ProviderConfiguration",
+				"name": "my-release",
+				"namespace": "default",
+				"chart": {"ref": "example.com/my-chart:1.0.0"},
+				"helmDeployment": true,
+				"rollbackPolicy": {"onReadinessFailure": true, "timeout": "2m"}
+			}`), nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(h.ProviderConfiguration.RollbackPolicy).ToNot(BeNil())
+			Expect(h.ProviderConfiguration.RollbackPolicy.OnReadinessFailure).To(BeTrue())
+			Expect(h.ProviderConfiguration.RollbackPolicy.Timeout).ToNot(BeNil())
+			Expect(h.ProviderConfiguration.RollbackPolicy.Timeout.Duration).To(Equal(2 * time.Minute))
+		})
+
+		It("should reject a rollback policy if helm is not used as deployment mechanism", func() {
+			_, err := New(helmv1alpha1.Configuration{}, nil, nil, newDeployItem(`{
+				"apiVersion": "helm.deployer.landscaper.gardener.cloud/v1alpha1",
+				"kind": "ProviderConfiguration",
+				"name": "my-release",
+				"namespace": "default",
+				"chart": {"ref": "example.com/my-chart:1.0.0"},
+				"helmDeployment": false,
+				"rollbackPolicy": {"onReadinessFailure": true}
+			}`), nil, nil, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("rollbackPolicy"))
+		})
+	})
+
+	Context("Release Status", func() {
+		It("should keep the last successful revision when the release status is updated", func() {
+			h := newHelm(nil, nil, &helmv1alpha1.ReleaseStatus{Revision: 2, LastSuccessfulRevision: 2})
+
+			h.updateReleaseStatus(ctx, rollbacker)
+			Expect(h.ProviderStatus.Release.Revision).To(Equal(3))
+			Expect(h.ProviderStatus.Release.LastSuccessfulRevision).To(Equal(2))
+
+			h.markReleaseSuccessful()
+			Expect(h.ProviderStatus.Release.LastSuccessfulRevision).To(Equal(3))
+		})
+
+		It("should not mark a revision as successful if the release status is unknown", func() {
+			h := newHelm(nil, nil, nil)
+			h.markReleaseSuccessful()
+			Expect(h.ProviderStatus.Release).To(BeNil())
+		})
+	})
+
+	Context("Readiness Failure", func() {
+		var readinessErr error
+
+		BeforeEach(func() {
+			readinessErr = lserrors.NewError("CheckResourcesHealth", "CheckResourcesReady", "pod not ready",
+				lsv1alpha1.ErrorReadinessCheckTimeout)
+		})
+
+		It("should not roll back without a rollback policy", func() {
+			h := newHelm(nil, nil, &helmv1alpha1.ReleaseStatus{Revision: 3, LastSuccessfulRevision: 2})
+
+			err := h.rollbackOnReadinessFailure(ctx, rollbacker, readinessErr)
+			Expect(err).To(Equal(readinessErr))
+			Expect(rollbacker.rollbacks).To(BeEmpty())
+		})
+
+		It("should not roll back if there is no other successful revision", func() {
+			policy := &helmv1alpha1.RollbackPolicy{OnReadinessFailure: true}
+
+			h := newHelm(nil, policy, &helmv1alpha1.ReleaseStatus{Revision: 3})
+			Expect(h.rollbackOnReadinessFailure(ctx, rollbacker, readinessErr)).To(Equal(readinessErr))
+
+			h = newHelm(nil, policy, &helmv1alpha1.ReleaseStatus{Revision: 3, LastSuccessfulRevision: 3})
+			Expect(h.rollbackOnReadinessFailure(ctx, rollbacker, readinessErr)).To(Equal(readinessErr))
+			Expect(rollbacker.rollbacks).To(BeEmpty())
+		})
+
+		It("should roll back to the last successful revision and keep the readiness error", func() {
+			h := newHelm(nil, &helmv1alpha1.RollbackPolicy{OnReadinessFailure: true},
+				&helmv1alpha1.ReleaseStatus{Revision: 3, LastSuccessfulRevision: 2})
+
+			err := h.rollbackOnReadinessFailure(ctx, rollbacker, readinessErr)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("rolled back to revision 2"))
+			Expect(err.Error()).To(ContainSubstring("pod not ready"))
+			Expect(lserrors.CollectErrorCodes(err)).To(ContainElement(lsv1alpha1.ErrorReadinessCheckTimeout))
+			Expect(rollbacker.rollbacks).To(Equal([]int{2}))
+			Expect(h.ProviderStatus.Release.Revision).To(Equal(4))
+			Expect(h.ProviderStatus.Release.LastSuccessfulRevision).To(Equal(2))
+		})
+
+		It("should report a failed rollback together with the readiness error", func() {
+			rollbacker.rollbackErr = errors.New("rollback timed out")
+			h := newHelm(nil, &helmv1alpha1.RollbackPolicy{OnReadinessFailure: true},
+				&helmv1alpha1.ReleaseStatus{Revision: 3, LastSuccessfulRevision: 2})
+
+			err := h.rollbackOnReadinessFailure(ctx, rollbacker, readinessErr)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to roll back to revision 2"))
+			Expect(err.Error()).To(ContainSubstring("rollback timed out"))
+			Expect(lserrors.CollectErrorCodes(err)).To(ContainElement(lsv1alpha1.ErrorReadinessCheckTimeout))
+			Expect(h.ProviderStatus.Release.Revision).To(Equal(3))
+		})
+	})
+
+	Context("Request", func() {
+		It("should reject an invalid revision", func() {
+			h := newHelm(nil, nil, &helmv1alpha1.ReleaseStatus{Revision: 3, LastSuccessfulRevision: 2})
+
+			for _, value := range []string{"abc", "0", "-1"} {
+				err := h.rollbackOnRequest(ctx, rollbacker, value)
+				Expect(err).To(HaveOccurred())
+				Expect(lserrors.CollectErrorCodes(err)).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+			}
+			Expect(rollbacker.rollbacks).To(BeEmpty())
+		})
+
+		It("should reject a rollback to the last successful revision if there is none", func() {
+			h := newHelm(nil, nil, &helmv1alpha1.ReleaseStatus{Revision: 3})
+
+			err := h.rollbackOnRequest(ctx, rollbacker, helmv1alpha1.RollbackToLastSuccessfulRevision)
+			Expect(err).To(HaveOccurred())
+			Expect(lserrors.CollectErrorCodes(err)).To(ContainElement(lsv1alpha1.ErrorConfigurationProblem))
+			Expect(rollbacker.rollbacks).To(BeEmpty())
+		})
+
+		It("should roll back to the requested revision and remove the annotation", func() {
+			item := newDeployItem("{}")
+			item.Annotations = map[string]string{helmv1alpha1.RollbackAnnotation: helmv1alpha1.RollbackToLastSuccessfulRevision}
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(item).Build()
+			h := newHelm(lsClient, nil, &helmv1alpha1.ReleaseStatus{Revision: 3, LastSuccessfulRevision: 2})
+			h.DeployItem = item.DeepCopy()
+
+			err := h.rollbackOnRequest(ctx, rollbacker, helmv1alpha1.RollbackToLastSuccessfulRevision)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("rolled back to revision 2 on request"))
+			Expect(rollbacker.rollbacks).To(Equal([]int{2}))
+			Expect(h.ProviderStatus.Release.Revision).To(Equal(4))
+
+			Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(item), item)).To(Succeed())
+			Expect(item.Annotations).ToNot(HaveKey(helmv1alpha1.RollbackAnnotation))
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +36,7 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/targetregistry"
 	"github.com/gardener/landscaper/pkg/utils/targetresolver"
 	secretresolver "github.com/gardener/landscaper/pkg/utils/targetresolver/secret"
 	"github.com/gardener/landscaper/pkg/version"
@@ -101,6 +103,8 @@ func Add(log logging.Logger, lsMgr, hostMgr manager.Manager, args DeployerArgs)
 		hostMgr.GetScheme(),
 		args)
 
+	registerDriftMetrics()
+
 	log = log.Reconciles("", "DeployItem").WithValues(lc.KeyDeployItemType, string(args.Type))
 
 	return builder.ControllerManagedBy(lsMgr).
@@ -192,7 +196,7 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	if di.Status.GetJobID() == di.Status.JobIDFinished {
 		logger.Info("deploy item not reconciled because no new job ID")
-		return reconcile.Result{}, nil
+		return c.checkDrift(ctx, lsCtx, di, rt)
 	}
 
 	if di.Status.Phase.IsFinal() || di.Status.Phase.IsEmpty() {
@@ -278,6 +282,14 @@ func (c *controller) checkTargetResponsibilityAndResolve(ctx context.Context, lo
 		} else {
 			rt = targetresolver.NewResolvedTarget(target)
 		}
+
+		errs := targetregistry.Default.ValidateConfig(target.Spec.Type, []byte(rt.Content), field.NewPath("spec", "config"))
+		if len(errs) != 0 {
+			msg := fmt.Sprintf("invalid configuration of target '%s/%s'", target.Namespace, target.Name)
+			lsError = lserrors.NewWrappedError(errs.ToAggregate(), "checkTargetResponsibilityAndResolve", "validateTarget", msg,
+				lsv1alpha1.ErrorConfigurationProblem)
+			return nil, false, lsError
+		}
 	}
 
 	return rt, true, nil
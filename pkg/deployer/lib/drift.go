@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DriftDetectedReason is the reason of the drift condition and event if drifted resources were found.
+	DriftDetectedReason = "DriftDetected"
+	// DriftCorrectedReason is the reason of the drift condition and event if drifted resources were applied again.
+	DriftCorrectedReason = "DriftCorrected"
+	// NoDriftReason is the reason of the drift condition if no drifted resources were found.
+	NoDriftReason = "NoDrift"
+	// DriftCheckFailedReason is the reason of the drift condition if the drift detection failed.
+	DriftCheckFailedReason = "DriftCheckFailed"
+)
+
+// DriftDetector is an optional interface of a Deployer.
+// If implemented, the live state of the resources of succeeded deploy items is periodically compared
+// with their last applied manifests.
+type DriftDetector interface {
+	// DriftDetection returns the drift detection configuration of the deploy item.
+	// It returns nil if no drift detection is configured.
+	DriftDetection(ctx context.Context, di *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error)
+	// DetectDrift returns the managed resources of the deploy item that have been changed outside of the landscaper.
+	// If correct is true, the drifted resources are applied again.
+	DetectDrift(ctx context.Context, lsContext *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, target *lsv1alpha1.ResolvedTarget, correct bool) ([]managedresource.ResourceDiff, error)
+}
+
+// checkDrift runs the drift detection of a finished deploy item if it is due
+// and returns when the next drift detection should happen.
+func (c *controller) checkDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) (reconcile.Result, error) {
+
+	detector, ok := c.deployer.(DriftDetector)
+	if !ok || !di.DeletionTimestamp.IsZero() || di.Status.Phase != lsv1alpha1.DeployItemPhases.Succeeded {
+		return reconcile.Result{}, nil
+	}
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	spec, err := detector.DriftDetection(ctx, di)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if ddval.DriftDetectionSpecIsEmpty(spec) {
+		return reconcile.Result{}, nil
+	}
+
+	interval := spec.Every.Duration
+	if cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, dd.DriftDetectedCondition); cond != nil {
+		if next := cond.LastUpdateTime.Add(interval); time.Now().Before(next) {
+			return reconcile.Result{RequeueAfter: time.Until(next)}, nil
+		}
+	}
+
+	policy := spec.Policy
+	if len(policy) == 0 {
+		policy = dd.DriftPolicyReport
+	}
+
+	logger.Info("Checking deploy item for drifted resources", "policy", string(policy))
+	drifted, err := detector.DetectDrift(ctx, lsCtx, di, rt, policy == dd.DriftPolicyCorrect)
+	recordDriftCheck(di.Spec.Type, string(policy), len(drifted))
+
+	var cond lsv1alpha1.Condition
+	switch {
+	case err != nil:
+		logger.Error(err, "Drift detection failed")
+		cond = lsv1alpha1helper.UpdatedCondition(lsv1alpha1helper.GetOrInitCondition(di.Status.Conditions, dd.DriftDetectedCondition),
+			lsv1alpha1.ConditionUnknown, DriftCheckFailedReason, err.Error())
+	case len(drifted) == 0:
+		cond = lsv1alpha1helper.UpdatedCondition(lsv1alpha1helper.GetOrInitCondition(di.Status.Conditions, dd.DriftDetectedCondition),
+			lsv1alpha1.ConditionFalse, NoDriftReason, "No drifted resources found")
+	default:
+		reason := DriftDetectedReason
+		if policy == dd.DriftPolicyCorrect {
+			reason = DriftCorrectedReason
+		}
+		msg := DriftMessage(drifted)
+		logger.Info(msg, "reason", reason)
+		c.lsEventRecorder.Event(di, corev1.EventTypeWarning, reason, msg)
+		cond = lsv1alpha1helper.UpdatedCondition(lsv1alpha1helper.GetOrInitCondition(di.Status.Conditions, dd.DriftDetectedCondition),
+			lsv1alpha1.ConditionTrue, reason, msg)
+	}
+	// the update time is always set as it marks the time of the last drift detection
+	cond.LastUpdateTime = metav1.Now()
+	di.Status.Conditions = lsv1alpha1helper.MergeConditions(di.Status.Conditions, cond)
+
+	if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000152, di); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// DriftMessage returns a human readable description of the given drifted resources.
+func DriftMessage(drifted []managedresource.ResourceDiff) string {
+	resources := make([]string, len(drifted))
+	for i, diff := range drifted {
+		ref := diff.Resource
+		name := fmt.Sprintf("%s %s/%s", ref.Kind, ref.Namespace, ref.Name)
+		if len(ref.Namespace) == 0 {
+			name = fmt.Sprintf("%s %s", ref.Kind, ref.Name)
+		}
+		switch diff.Action {
+		case managedresource.ResourceDiffActionCreate:
+			resources[i] = fmt.Sprintf("%s (deleted)", name)
+		default:
+			resources[i] = fmt.Sprintf("%s (%s)", name, strings.Join(diff.ChangedFields, ", "))
+		}
+	}
+	return fmt.Sprintf("%d managed resource(s) changed outside of the landscaper: %s", len(drifted), strings.Join(resources, "; "))
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	deployItemSubsystemName = "deployitems"
+	deployItemTypeLabel     = "type"
+	driftPolicyLabel        = "policy"
+)
+
+var (
+	// driftChecks counts the drift checks of deploy items.
+	driftChecks = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "drift_checks_total",
+			Help:      "Total number of drift checks of deploy items by deploy item type.",
+		},
+		[]string{deployItemTypeLabel},
+	)
+
+	// driftedResources counts the managed resources that have been changed outside of the landscaper.
+	driftedResources = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "drifted_resources_total",
+			Help:      "Total number of detected managed resources that have been changed outside of the landscaper by deploy item type and drift policy.",
+		},
+		[]string{deployItemTypeLabel, driftPolicyLabel},
+	)
+)
+
+var registerDriftMetricsOnce sync.Once
+
+// registerDriftMetrics registers the drift detection metrics once,
+// as multiple deployers might run in the same process.
+func registerDriftMetrics() {
+	registerDriftMetricsOnce.Do(func() {
+		ctrlmetrics.Registry.MustRegister(driftChecks, driftedResources)
+	})
+}
+
+// recordDriftCheck records a drift check of a deploy item of the given type and the number of drifted resources.
+func recordDriftCheck(deployItemType lsv1alpha1.DeployItemType, policy string, drifted int) {
+	driftChecks.WithLabelValues(string(deployItemType)).Inc()
+	if drifted > 0 {
+		driftedResources.WithLabelValues(string(deployItemType), policy).Add(float64(drifted))
+	}
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+var _ = Describe("Drift Metrics", func() {
+
+	counterValue := func(c prometheus.Counter) float64 {
+		m := &dto.Metric{}
+		ExpectWithOffset(1, c.Write(m)).To(Succeed())
+		return m.GetCounter().GetValue()
+	}
+
+	BeforeEach(func() {
+		driftChecks.Reset()
+		driftedResources.Reset()
+	})
+
+	It("should count drift checks and drifted resources", func() {
+		recordDriftCheck("landscaper.gardener.cloud/helm", "report", 0)
+		recordDriftCheck("landscaper.gardener.cloud/helm", "report", 2)
+
+		Expect(counterValue(driftChecks.WithLabelValues("landscaper.gardener.cloud/helm"))).To(Equal(float64(2)))
+		Expect(counterValue(driftedResources.WithLabelValues("landscaper.gardener.cloud/helm", "report"))).To(Equal(float64(2)))
+	})
+})
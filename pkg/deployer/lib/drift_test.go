@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+)
+
+type fakeDriftDetector struct {
+	spec    *dd.DriftDetectionSpec
+	drifted []managedresource.ResourceDiff
+	calls   int
+	correct bool
+}
+
+func (f *fakeDriftDetector) Reconcile(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (f *fakeDriftDetector) Delete(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (f *fakeDriftDetector) Abort(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (f *fakeDriftDetector) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return extension.ReconcileExtensionHooks{}
+}
+
+func (f *fakeDriftDetector) DriftDetection(_ context.Context, _ *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error) {
+	return f.spec, nil
+}
+
+func (f *fakeDriftDetector) DetectDrift(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget, correct bool) ([]managedresource.ResourceDiff, error) {
+	f.calls++
+	f.correct = correct
+	return f.drifted, nil
+}
+
+var _ = Describe("Drift detection", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		recorder *record.FakeRecorder
+		detector *fakeDriftDetector
+		c        *controller
+		di       *lsv1alpha1.DeployItem
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.TODO())
+		di = &lsv1alpha1.DeployItem{}
+		di.Name = "my-di"
+		di.Namespace = "default"
+		di.Spec.Type = "test"
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(di).Build()
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(di), di)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(10)
+		detector = &fakeDriftDetector{
+			spec: &dd.DriftDetectionSpec{
+				Every: &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+			},
+		}
+		c = &controller{
+			deployer:        detector,
+			deployerType:    "test",
+			lsClient:        lsClient,
+			lsScheme:        api.LandscaperScheme,
+			lsEventRecorder: recorder,
+		}
+	})
+
+	It("should report drifted resources", func() {
+		ref := corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "my-cm"}
+		detector.drifted = []managedresource.ResourceDiff{
+			{Resource: ref, Action: managedresource.ResourceDiffActionUpdate, ChangedFields: []string{"data.key"}},
+		}
+
+		res, err := c.checkDrift(ctx, nil, di, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(10 * time.Minute))
+		Expect(detector.calls).To(Equal(1))
+		Expect(detector.correct).To(BeFalse())
+
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(di), di)).To(Succeed())
+		cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, dd.DriftDetectedCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(DriftDetectedReason))
+		Expect(cond.Message).To(ContainSubstring("ConfigMap default/my-cm (data.key)"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(DriftDetectedReason)))
+	})
+
+	It("should correct drifted resources if configured", func() {
+		detector.spec.Policy = dd.DriftPolicyCorrect
+		detector.drifted = []managedresource.ResourceDiff{
+			{Resource: corev1.ObjectReference{Kind: "Namespace", Name: "my-ns"}, Action: managedresource.ResourceDiffActionCreate},
+		}
+
+		_, err := c.checkDrift(ctx, nil, di, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(detector.correct).To(BeTrue())
+
+		cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, dd.DriftDetectedCondition)
+		Expect(cond.Reason).To(Equal(DriftCorrectedReason))
+		Expect(cond.Message).To(ContainSubstring("Namespace my-ns (deleted)"))
+	})
+
+	It("should not check for drift before the interval has passed", func() {
+		_, err := c.checkDrift(ctx, nil, di, nil)
+		Expect(err).ToNot(HaveOccurred())
+		cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, dd.DriftDetectedCondition)
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		Expect(recorder.Events).To(BeEmpty())
+
+		res, err := c.checkDrift(ctx, nil, di, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(detector.calls).To(Equal(1))
+		Expect(res.RequeueAfter).To(BeNumerically(">", 9*time.Minute))
+
+		cond.LastUpdateTime = metav1.NewTime(time.Now().Add(-11 * time.Minute))
+		di.Status.Conditions = lsv1alpha1helper.MergeConditions(di.Status.Conditions, *cond)
+		_, err = c.checkDrift(ctx, nil, di, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(detector.calls).To(Equal(2))
+	})
+
+	It("should not check deploy items that have not succeeded", func() {
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Failed
+		res, err := c.checkDrift(ctx, nil, di, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeZero())
+		Expect(detector.calls).To(BeZero())
+	})
+})
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+// DetectDrift compares the live state of the managed resources with the given manifests.
+// The manifests are applied with a server-side dry-run, so the comparison is not affected by defaulting of the api server.
+//
+// A resource has drifted if it was deleted or if a field that is defined by its manifest has been changed.
+// Changes to fields that are not defined by the manifests, e.g. annotations added by other controllers, are ignored.
+// Resources that are not part of the given managed resources are ignored as they have never been applied.
+func DetectDrift(ctx context.Context, opts ManifestApplierOptions) ([]managedresource.ResourceDiff, error) {
+	opts.DryRun = true
+	applier := NewManifestApplier(opts)
+	if err := applier.Apply(ctx); err != nil {
+		return nil, err
+	}
+
+	drifted := make([]managedresource.ResourceDiff, 0)
+	for _, diff := range applier.GetDryRunResult().Resources {
+		switch diff.Action {
+		case managedresource.ResourceDiffActionCreate:
+			if containsObjectRef(diff.Resource, opts.ManagedResources) {
+				drifted = append(drifted, diff)
+			}
+		case managedresource.ResourceDiffActionUpdate:
+			desiredFields := applier.desiredFields[resourceKey(diff.Resource)]
+			changedFields := make([]string, 0, len(diff.ChangedFields))
+			for _, path := range diff.ChangedFields {
+				if desiredFields.Has(path) {
+					changedFields = append(changedFields, path)
+				}
+			}
+			if len(changedFields) != 0 {
+				diff.ChangedFields = changedFields
+				drifted = append(drifted, diff)
+			}
+		}
+	}
+	return drifted, nil
+}
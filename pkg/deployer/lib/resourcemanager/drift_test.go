@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("DetectDrift", func() {
+
+	var (
+		state *envtest.State
+		ctx   context.Context
+		cm    *corev1.ConfigMap
+		opts  resourcemanager.ManifestApplierOptions
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = logging.NewContextWithDiscard(context.TODO())
+		state, err = testenv.InitState(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		cm = &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts = resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeleteTimeout:    10 * time.Second,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		opts.ManagedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(state.CleanupState(ctx))
+	})
+
+	It("should not report unchanged resources", func() {
+		drifted, err := resourcemanager.DetectDrift(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(BeEmpty())
+	})
+
+	It("should report fields of the manifest that have been changed", func() {
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Data["key"] = "modified"
+		Expect(testenv.Client.Update(ctx, res)).To(Succeed())
+
+		drifted, err := resourcemanager.DetectDrift(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(HaveLen(1))
+		Expect(drifted[0].Resource.Name).To(Equal("my-cm"))
+		Expect(drifted[0].Action).To(Equal(managedresource.ResourceDiffActionUpdate))
+		Expect(drifted[0].ChangedFields).To(ConsistOf("data.key"))
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "modified"))
+	})
+
+	It("should ignore fields that are not defined by the manifest", func() {
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Annotations = map[string]string{
+			"some-controller": "value",
+		}
+		res.Data["other"] = "val"
+		Expect(testenv.Client.Update(ctx, res)).To(Succeed())
+
+		drifted, err := resourcemanager.DetectDrift(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(BeEmpty())
+	})
+
+	It("should report deleted resources", func() {
+		Expect(testenv.Client.Delete(ctx, cm)).To(Succeed())
+
+		drifted, err := resourcemanager.DetectDrift(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(HaveLen(1))
+		Expect(drifted[0].Resource.Name).To(Equal("my-cm"))
+		Expect(drifted[0].Action).To(Equal(managedresource.ResourceDiffActionCreate))
+	})
+})
@@ -16,12 +16,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	apischema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	apimacherrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/utils/diff"
 )
 
 // ApplyManifests creates or updates all configured manifests.
@@ -57,6 +60,9 @@ type ManifestApplierOptions struct {
 	ManagedResources managedresource.ManagedResourceStatusList
 	// Labels defines additional labels that are automatically injected into all resources.
 	Labels map[string]string
+	// DryRun defines that all changes are only applied with a server-side dry-run.
+	// The managed resources are not changed and the computed changes can be read with GetDryRunResult.
+	DryRun bool
 }
 
 // ManifestApplier creates or updated manifest based on their definition.
@@ -71,6 +77,7 @@ type ManifestApplier struct {
 	manifests        []managedresource.Manifest
 	managedResources managedresource.ManagedResourceStatusList
 	labels           map[string]string
+	dryRun           bool
 
 	// properties created during runtime
 
@@ -81,6 +88,12 @@ type ManifestApplier struct {
 	// The second group contains all clusterwide resources and teh third one contains all namespaced resources.
 	manifestExecutions [3][]*Manifest
 	apiResourceHandler *ApiResourceHandler
+
+	// resourceDiffs contains the changes computed during a dry-run.
+	resourceDiffs []managedresource.ResourceDiff
+	// desiredFields contains the field paths that are defined by the manifests, indexed by resourceKey.
+	desiredFields map[string]sets.String
+	diffMux       sync.Mutex
 }
 
 const (
@@ -114,6 +127,7 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 		manifests:          opts.Manifests,
 		managedResources:   opts.ManagedResources,
 		labels:             opts.Labels,
+		dryRun:             opts.DryRun,
 		apiResourceHandler: CreateApiResourceHandler(opts.Clientset),
 	}
 }
@@ -123,11 +137,29 @@ func (a *ManifestApplier) GetManagedResourcesStatus() managedresource.ManagedRes
 	return a.managedResources
 }
 
+// GetDryRunResult returns the changes that were computed by a dry-run of the applier.
+func (a *ManifestApplier) GetDryRunResult() *managedresource.DryRunResult {
+	a.diffMux.Lock()
+	defer a.diffMux.Unlock()
+	resources := make([]managedresource.ResourceDiff, len(a.resourceDiffs))
+	copy(resources, a.resourceDiffs)
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Resource.String() < resources[j].Resource.String()
+	})
+	return &managedresource.DryRunResult{
+		Time:      metav1.Now(),
+		Resources: resources,
+	}
+}
+
 // Apply creates or updates all configured manifests.
+// If the applier is configured for a dry-run, all changes are only computed with a server-side dry-run.
 func (a *ManifestApplier) Apply(ctx context.Context) error {
 	if err := a.prepareManifests(); err != nil {
 		return err
 	}
+	a.resourceDiffs = make([]managedresource.ResourceDiff, 0)
+	a.desiredFields = map[string]sets.String{}
 
 	var (
 		allErrs []error
@@ -137,6 +169,12 @@ func (a *ManifestApplier) Apply(ctx context.Context) error {
 	// we can then compare which one need to be cleaned up.
 	oldManagedResources := a.managedResources
 	a.managedResources = make(managedresource.ManagedResourceStatusList, 0)
+	if a.dryRun {
+		// a dry-run must not change the managed resources as nothing has been applied
+		defer func() {
+			a.managedResources = oldManagedResources
+		}()
+	}
 	for _, list := range a.manifestExecutions {
 		var (
 			wg               = sync.WaitGroup{}
@@ -206,12 +244,27 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		}
 	}
 
+	if a.dryRun {
+		if err := a.addDesiredFields(obj); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Debug("Applying manifest", lc.KeyResource, kutil.ObjectKeyFromObject(obj).String(), lc.KeyGroupVersionKind, gvk)
 
 	currObj := unstructured.Unstructured{} // can't use obj.NewEmptyInstance() as this returns a runtime.Unstructured object which doesn't implement client.Object
 	currObj.GetObjectKind().SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
 	key := kutil.ObjectKey(obj.GetName(), obj.GetNamespace())
 	if err := a.kubeClient.Get(ctx, key, &currObj); err != nil {
+		if a.dryRun && apimeta.IsNoMatchError(err) {
+			// the resource type is not yet known, e.g. because its CRD is also only applied with a dry-run.
+			a.addResourceDiff(obj, managedresource.ResourceDiffActionCreate, nil)
+			return &managedresource.ManagedResourceStatus{
+				AnnotateBeforeDelete: manifest.AnnotateBeforeDelete,
+				Policy:               manifest.Policy,
+				Resource:             *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+			}, nil
+		}
 		if !apierrors.IsNotFound(err) {
 			return nil, fmt.Errorf("unable to get object: %w", err)
 		}
@@ -232,8 +285,14 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			obj.SetAnnotations(objAnnotations)
 		}
 
-		if err := a.kubeClient.Create(ctx, obj); err != nil {
-			return nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
+		if err := a.kubeClient.Create(ctx, obj, a.createOptions()...); err != nil {
+			// The namespace of the resource might also be only created with a dry-run.
+			if !a.dryRun || !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
+			}
+		}
+		if a.dryRun {
+			a.addResourceDiff(obj, managedresource.ResourceDiffActionCreate, nil)
 		}
 		return &managedresource.ManagedResourceStatus{
 			AnnotateBeforeDelete: manifest.AnnotateBeforeDelete,
@@ -257,9 +316,15 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 
 	if manifest.Policy == managedresource.ImmutablePolicy {
 		logger.Info("Resource is immutable, skip update", lc.KeyResource, key.String())
+		if a.dryRun {
+			a.addResourceDiff(&currObj, managedresource.ResourceDiffActionNone, nil)
+		}
 		return mr, nil
 	}
 
+	// the live state is needed to compute the changes of a dry-run, as the merge strategies modify the current object.
+	liveObj := currObj.DeepCopy()
+
 	switch a.updateStrategy {
 	case manifestv1alpha2.UpdateStrategyUpdate:
 		fallthrough
@@ -275,14 +340,17 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		}
 
 		if a.updateStrategy == manifestv1alpha2.UpdateStrategyUpdate {
-			if err := a.kubeClient.Update(ctx, obj); err != nil {
+			if err := a.kubeClient.Update(ctx, obj, a.updateOptions()...); err != nil {
 				return mr, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 			}
 		} else {
-			if err := a.kubeClient.Patch(ctx, obj, client.MergeFrom(&currObj)); err != nil {
+			if err := a.kubeClient.Patch(ctx, obj, client.MergeFrom(&currObj), a.patchOptions()...); err != nil {
 				return mr, fmt.Errorf("unable to patch resource %s: %w", key.String(), err)
 			}
 		}
+		if err := a.addUpdateDiff(liveObj, obj); err != nil {
+			return mr, err
+		}
 	case manifestv1alpha2.UpdateStrategyMerge:
 		fallthrough
 	case manifestv1alpha2.UpdateStrategyMergeOverwrite:
@@ -303,9 +371,12 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		a.injectLabels(&currObj)
 		kutil.SetMetaDataLabel(&currObj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
 
-		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
+		if err := a.kubeClient.Update(ctx, &currObj, a.updateOptions()...); err != nil {
 			return mr, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 		}
+		if err := a.addUpdateDiff(liveObj, &currObj); err != nil {
+			return mr, err
+		}
 	default:
 		return mr, fmt.Errorf("%s is not a valid update strategy", a.updateStrategy)
 	}
@@ -360,6 +431,15 @@ func (a *ManifestApplier) cleanupOrphanedResources(ctx context.Context, managedR
 		}
 
 		if !containsObjectRef(ref, a.managedResources) {
+			if a.dryRun {
+				logger2.Debug("Object is orphaned and would be deleted")
+				if err := a.kubeClient.Delete(ctx, obj, client.DryRunAll); err != nil && !apierrors.IsNotFound(err) {
+					allErrs = append(allErrs, err)
+					continue
+				}
+				a.addResourceDiff(obj, managedresource.ResourceDiffActionDelete, nil)
+				continue
+			}
 			logger2.Debug("Object is orphaned and will be deleted")
 			wg.Add(1)
 			go func(obj *unstructured.Unstructured) {
@@ -380,6 +460,94 @@ func (a *ManifestApplier) cleanupOrphanedResources(ctx context.Context, managedR
 	return apimacherrors.NewAggregate(allErrs)
 }
 
+func (a *ManifestApplier) createOptions() []client.CreateOption {
+	if a.dryRun {
+		return []client.CreateOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (a *ManifestApplier) updateOptions() []client.UpdateOption {
+	if a.dryRun {
+		return []client.UpdateOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (a *ManifestApplier) patchOptions() []client.PatchOption {
+	if a.dryRun {
+		return []client.PatchOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (a *ManifestApplier) addResourceDiff(obj *unstructured.Unstructured, action managedresource.ResourceDiffAction, changedFields []string) {
+	a.diffMux.Lock()
+	defer a.diffMux.Unlock()
+	a.resourceDiffs = append(a.resourceDiffs, managedresource.ResourceDiff{
+		Resource:      *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+		Action:        action,
+		ChangedFields: changedFields,
+	})
+}
+
+func (a *ManifestApplier) addDesiredFields(obj *unstructured.Unstructured) error {
+	paths, err := diff.FieldPaths(obj.Object)
+	if err != nil {
+		return fmt.Errorf("unable to get fields of resource %s: %w", kutil.ObjectKeyFromObject(obj).String(), err)
+	}
+	a.diffMux.Lock()
+	defer a.diffMux.Unlock()
+	a.desiredFields[resourceKey(*kutil.CoreObjectReferenceFromUnstructuredObject(obj))] = sets.NewString(paths...)
+	return nil
+}
+
+// resourceKey identifies a resource independently of its api version and uid.
+func resourceKey(ref corev1.ObjectReference) string {
+	return fmt.Sprintf("%s/%s/%s", ref.GroupVersionKind().GroupKind().String(), ref.Namespace, ref.Name)
+}
+
+// addUpdateDiff records the changes between the live object and the result of a dry-run update.
+// It is a noop if the applier does not perform a dry-run.
+func (a *ManifestApplier) addUpdateDiff(liveObj, dryRunObj *unstructured.Unstructured) error {
+	if !a.dryRun {
+		return nil
+	}
+	changedFields, err := ChangedFields(liveObj, dryRunObj)
+	if err != nil {
+		return fmt.Errorf("unable to compute changes of resource %s: %w", kutil.ObjectKeyFromObject(liveObj).String(), err)
+	}
+	action := managedresource.ResourceDiffActionNone
+	if len(changedFields) != 0 {
+		action = managedresource.ResourceDiffActionUpdate
+	}
+	a.addResourceDiff(liveObj, action, changedFields)
+	return nil
+}
+
+// ignoredDiffFields are the fields that are maintained by the api server and therefore ignored
+// when computing the changes of a resource.
+var ignoredDiffFields = [][]string{
+	{"metadata", "creationTimestamp"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"status"},
+}
+
+// ChangedFields returns the paths of all fields that differ between the given objects.
+// Fields that are maintained by the api server like the resource version or the status are ignored.
+func ChangedFields(oldObj, newObj *unstructured.Unstructured) ([]string, error) {
+	oldObj = oldObj.DeepCopy()
+	newObj = newObj.DeepCopy()
+	for _, fields := range ignoredDiffFields {
+		unstructured.RemoveNestedField(oldObj.Object, fields...)
+		unstructured.RemoveNestedField(newObj.Object, fields...)
+	}
+	return diff.ChangedFields(oldObj.Object, newObj.Object)
+}
+
 // crdIdentifier generates an identifier string from a GroupVersionKind object
 // The version is ignored in this case, because the information whether the resource is namespaced or not
 // does not depend on it.
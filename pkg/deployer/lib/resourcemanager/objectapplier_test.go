@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
@@ -711,4 +712,97 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
 		Expect(cmRead.Annotations).To(HaveKeyWithValue("modified", "True"))
 	})
+
+	Context("DryRun", func() {
+
+		It("should compute the changes without applying them", func() {
+			cm := &corev1.ConfigMap{}
+			cm.Name = "my-cm"
+			cm.Namespace = state.Namespace
+			cm.Data = map[string]string{
+				"key": "val",
+			}
+			cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+			secret := &corev1.Secret{}
+			secret.Name = "my-secret"
+			secret.Namespace = state.Namespace
+			secret.Data = map[string][]byte{
+				"key": []byte("val"),
+			}
+			secretRaw, err := kutil.ConvertToRawExtension(secret, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+
+			opts := resourcemanager.ManifestApplierOptions{
+				Decoder:          api.NewDecoder(scheme.Scheme),
+				KubeClient:       testenv.Client,
+				Clientset:        clientset,
+				DefaultNamespace: state.Namespace,
+				DeleteTimeout:    10 * time.Second,
+				UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+				Manifests: []managedresource.Manifest{
+					{
+						Manifest: cmRaw,
+					},
+					{
+						Manifest: secretRaw,
+					},
+				},
+				ManagedResources: managedresource.ManagedResourceStatusList{},
+			}
+			managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(managedResources).To(HaveLen(2))
+
+			cm.Data["key"] = "modified"
+			cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+			newCm := &corev1.ConfigMap{}
+			newCm.Name = "my-new-cm"
+			newCm.Namespace = state.Namespace
+			newCmRaw, err := kutil.ConvertToRawExtension(newCm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+
+			opts.Manifests = []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+				{
+					Manifest: newCmRaw,
+				},
+			}
+			opts.ManagedResources = managedResources
+			opts.DryRun = true
+			applier := resourcemanager.NewManifestApplier(opts)
+			Expect(applier.Apply(ctx)).To(Succeed())
+			Expect(applier.GetManagedResourcesStatus()).To(Equal(managedResources))
+
+			result := applier.GetDryRunResult()
+			Expect(result.Resources).To(HaveLen(3))
+			Expect(result.Resources[0].Resource.Name).To(Equal("my-cm"))
+			Expect(result.Resources[0].Action).To(Equal(managedresource.ResourceDiffActionUpdate))
+			Expect(result.Resources[0].ChangedFields).To(ConsistOf("data.key"))
+			Expect(result.Resources[1].Resource.Name).To(Equal("my-new-cm"))
+			Expect(result.Resources[1].Action).To(Equal(managedresource.ResourceDiffActionCreate))
+			Expect(result.Resources[2].Resource.Name).To(Equal("my-secret"))
+			Expect(result.Resources[2].Action).To(Equal(managedresource.ResourceDiffActionDelete))
+
+			res := &corev1.ConfigMap{}
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+			Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(newCm), &corev1.ConfigMap{})).ToNot(Succeed())
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should ignore fields that are maintained by the api server", func() {
+			oldObj := &unstructured.Unstructured{}
+			Expect(oldObj.UnmarshalJSON([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a","resourceVersion":"1"},"data":{"key":"val"}}`))).To(Succeed())
+			newObj := &unstructured.Unstructured{}
+			Expect(newObj.UnmarshalJSON([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a","resourceVersion":"2","labels":{"a":"b"}},"data":{"key":"val"}}`))).To(Succeed())
+
+			changedFields, err := resourcemanager.ChangedFields(oldObj, newObj)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changedFields).To(ConsistOf("metadata.labels"))
+		})
+	})
 })
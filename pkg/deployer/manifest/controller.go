@@ -15,6 +15,8 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
@@ -86,3 +88,23 @@ func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1al
 	next := schedule.Next(last)
 	return &next, nil
 }
+
+func (d *deployer) DriftDetection(_ context.Context, di *lsv1alpha1.DeployItem) (*dd.DriftDetectionSpec, error) {
+	manifest, err := New(d.lsClient, d.hostClient, &d.config, di, nil)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.ProviderConfiguration.DryRun {
+		// nothing has been applied that could drift
+		return nil, nil
+	}
+	return manifest.ProviderConfiguration.DriftDetection, nil
+}
+
+func (d *deployer) DetectDrift(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget, correct bool) ([]managedresource.ResourceDiff, error) {
+	manifest, err := New(d.lsClient, d.hostClient, &d.config, di, rt)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.DetectDrift(ctx, correct)
+}
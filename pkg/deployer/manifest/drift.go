@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"context"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+)
+
+// DetectDrift returns the managed resources that have been changed outside of the landscaper.
+// If correct is true, the manifests are applied again if drifted resources were found.
+// The updated provider status is written into the deploy item but not persisted.
+func (m *Manifest) DetectDrift(ctx context.Context, correct bool) ([]managedresource.ResourceDiff, error) {
+	currOp := "DetectDriftManifests"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if m.ProviderStatus == nil || len(m.ProviderStatus.ManagedResources) == 0 {
+		return nil, nil
+	}
+
+	_, targetClient, targetClientSet, err := m.TargetClient(ctx)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "TargetClusterClient", err.Error())
+	}
+
+	opts := m.applierOptions(targetClient, targetClientSet)
+	drifted, err := resourcemanager.DetectDrift(ctx, opts)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "DetectDrift", err.Error())
+	}
+	if !correct || len(drifted) == 0 {
+		return drifted, nil
+	}
+
+	logger.Info("Correcting drifted resources", "count", len(drifted))
+	applier := resourcemanager.NewManifestApplier(opts)
+	err = applier.Apply(ctx)
+	m.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
+	var err2 error
+	m.DeployItem.Status.ProviderStatus, err2 = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
+	if err != nil {
+		return drifted, lserrors.NewWrappedError(err,
+			currOp, "CorrectDrift", err.Error())
+	}
+	if err2 != nil {
+		return drifted, lserrors.NewWrappedError(err2,
+			currOp, "ProviderStatus", err2.Error())
+	}
+	return drifted, nil
+}
@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -51,22 +52,14 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		}
 	}
 
-	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
-		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
-		KubeClient:       targetClient,
-		Clientset:        targetClientSet,
-		DeployItemName:   m.DeployItem.Name,
-		DeleteTimeout:    m.ProviderConfiguration.DeleteTimeout.Duration,
-		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
-		Manifests:        m.ProviderConfiguration.Manifests,
-		ManagedResources: m.ProviderStatus.ManagedResources,
-		Labels: map[string]string{
-			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
-		},
-	})
+	applier := resourcemanager.NewManifestApplier(m.applierOptions(targetClient, targetClientSet))
 
 	err = applier.Apply(ctx)
 	m.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
+	m.ProviderStatus.DryRunResult = nil
+	if m.ProviderConfiguration.DryRun {
+		m.ProviderStatus.DryRunResult = applier.GetDryRunResult()
+	}
 	if err != nil {
 		var err2 error
 		m.DeployItem.Status.ProviderStatus, err2 = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
@@ -86,6 +79,13 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 			currOp, "UpdateStatus", err.Error())
 	}
 
+	if m.ProviderConfiguration.DryRun {
+		// nothing has been applied, so there are no resources to check or export
+		logger.Info("Dry-run finished", "changes", len(m.ProviderStatus.DryRunResult.Resources))
+		m.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.DryRunSucceeded
+		return nil
+	}
+
 	if err := m.CheckResourcesReady(ctx, targetClient); err != nil {
 		return err
 	}
@@ -115,6 +115,24 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 	return nil
 }
 
+// applierOptions returns the options to apply the manifests of the deploy item to the target cluster.
+func (m *Manifest) applierOptions(targetClient client.Client, targetClientSet kubernetes.Interface) resourcemanager.ManifestApplierOptions {
+	return resourcemanager.ManifestApplierOptions{
+		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
+		KubeClient:       targetClient,
+		Clientset:        targetClientSet,
+		DeployItemName:   m.DeployItem.Name,
+		DeleteTimeout:    m.ProviderConfiguration.DeleteTimeout.Duration,
+		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
+		Manifests:        m.ProviderConfiguration.Manifests,
+		ManagedResources: m.ProviderStatus.ManagedResources,
+		Labels: map[string]string{
+			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
+		},
+		DryRun: m.ProviderConfiguration.DryRun,
+	}
+}
+
 // CheckResourcesReady checks if the managed resources are Ready/Healthy.
 func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client) error {
 
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package blueprints
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// lockFile tries to acquire an exclusive lock on the given file without waiting.
+// If the file is already locked, errLocked is returned.
+// The returned function releases the lock.
+func lockFile(path string) (func(), error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open lock file %q: %w", path, err)
+	}
+	for {
+		err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if !errors.Is(err, syscall.EINTR) {
+			break
+		}
+	}
+	if err != nil {
+		_ = file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, errLocked
+		}
+		return nil, fmt.Errorf("unable to lock file %q: %w", path, err)
+	}
+	unlock := func() {
+		_ = syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		_ = file.Close()
+	}
+
+	// the lock file might have been removed by the garbage collection after it was opened,
+	// the lock is not exclusive anymore in that case as others would create a new file.
+	fileInfo, err := file.Stat()
+	if err != nil {
+		unlock()
+		return nil, fmt.Errorf("unable to stat lock file %q: %w", path, err)
+	}
+	pathInfo, err := os.Stat(path)
+	if err != nil || !os.SameFile(fileInfo, pathInfo) {
+		unlock()
+		return nil, errLocked
+	}
+	return unlock, nil
+}
+
+// removeLockFile removes the given lock file if it is not locked.
+func removeLockFile(path string) error {
+	unlock, err := lockFile(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return os.Remove(path)
+}
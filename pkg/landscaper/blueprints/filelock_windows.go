@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package blueprints
+
+import (
+	"sync"
+)
+
+// fileLocks are process local locks that are used as file locks are not supported on windows.
+var fileLocks sync.Map
+
+// lockFile tries to acquire an exclusive lock for the given path without waiting.
+// The lock is only effective within the current process.
+// If the path is already locked, errLocked is returned.
+// The returned function releases the lock.
+func lockFile(path string) (func(), error) {
+	val, _ := fileLocks.LoadOrStore(path, &sync.Mutex{})
+	mux := val.(*sync.Mutex)
+	if !mux.TryLock() {
+		return nil, errLocked
+	}
+	return mux.Unlock, nil
+}
+
+// removeLockFile removes the lock of the given path if it is not locked.
+func removeLockFile(path string) error {
+	unlock, err := lockFile(path)
+	if err != nil {
+		return err
+	}
+	fileLocks.Delete(path)
+	unlock()
+	return nil
+}
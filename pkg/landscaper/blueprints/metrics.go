@@ -34,10 +34,54 @@ var (
 			Help:      "Total number of items currently stored by the blueprint store.",
 		},
 	)
+
+	// SharedCacheDiskUsage discloses disk used by the shared blueprint cache as observed by the last garbage collection.
+	SharedCacheDiskUsage = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "shared_cache_disk_usage_bytes",
+			Help:      "Bytes on disk currently used by the shared blueprint cache.",
+		},
+	)
+
+	// SharedCacheHits discloses the number of blueprint blobs that were read from the shared blueprint cache.
+	SharedCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "shared_cache_hits_total",
+			Help:      "Total number of blueprint blobs that were read from the shared blueprint cache.",
+		},
+	)
+
+	// SharedCacheMisses discloses the number of blueprint blobs that had to be fetched from the remote.
+	SharedCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "shared_cache_misses_total",
+			Help:      "Total number of blueprint blobs that were not found in the shared blueprint cache.",
+		},
+	)
+
+	// SharedCacheEvictions discloses the number of blueprint blobs that were evicted by the garbage collection.
+	SharedCacheEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "shared_cache_evictions_total",
+			Help:      "Total number of blueprint blobs that were evicted from the shared blueprint cache.",
+		},
+	)
 )
 
 // RegisterStoreMetrics allows to register blueprint store metrics with a given prometheus registerer
 func RegisterStoreMetrics(reg prometheus.Registerer) {
 	reg.MustRegister(DiskUsage)
 	reg.MustRegister(StoredItems)
+	reg.MustRegister(SharedCacheDiskUsage)
+	reg.MustRegister(SharedCacheHits)
+	reg.MustRegister(SharedCacheMisses)
+	reg.MustRegister(SharedCacheEvictions)
 }
@@ -0,0 +1,446 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/opencontainers/go-digest"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+)
+
+const (
+	sharedCacheBlobsDir = "blobs"
+	sharedCacheLocksDir = "locks"
+	sharedCacheTmpDir   = "tmp"
+	sharedCacheGCLock   = "gc.lock"
+
+	sharedCacheBlobFile = "blob"
+	sharedCacheInfoFile = "info.json"
+
+	// sharedCacheTmpMaxAge is the age after which leftovers of interrupted downloads and unused lock files are removed.
+	sharedCacheTmpMaxAge = time.Hour
+
+	// sharedCacheLockRetryInterval is the interval in which a lock that is held by someone else is tried again.
+	sharedCacheLockRetryInterval = 100 * time.Millisecond
+)
+
+// errLocked is returned if a file lock cannot be acquired because it is held by someone else.
+var errLocked = errors.New("LOCKED")
+
+// SharedCache is a content-addressed cache of blueprint blobs.
+// The blobs are stored by their digest on a filesystem that survives restarts and that can be shared between multiple
+// landscaper instances, e.g. a persistent volume.
+//
+//	root
+//	├── blobs
+//	│   └── <algorithm>
+//	│       └── <encoded digest>
+//	│           ├── blob
+//	│           └── info.json
+//	├── locks
+//	└── tmp
+//
+// Entries are immutable and are created and evicted by renaming them, so that they can be read without locking.
+// File locks per digest are used to prevent that the same blob is downloaded by multiple instances at once and
+// a global file lock prevents that multiple instances run the garbage collection at the same time.
+type SharedCache struct {
+	log  logging.Logger
+	root string
+
+	size            int64
+	gcHighThreshold float64
+	gcLowThreshold  float64
+}
+
+// sharedCacheEntryInfo contains the metadata of a blob in the shared cache.
+type sharedCacheEntryInfo struct {
+	MediaType string `json:"mediaType"`
+	Size      int64  `json:"size"`
+}
+
+// NewSharedCache creates a new shared blueprint cache in the configured directory.
+// The garbage collection thresholds of the blueprint store are also used for the shared cache.
+func NewSharedCache(log logging.Logger, cfg config.SharedBlueprintCache, gcConfig config.GarbageCollectionConfiguration) (*SharedCache, error) {
+	if len(cfg.Path) == 0 {
+		return nil, errors.New("no path defined for the shared blueprint cache")
+	}
+	for _, dir := range []string{sharedCacheBlobsDir, sharedCacheLocksDir, sharedCacheTmpDir} {
+		if err := os.MkdirAll(filepath.Join(cfg.Path, dir), os.ModePerm); err != nil {
+			return nil, fmt.Errorf("unable to create directory for shared blueprint cache: %w", err)
+		}
+	}
+
+	c := &SharedCache{
+		log:             log,
+		root:            cfg.Path,
+		gcHighThreshold: gcConfig.GCHighThreshold,
+		gcLowThreshold:  gcConfig.GCLowThreshold,
+	}
+	if len(cfg.Size) != 0 && cfg.Size != "0" {
+		quantity, err := resource.ParseQuantity(cfg.Size)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse size %q of shared blueprint cache: %w", cfg.Size, err)
+		}
+		sizeInBytes, ok := quantity.AsInt64()
+		if !ok {
+			return nil, fmt.Errorf("unable to parse size %q of shared blueprint cache as int", cfg.Size)
+		}
+		c.size = sizeInBytes
+	}
+	return c, nil
+}
+
+// FetchAndExtractBlueprint extracts the blueprint blob to the given path.
+// The blob is read from the shared cache or fetched from the remote and added to the cache if it is not cached yet.
+func (c *SharedCache) FetchAndExtractBlueprint(
+	ctx context.Context,
+	fs vfs.FileSystem,
+	bpPath string,
+	res model.Resource,
+	blobInfo *ctf.BlobInfo) error {
+
+	dig, err := digest.Parse(blobInfo.Digest)
+	if err != nil || !dig.Algorithm().Available() {
+		// blobs without a verifiable digest cannot be content-addressed
+		c.log.Debug("blueprint blob is not cached as it has no valid digest", "digest", blobInfo.Digest)
+		return FetchAndExtractBlueprint(ctx, fs, bpPath, res, blobInfo)
+	}
+
+	file, info, err := c.Get(dig)
+	if err != nil {
+		if !errors.Is(err, NotFoundError) {
+			return err
+		}
+		SharedCacheMisses.Inc()
+		file, info, err = c.add(ctx, dig, res, blobInfo)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			go c.RunGarbageCollection()
+		}()
+	} else {
+		SharedCacheHits.Inc()
+	}
+	defer file.Close()
+
+	mediaType, err := mediatype.Parse(info.MediaType)
+	if err != nil {
+		return fmt.Errorf("unable to parse media type: %w", err)
+	}
+	return ExtractBlueprint(ctx, fs, bpPath, mediaType, file)
+}
+
+// Get returns the blob with the given digest and its metadata.
+// The integrity of the blob is verified before it is returned,
+// blobs that do not match their digest are removed from the cache and a NotFoundError is returned.
+// The caller has to close the returned file.
+func (c *SharedCache) Get(dig digest.Digest) (*os.File, *sharedCacheEntryInfo, error) {
+	entryPath := c.entryPath(dig)
+	infoBytes, err := os.ReadFile(filepath.Join(entryPath, sharedCacheInfoFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, NotFoundError
+		}
+		return nil, nil, fmt.Errorf("unable to read shared cache entry %s: %w", dig, err)
+	}
+	info := &sharedCacheEntryInfo{}
+	if err := json.Unmarshal(infoBytes, info); err != nil {
+		c.log.Info("removing invalid shared cache entry", "digest", dig.String(), "error", err.Error())
+		c.remove(dig)
+		return nil, nil, NotFoundError
+	}
+
+	file, err := os.Open(filepath.Join(entryPath, sharedCacheBlobFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, NotFoundError
+		}
+		return nil, nil, fmt.Errorf("unable to open shared cache entry %s: %w", dig, err)
+	}
+	verifier := dig.Verifier()
+	if _, err := io.Copy(verifier, file); err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("unable to read shared cache entry %s: %w", dig, err)
+	}
+	if !verifier.Verified() {
+		file.Close()
+		c.log.Info("removing corrupted shared cache entry", "digest", dig.String())
+		c.remove(dig)
+		return nil, nil, NotFoundError
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("unable to read shared cache entry %s: %w", dig, err)
+	}
+
+	// the modification time of the info file is used as last access time for the garbage collection
+	now := time.Now()
+	if err := os.Chtimes(filepath.Join(entryPath, sharedCacheInfoFile), now, now); err != nil {
+		c.log.Debug("unable to update access time of shared cache entry", "digest", dig.String(), "error", err.Error())
+	}
+	return file, info, nil
+}
+
+// add fetches the blob from the remote and adds it to the cache.
+// The download is guarded by a file lock so that a blob is only downloaded once if multiple instances request it at the same time.
+// Waiting for the lock is aborted if the context is done.
+func (c *SharedCache) add(ctx context.Context, dig digest.Digest, res model.Resource, blobInfo *ctf.BlobInfo) (*os.File, *sharedCacheEntryInfo, error) {
+	unlock, err := lockFileWithContext(ctx, c.lockPath(dig))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to lock shared cache entry %s: %w", dig, err)
+	}
+	defer unlock()
+
+	// the blob might have been added by another instance while waiting for the lock
+	if file, info, err := c.Get(dig); err == nil {
+		return file, info, nil
+	}
+
+	tmpPath, err := os.MkdirTemp(filepath.Join(c.root, sharedCacheTmpDir), dig.Encoded())
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create temporary directory in shared cache: %w", err)
+	}
+	defer os.RemoveAll(tmpPath)
+
+	blobFile, err := os.Create(filepath.Join(tmpPath, sharedCacheBlobFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create blob in shared cache: %w", err)
+	}
+	verifier := dig.Verifier()
+	_, err = res.GetBlob(ctx, io.MultiWriter(blobFile, verifier))
+	if closeErr := blobFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to resolve blueprint blob: %w", err)
+	}
+	if !verifier.Verified() {
+		return nil, nil, fmt.Errorf("the fetched blueprint blob does not match its digest %s", dig)
+	}
+
+	stat, err := os.Stat(filepath.Join(tmpPath, sharedCacheBlobFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	info := &sharedCacheEntryInfo{
+		MediaType: blobInfo.MediaType,
+		Size:      stat.Size(),
+	}
+	infoBytes, err := json.Marshal(info)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, sharedCacheInfoFile), infoBytes, os.ModePerm); err != nil {
+		return nil, nil, fmt.Errorf("unable to write blob info to shared cache: %w", err)
+	}
+
+	entryPath := c.entryPath(dig)
+	if err := os.MkdirAll(filepath.Dir(entryPath), os.ModePerm); err != nil {
+		return nil, nil, fmt.Errorf("unable to create directory in shared cache: %w", err)
+	}
+	// remove leftovers of invalid entries, the entry would be used otherwise
+	_ = os.RemoveAll(entryPath)
+	if err := os.Rename(tmpPath, entryPath); err != nil {
+		return nil, nil, fmt.Errorf("unable to add blob to shared cache: %w", err)
+	}
+
+	file, err := os.Open(filepath.Join(entryPath, sharedCacheBlobFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open shared cache entry %s: %w", dig, err)
+	}
+	return file, info, nil
+}
+
+// remove removes an entry from the cache.
+// The entry is moved to the temporary directory first so that it cannot be read partially.
+func (c *SharedCache) remove(dig digest.Digest) bool {
+	tmpPath := filepath.Join(c.root, sharedCacheTmpDir, fmt.Sprintf("%s-%d.removed", dig.Encoded(), time.Now().UnixNano()))
+	if err := os.Rename(c.entryPath(dig), tmpPath); err != nil {
+		if !os.IsNotExist(err) {
+			c.log.Error(err, "unable to remove shared cache entry", "digest", dig.String())
+		}
+		return false
+	}
+	if err := os.RemoveAll(tmpPath); err != nil {
+		c.log.Error(err, "unable to delete removed shared cache entry", "digest", dig.String())
+	}
+	return true
+}
+
+// sharedCacheEntry describes a blob in the cache for the garbage collection.
+type sharedCacheEntry struct {
+	digest     digest.Digest
+	size       int64
+	lastAccess time.Time
+}
+
+// RunGarbageCollection evicts the least recently used blobs if the usage of the cache reached the gc high threshold.
+// The garbage collection is skipped if it is currently run by another instance.
+func (c *SharedCache) RunGarbageCollection() {
+	unlock, err := lockFile(filepath.Join(c.root, sharedCacheLocksDir, sharedCacheGCLock))
+	if err != nil {
+		if !errors.Is(err, errLocked) {
+			c.log.Error(err, "unable to lock shared blueprint cache for garbage collection")
+		}
+		return
+	}
+	defer unlock()
+
+	c.removeStaleTmpFiles()
+	c.removeUnusedLockFiles()
+
+	// do not evict blobs if the size is infinite
+	if c.size == 0 {
+		return
+	}
+
+	entries, currentSize, err := c.listEntries()
+	if err != nil {
+		c.log.Error(err, "unable to list shared blueprint cache entries")
+		return
+	}
+	SharedCacheDiskUsage.Set(float64(currentSize))
+	if float64(currentSize)/float64(c.size) < c.gcHighThreshold {
+		return
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].lastAccess.Before(entries[j].lastAccess)
+	})
+	for _, entry := range entries {
+		if float64(currentSize)/float64(c.size) <= c.gcLowThreshold {
+			break
+		}
+		if c.remove(entry.digest) {
+			c.log.Debug("evicted blob from shared blueprint cache", "digest", entry.digest.String())
+			SharedCacheEvictions.Inc()
+			currentSize -= entry.size
+		}
+	}
+	SharedCacheDiskUsage.Set(float64(currentSize))
+}
+
+// listEntries returns all entries of the cache and their total size.
+func (c *SharedCache) listEntries() ([]sharedCacheEntry, int64, error) {
+	var (
+		entries   []sharedCacheEntry
+		totalSize int64
+	)
+	blobsDir := filepath.Join(c.root, sharedCacheBlobsDir)
+	algorithms, err := os.ReadDir(blobsDir)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, alg := range algorithms {
+		encodedDigests, err := os.ReadDir(filepath.Join(blobsDir, alg.Name()))
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, encoded := range encodedDigests {
+			entryPath := filepath.Join(blobsDir, alg.Name(), encoded.Name())
+			infoStat, err := os.Stat(filepath.Join(entryPath, sharedCacheInfoFile))
+			if err != nil {
+				continue
+			}
+			blobStat, err := os.Stat(filepath.Join(entryPath, sharedCacheBlobFile))
+			if err != nil {
+				continue
+			}
+			entries = append(entries, sharedCacheEntry{
+				digest:     digest.NewDigestFromEncoded(digest.Algorithm(alg.Name()), encoded.Name()),
+				size:       blobStat.Size(),
+				lastAccess: infoStat.ModTime(),
+			})
+			totalSize += blobStat.Size()
+		}
+	}
+	return entries, totalSize, nil
+}
+
+// removeStaleTmpFiles removes leftovers of interrupted downloads and removals.
+func (c *SharedCache) removeStaleTmpFiles() {
+	tmpDir := filepath.Join(c.root, sharedCacheTmpDir)
+	files, err := os.ReadDir(tmpDir)
+	if err != nil {
+		c.log.Error(err, "unable to list temporary files of shared blueprint cache")
+		return
+	}
+	for _, file := range files {
+		info, err := file.Info()
+		if err != nil || time.Since(info.ModTime()) < sharedCacheTmpMaxAge {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(tmpDir, file.Name())); err != nil {
+			c.log.Error(err, "unable to remove temporary file of shared blueprint cache", "file", file.Name())
+		}
+	}
+}
+
+// removeUnusedLockFiles removes old lock files that are currently not locked.
+func (c *SharedCache) removeUnusedLockFiles() {
+	locksDir := filepath.Join(c.root, sharedCacheLocksDir)
+	files, err := os.ReadDir(locksDir)
+	if err != nil {
+		c.log.Error(err, "unable to list lock files of shared blueprint cache")
+		return
+	}
+	for _, file := range files {
+		if file.Name() == sharedCacheGCLock {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil || time.Since(info.ModTime()) < sharedCacheTmpMaxAge {
+			continue
+		}
+		if err := removeLockFile(filepath.Join(locksDir, file.Name())); err != nil && !errors.Is(err, errLocked) && !os.IsNotExist(err) {
+			c.log.Error(err, "unable to remove lock file of shared blueprint cache", "file", file.Name())
+		}
+	}
+}
+
+func (c *SharedCache) entryPath(dig digest.Digest) string {
+	return filepath.Join(c.root, sharedCacheBlobsDir, dig.Algorithm().String(), dig.Encoded())
+}
+
+// lockPath returns the path of the lock file for a digest.
+// Unused lock files are removed by the garbage collection.
+func (c *SharedCache) lockPath(dig digest.Digest) string {
+	return filepath.Join(c.root, sharedCacheLocksDir, fmt.Sprintf("%s-%s.lock", dig.Algorithm(), dig.Encoded()))
+}
+
+// lockFileWithContext acquires an exclusive lock on the given file.
+// The lock is tried again until it is acquired or the context is done.
+func lockFileWithContext(ctx context.Context, path string) (func(), error) {
+	ticker := time.NewTicker(sharedCacheLockRetryInterval)
+	defer ticker.Stop()
+	for {
+		unlock, err := lockFile(path)
+		if !errors.Is(err, errLocked) {
+			return unlock, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package blueprints_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/testutils"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints/bputils"
+)
+
+var _ = Describe("SharedCache Locking", func() {
+
+	var (
+		cacheDir string
+		cache    *blueprints.SharedCache
+	)
+
+	newTestBlueprint := func(annotation string) ([]byte, *ctf.BlobInfo) {
+		blob, blobInfo, err := bputils.NewBuilder().Blueprint(&lsv1alpha1.Blueprint{
+			Annotations: map[string]string{
+				"test": annotation,
+			},
+		}).BuildResource(false)
+		Expect(err).ToNot(HaveOccurred())
+		defer blob.Close()
+		data := &bytes.Buffer{}
+		_, err = data.ReadFrom(blob)
+		Expect(err).ToNot(HaveOccurred())
+		return data.Bytes(), blobInfo
+	}
+
+	fetch := func(ctx context.Context, data []byte, blobInfo *ctf.BlobInfo) error {
+		res := types.Resource{}
+		res.Name = "blueprint"
+		res.Version = "0.0.1"
+		return cache.FetchAndExtractBlueprint(ctx, memoryfs.New(), "/bp",
+			testutils.NewTestResourceFromReader(&res, bytes.NewReader(data), blobInfo), blobInfo)
+	}
+
+	// holdLock locks the lock file of the given blob like another instance that shares the cache would do.
+	holdLock := func(blobInfo *ctf.BlobInfo) func() {
+		dig, err := digest.Parse(blobInfo.Digest)
+		Expect(err).ToNot(HaveOccurred())
+		path := filepath.Join(cacheDir, "locks", fmt.Sprintf("%s-%s.lock", dig.Algorithm(), dig.Encoded()))
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0666)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(syscall.Flock(int(file.Fd()), syscall.LOCK_EX)).To(Succeed())
+		return func() {
+			_ = syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+			_ = file.Close()
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = os.MkdirTemp("", "shared-bp-cache-")
+		Expect(err).ToNot(HaveOccurred())
+		cache, err = blueprints.NewSharedCache(logging.Discard(), config.SharedBlueprintCache{Path: cacheDir},
+			config.GarbageCollectionConfiguration{GCHighThreshold: 0.85, GCLowThreshold: 0.75})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("should stop waiting for a lock that is held by someone else if the context is done", func() {
+		data, blobInfo := newTestBlueprint("val")
+		unlock := holdLock(blobInfo)
+		defer unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		err := fetch(ctx, data, blobInfo)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	It("should not block blobs with other digests", func() {
+		data1, blobInfo1 := newTestBlueprint("val1")
+		data2, blobInfo2 := newTestBlueprint("val2")
+		unlock := holdLock(blobInfo1)
+		defer unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		Expect(fetch(ctx, data2, blobInfo2)).To(Succeed())
+
+		done := make(chan error)
+		go func() {
+			done <- fetch(ctx, data1, blobInfo1)
+		}()
+		Consistently(done, 300*time.Millisecond).ShouldNot(Receive())
+		unlock()
+		Eventually(done, 2*time.Second).Should(Receive(BeNil()))
+	})
+
+	It("should remove unused lock files", func() {
+		data, blobInfo := newTestBlueprint("val")
+		Expect(fetch(context.Background(), data, blobInfo)).To(Succeed())
+
+		lockFiles, err := filepath.Glob(filepath.Join(cacheDir, "locks", "sha256-*.lock"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lockFiles).To(HaveLen(1))
+		past := time.Now().Add(-2 * time.Hour)
+		Expect(os.Chtimes(lockFiles[0], past, past)).To(Succeed())
+
+		cache.RunGarbageCollection()
+		Expect(lockFiles[0]).ToNot(BeAnExistingFile())
+	})
+})
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/testutils"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints/bputils"
+)
+
+var _ = Describe("SharedCache", func() {
+
+	var (
+		ctx              context.Context
+		cacheDir         string
+		storeConfig      config.BlueprintStore
+		newTestBlueprint func(annotation string) ([]byte, *ctf.BlobInfo)
+		newTestResource  func(data []byte, blobInfo *ctf.BlobInfo) model.Resource
+		entryPath        func(blobInfo *ctf.BlobInfo) string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		cacheDir, err = os.MkdirTemp("", "shared-bp-cache-")
+		Expect(err).ToNot(HaveOccurred())
+
+		cs := v1alpha1.BlueprintStore{}
+		v1alpha1.SetDefaults_BlueprintStore(&cs)
+		Expect(v1alpha1.Convert_v1alpha1_BlueprintStore_To_config_BlueprintStore(&cs, &storeConfig, nil)).To(Succeed())
+		storeConfig.IndexMethod = config.BlueprintDigestIndex
+		storeConfig.SharedCache = &config.SharedBlueprintCache{
+			Path: cacheDir,
+		}
+
+		newTestBlueprint = func(annotation string) ([]byte, *ctf.BlobInfo) {
+			blob, blobInfo, err := bputils.NewBuilder().Blueprint(&lsv1alpha1.Blueprint{
+				Annotations: map[string]string{
+					"test": annotation,
+				},
+			}).BuildResource(false)
+			Expect(err).ToNot(HaveOccurred())
+			defer blob.Close()
+			data, err := io.ReadAll(blob)
+			Expect(err).ToNot(HaveOccurred())
+			return data, blobInfo
+		}
+		newTestResource = func(data []byte, blobInfo *ctf.BlobInfo) model.Resource {
+			res := types.Resource{}
+			res.Name = "blueprint"
+			res.Version = "0.0.1"
+			return testutils.NewTestResourceFromReader(&res, bytes.NewReader(data), blobInfo)
+		}
+		entryPath = func(blobInfo *ctf.BlobInfo) string {
+			dig, err := digest.Parse(blobInfo.Digest)
+			Expect(err).ToNot(HaveOccurred())
+			return filepath.Join(cacheDir, "blobs", dig.Algorithm().String(), dig.Encoded())
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("should read a blueprint that was stored by another store instance from the shared cache", func() {
+		data, blobInfo := newTestBlueprint("val")
+
+		store1, err := blueprints.NewStore(logging.Discard(), memoryfs.New(), storeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		defer store1.Close()
+		_, err = store1.Store(ctx, newTestResource(data, blobInfo), blobInfo.Digest, blobInfo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(entryPath(blobInfo), "blob")).To(BeAnExistingFile())
+
+		// the second store must not download the blob as the resource provides no data
+		store2, err := blueprints.NewStore(logging.Discard(), memoryfs.New(), storeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		defer store2.Close()
+		bp, err := store2.Store(ctx, newTestResource(nil, blobInfo), blobInfo.Digest, blobInfo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bp.Info.Annotations).To(HaveKeyWithValue("test", "val"))
+	})
+
+	It("should detect a corrupted blob and fetch it again", func() {
+		data, blobInfo := newTestBlueprint("val")
+
+		store1, err := blueprints.NewStore(logging.Discard(), memoryfs.New(), storeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		defer store1.Close()
+		_, err = store1.Store(ctx, newTestResource(data, blobInfo), blobInfo.Digest, blobInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		blobPath := filepath.Join(entryPath(blobInfo), "blob")
+		Expect(os.WriteFile(blobPath, []byte("corrupted"), os.ModePerm)).To(Succeed())
+
+		store2, err := blueprints.NewStore(logging.Discard(), memoryfs.New(), storeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		defer store2.Close()
+		bp, err := store2.Store(ctx, newTestResource(data, blobInfo), blobInfo.Digest, blobInfo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bp.Info.Annotations).To(HaveKeyWithValue("test", "val"))
+
+		cached, err := os.ReadFile(blobPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cached).To(Equal(data))
+	})
+
+	It("should not cache a blob that does not match its digest", func() {
+		_, blobInfo := newTestBlueprint("val")
+
+		store, err := blueprints.NewStore(logging.Discard(), memoryfs.New(), storeConfig)
+		Expect(err).ToNot(HaveOccurred())
+		defer store.Close()
+		_, err = store.Store(ctx, newTestResource([]byte("invalid"), blobInfo), blobInfo.Digest, blobInfo)
+		Expect(err).To(HaveOccurred())
+		Expect(entryPath(blobInfo)).ToNot(BeADirectory())
+	})
+
+	It("should evict the least recently used blobs if the size limit is reached", func() {
+		data1, blobInfo1 := newTestBlueprint("val1")
+		data2, blobInfo2 := newTestBlueprint("val2")
+		Expect(data1).To(HaveLen(len(data2)))
+
+		// only one of the blobs fits into the cache
+		cacheConfig := config.SharedBlueprintCache{
+			Path: cacheDir,
+			Size: fmt.Sprintf("%d", len(data1)*3/2),
+		}
+		cache, err := blueprints.NewSharedCache(logging.Discard(), cacheConfig, storeConfig.GarbageCollectionConfiguration)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cache.FetchAndExtractBlueprint(ctx, memoryfs.New(), "/bp1", newTestResource(data1, blobInfo1), blobInfo1)).To(Succeed())
+		cache.RunGarbageCollection()
+		Expect(entryPath(blobInfo1)).To(BeADirectory())
+		past := time.Now().Add(-time.Hour)
+		Expect(os.Chtimes(filepath.Join(entryPath(blobInfo1), "info.json"), past, past)).To(Succeed())
+
+		Expect(cache.FetchAndExtractBlueprint(ctx, memoryfs.New(), "/bp2", newTestResource(data2, blobInfo2), blobInfo2)).To(Succeed())
+		Eventually(func() string {
+			cache.RunGarbageCollection()
+			return entryPath(blobInfo1)
+		}, 5*time.Second, 100*time.Millisecond).ShouldNot(BeADirectory())
+		Expect(entryPath(blobInfo2)).To(BeADirectory())
+	})
+
+})
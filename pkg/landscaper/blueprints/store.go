@@ -87,6 +87,9 @@ type Store struct {
 	// It calculated by using the max size and the current size.
 	usage float64
 
+	// sharedCache is an optional cache for the blueprint blobs that is shared with other landscaper instances.
+	sharedCache *SharedCache
+
 	gcConfig      config.GarbageCollectionConfiguration
 	resetStopChan chan struct{}
 	closed        bool
@@ -120,6 +123,13 @@ func NewStore(log logging.Logger, baseFs vfs.FileSystem, config config.Blueprint
 		gcConfig:    config.GarbageCollectionConfiguration,
 	}
 
+	if config.SharedCache != nil && !config.DisableCache {
+		store.sharedCache, err = NewSharedCache(log, *config.SharedCache, config.GarbageCollectionConfiguration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if config.Size != "0" {
 		quantity, err := resource.ParseQuantity(config.Size)
 		if err != nil {
@@ -219,7 +229,11 @@ func (s *Store) Store(ctx context.Context, resource model.Resource, blueprintID
 			return nil, fmt.Errorf("unable to get blob info: %w", err)
 		}
 	}
-	if err := FetchAndExtractBlueprint(ctx, s.fs, bpPath, resource, blobInfo); err != nil {
+	if s.sharedCache != nil {
+		if err := s.sharedCache.FetchAndExtractBlueprint(ctx, s.fs, bpPath, resource, blobInfo); err != nil {
+			return nil, err
+		}
+	} else if err := FetchAndExtractBlueprint(ctx, s.fs, bpPath, resource, blobInfo); err != nil {
 		return nil, err
 	}
 
@@ -267,9 +281,20 @@ func FetchAndExtractBlueprint(
 		return pw.Close()
 	})
 
-	var blobReader io.Reader = pr
+	if err := ExtractBlueprint(ctx, fs, bpPath, mediaType, pr); err != nil {
+		return err
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExtractBlueprint extracts a blueprint blob of the given media type to the given path.
+func ExtractBlueprint(ctx context.Context, fs vfs.FileSystem, bpPath string, mediaType mediatype.MediaType, blob io.Reader) error {
+	var blobReader = blob
 	if mediaType.String() == mediatype.MediaTypeGZip || mediaType.IsCompressed(mediatype.GZipCompression) {
-		gr, err := gzip.NewReader(pr)
+		gr, err := gzip.NewReader(blob)
 		if err != nil {
 			if err == gzip.ErrHeader {
 				return errors.New("expected a gzip compressed tar")
@@ -286,9 +311,6 @@ func FetchAndExtractBlueprint(
 	if err := tar.ExtractTar(ctx, blobReader, fs, tar.ToPath(bpPath), tar.Overwrite(true)); err != nil {
 		return fmt.Errorf("unable to extract blueprint from blob: %w", err)
 	}
-	if err := eg.Wait(); err != nil {
-		return err
-	}
 	return nil
 }
 
@@ -6,8 +6,10 @@ package context
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
 	corev1 "k8s.io/api/core/v1"
@@ -52,6 +54,7 @@ func (c *defaulterController) Reconcile(ctx context.Context, req reconcile.Reque
 	if c.excludeNamespaces.Has(req.Name) {
 		return reconcile.Result{}, nil
 	}
+	defer metrics.ObserveReconcileDuration(metrics.ContextController, time.Now())
 
 	logger := c.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
@@ -77,6 +80,7 @@ func (c *defaulterController) Reconcile(ctx context.Context, req reconcile.Reque
 		}
 		return nil
 	}); err != nil {
+		metrics.RecordError(metrics.ContextController, err)
 		return reconcile.Result{}, err
 	}
 	return reconcile.Result{}, nil
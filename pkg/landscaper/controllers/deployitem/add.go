@@ -6,13 +6,16 @@ package deployitem
 
 import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/go-logr/logr"
 
 	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 
 	lscore "github.com/gardener/landscaper/apis/core"
@@ -37,8 +40,36 @@ func AddControllerToManager(logger logging.Logger,
 	}
 
 	return builder.ControllerManagedBy(mgr).
-		For(&lsv1alpha1.DeployItem{}).
+		For(&lsv1alpha1.DeployItem{}, builder.WithPredicates(phaseTransitionRecorder())).
 		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.CommonControllerConfig)).
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
 		Complete(a)
 }
+
+// phaseTransitionRecorder returns a predicate that records the phase transitions and errors of deploy items as metrics.
+// The phases and errors of deploy items are set by the deployers, so they are observed on the update events.
+// The predicate does not filter any events.
+func phaseTransitionRecorder() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldDi, ok := e.ObjectOld.(*lsv1alpha1.DeployItem)
+			if !ok {
+				return true
+			}
+			newDi, ok := e.ObjectNew.(*lsv1alpha1.DeployItem)
+			if !ok {
+				return true
+			}
+			if oldDi.Status.Phase != newDi.Status.Phase {
+				metrics.RecordDeployItemPhase(newDi)
+			}
+			if newErr := newDi.Status.GetLastError(); newErr != nil {
+				oldErr := oldDi.Status.GetLastError()
+				if oldErr == nil || !oldErr.LastUpdateTime.Equal(&newErr.LastUpdateTime) {
+					metrics.RecordLastError(metrics.DeployItemController, newErr)
+				}
+			}
+			return true
+		},
+	}
+}
@@ -19,10 +19,12 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	defer metrics.ObserveReconcileDuration(metrics.DeployItemController, time.Now())
 	logger := con.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
 
@@ -40,14 +42,25 @@ func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (re
 		return reconcile.Result{}, nil
 	}
 
+	timeouts, err := con.getEffectiveTimeouts(ctx, di)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := con.updateEffectiveTimeouts(ctx, di, timeouts); err != nil {
+		logger.Error(err, "unable to update effective timeouts of deployitem")
+		return reconcile.Result{}, err
+	}
+	pickupTimeout := timeouts.Pickup.Duration
+	progressingTimeout := timeouts.Progressing.Duration
+
 	// check pickup timeout
 	if !HasBeenPickedUp(di) {
-		if con.pickupTimeout != 0 {
+		if pickupTimeout != 0 {
 			logger.Debug("check for pickup timeout")
 
-			exceeded, requeue := con.isPickupTimeoutExceeded(di)
+			exceeded, requeue := con.isPickupTimeoutExceeded(di, pickupTimeout)
 			if exceeded {
-				err := con.writePickupTimeoutExceeded(ctx, di)
+				err := con.writePickupTimeoutExceeded(ctx, di, pickupTimeout)
 				// if there was a pickup timeout, no need to check for anything else
 				return reconcile.Result{}, err
 			}
@@ -63,17 +76,10 @@ func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (re
 
 	// check progressing timeout
 	// only do something if progressing timeout detection is neither deactivated on the deploy item,
-	// nor defaulted by the deploy item and deactivated by default
-	if !((di.Spec.Timeout != nil && di.Spec.Timeout.Duration == 0) || (di.Spec.Timeout == nil && con.defaultTimeout == 0)) {
+	// nor defaulted by the context or the configuration and deactivated there
+	if progressingTimeout != 0 {
 		logger.Debug("check for progressing timeout")
 
-		var progressingTimeout time.Duration
-		if di.Spec.Timeout == nil { // timeout not specified in deploy item, use global default
-			progressingTimeout = con.defaultTimeout
-		} else {
-			progressingTimeout = di.Spec.Timeout.Duration
-		}
-
 		exceeded, requeue, err := con.isProgressingTimeoutExceeded(ctx, di, progressingTimeout)
 		if err != nil {
 			return reconcile.Result{}, err
@@ -93,22 +99,22 @@ func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (re
 	return reconcile.Result{}, nil
 }
 
-func (con *controller) isPickupTimeoutExceeded(di *lsv1alpha1.DeployItem) (bool, *time.Duration) {
+func (con *controller) isPickupTimeoutExceeded(di *lsv1alpha1.DeployItem, pickupTimeout time.Duration) (bool, *time.Duration) {
 	waitingForPickupDuration := time.Duration(0)
 	if di.Status.JobIDGenerationTime != nil {
 		waitingForPickupDuration = time.Since(di.Status.JobIDGenerationTime.Time)
 	}
-	if waitingForPickupDuration >= con.pickupTimeout {
+	if waitingForPickupDuration >= pickupTimeout {
 		return true, nil
 	}
 
 	// deploy item neither picked up nor timed out
 	// => requeue shortly after expected timeout
-	requeue := con.pickupTimeout - waitingForPickupDuration + (5 * time.Second)
+	requeue := pickupTimeout - waitingForPickupDuration + (5 * time.Second)
 	return false, &requeue
 }
 
-func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1alpha1.DeployItem) error {
+func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1alpha1.DeployItem, pickupTimeout time.Duration) error {
 	// no deployer has picked up the deploy item within the timeframe
 	// => pickup timeout
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
@@ -121,7 +127,7 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 	lsutil.SetLastError(&di.Status, lserrors.UpdatedError(di.Status.GetLastError(),
 		lsv1alpha1.PickupTimeoutOperation,
 		lsv1alpha1.PickupTimeoutReason,
-		fmt.Sprintf("no deployer has reconciled this deployitem within %d seconds", con.pickupTimeout/time.Second),
+		fmt.Sprintf("no deployer has reconciled this deployitem within %d seconds", pickupTimeout/time.Second),
 		lsv1alpha1.ErrorTimeout,
 	))
 
@@ -130,6 +136,8 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		return err
 	}
 
+	metrics.RecordDeployItemTimeout(lsv1alpha1.PickupTimeoutReason)
+
 	return nil
 }
 
@@ -179,5 +187,7 @@ func (con *controller) writeProgressingTimeoutExceeded(ctx context.Context, di *
 		return err
 	}
 
+	metrics.RecordDeployItemTimeout(lsv1alpha1.ProgressingTimeoutReason)
+
 	return nil
 }
@@ -103,8 +103,18 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 		Expect(di.Status.LastReconcileTime).NotTo(BeNil())
 		old := di.DeepCopy()
 
-		// reconcile with deploy item controller should not do anything to the deploy item
-		By("Verify that deploy item controller doesn't change anything if no timeout occurred")
+		// reconcile with deploy item controller should only store the effective timeouts in the deploy item
+		By("Verify that deploy item controller doesn't change anything but the effective timeouts if no timeout occurred")
+		testutils.ShouldReconcile(ctx, deployItemController, diReq)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		Expect(di.Status.EffectiveTimeouts).To(Equal(&lsv1alpha1.EffectiveDeployItemTimeouts{
+			Pickup:      &lsv1alpha1.Duration{Duration: testPickupTimeoutDuration.Duration},
+			Progressing: &lsv1alpha1.Duration{Duration: testProgressingTimeoutDuration.Duration},
+		}))
+		old.Status.EffectiveTimeouts = di.Status.EffectiveTimeouts
+		old.ResourceVersion = di.ResourceVersion
+		Expect(di).To(Equal(old))
+
 		testutils.ShouldReconcile(ctx, deployItemController, diReq)
 		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
 		Expect(di).To(Equal(old))
@@ -228,4 +238,49 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 		Expect(di.Status.LastError.Codes).To(ContainElement(lsv1alpha1.ErrorTimeout))
 		Expect(utils2.IsDeployItemJobIDsIdentical(di)).To(BeTrue())
 	})
+
+	It("Should prefer the timeouts of the context over the default", func() {
+		ctx := context.Background()
+		defer ctx.Done()
+
+		var err error
+		state, err = testenv.InitResources(ctx, testdataDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		By("Create a context with a longer progressing timeout")
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = "slow"
+		lsCtx.Namespace = state.Namespace
+		lsCtx.DeployItemTimeouts = &lsv1alpha1.DeployItemTimeouts{
+			ProgressingDefault: &lsv1alpha1.Duration{Duration: time.Hour},
+		}
+		utils.ExpectNoError(state.Create(ctx, lsCtx))
+
+		By("Prepare test deploy items")
+		di := &lsv1alpha1.DeployItem{}
+		diReq := testutils.Request("mock-di-prog", state.Namespace)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		di.Spec.Context = lsCtx.Name
+		utils.ExpectNoError(testenv.Client.Update(ctx, di))
+		Expect(testutils.UpdateJobIdForDeployItem(ctx, testenv, di, metav1.Now())).ToNot(HaveOccurred())
+
+		testutils.ShouldReconcile(ctx, mockController, diReq)
+		testutils.ShouldReconcile(ctx, mockController, diReq)
+
+		By("Set timed out LastReconcileTime timestamp (using default timeout duration)")
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		timedOut := metav1.Time{Time: time.Now().Add(-(testProgressingTimeoutDuration.Duration + (5 * time.Second)))}
+		di.Status.LastReconcileTime = &timedOut
+		di.Status.JobIDGenerationTime = &timedOut
+		utils.ExpectNoError(testenv.Client.Status().Update(ctx, di))
+
+		By("Verify that deploy item is not timed out")
+		testutils.ShouldReconcile(ctx, deployItemController, diReq)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		Expect(utils2.IsDeployItemPhase(di, lsv1alpha1.DeployItemPhases.Progressing)).To(BeTrue())
+		Expect(di.Status.EffectiveTimeouts).To(Equal(&lsv1alpha1.EffectiveDeployItemTimeouts{
+			Pickup:      &lsv1alpha1.Duration{Duration: testPickupTimeoutDuration.Duration},
+			Progressing: &lsv1alpha1.Duration{Duration: time.Hour},
+		}))
+	})
 })
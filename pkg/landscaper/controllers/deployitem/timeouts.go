@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployitem
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// getEffectiveTimeouts returns the pickup and progressing timeout of a deploy item.
+// The timeouts of the landscaper configuration are overwritten by the timeouts of the context of the deploy item,
+// and the progressing timeout is overwritten by the timeout in the spec of the deploy item.
+// A timeout of zero means that the timeout detection is disabled.
+func (con *controller) getEffectiveTimeouts(ctx context.Context, di *lsv1alpha1.DeployItem) (*lsv1alpha1.EffectiveDeployItemTimeouts, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	pickupTimeout := con.pickupTimeout
+	progressingTimeout := con.defaultTimeout
+
+	if len(di.Spec.Context) != 0 {
+		lsCtx := &lsv1alpha1.Context{}
+		if err := con.c.Get(ctx, kutil.ObjectKey(di.Spec.Context, di.Namespace), lsCtx); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to get context %q of deploy item: %w", di.Spec.Context, err)
+			}
+			logger.Debug("context of deploy item not found, using default timeouts", lc.KeyResource, di.Spec.Context)
+		} else if lsCtx.DeployItemTimeouts != nil {
+			if lsCtx.DeployItemTimeouts.Pickup != nil {
+				pickupTimeout = lsCtx.DeployItemTimeouts.Pickup.Duration
+			}
+			if lsCtx.DeployItemTimeouts.ProgressingDefault != nil {
+				progressingTimeout = lsCtx.DeployItemTimeouts.ProgressingDefault.Duration
+			}
+		}
+	}
+
+	if di.Spec.Timeout != nil {
+		progressingTimeout = di.Spec.Timeout.Duration
+	}
+
+	return &lsv1alpha1.EffectiveDeployItemTimeouts{
+		Pickup:      &lsv1alpha1.Duration{Duration: pickupTimeout},
+		Progressing: &lsv1alpha1.Duration{Duration: progressingTimeout},
+	}, nil
+}
+
+// updateEffectiveTimeouts stores the effective timeouts in the status of the deploy item if they have changed.
+func (con *controller) updateEffectiveTimeouts(ctx context.Context, di *lsv1alpha1.DeployItem, timeouts *lsv1alpha1.EffectiveDeployItemTimeouts) error {
+	if equalTimeouts(di.Status.EffectiveTimeouts, timeouts) {
+		return nil
+	}
+
+	di.Status.EffectiveTimeouts = timeouts
+	return con.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000163, di)
+}
+
+func equalTimeouts(a, b *lsv1alpha1.EffectiveDeployItemTimeouts) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return durationValue(a.Pickup) == durationValue(b.Pickup) && durationValue(a.Progressing) == durationValue(b.Progressing)
+}
+
+func durationValue(d *lsv1alpha1.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.Duration
+}
@@ -26,6 +26,7 @@ func AddControllerToManager(logger logging.Logger, mgr manager.Manager, config c
 		mgr.GetClient(),
 		mgr.GetScheme(),
 		mgr.GetEventRecorderFor("Landscaper"),
+		config.MaxParallelDeployItemsPerTarget,
 	)
 	if err != nil {
 		return err
@@ -7,6 +7,8 @@ package execution
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,17 +25,21 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 // NewController creates a new execution controller that reconcile Execution resources.
-func NewController(logger logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder) (reconcile.Reconciler, error) {
+// The maximum number of parallel deploy items per target is optional; nil means that the number is not limited.
+func NewController(logger logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, eventRecorder record.EventRecorder,
+	maxParallelDeployItemsPerTarget *int32) (reconcile.Reconciler, error) {
 	return &controller{
-		log:           logger,
-		client:        kubeClient,
-		scheme:        scheme,
-		eventRecorder: eventRecorder,
+		log:                             logger,
+		client:                          kubeClient,
+		scheme:                          scheme,
+		eventRecorder:                   eventRecorder,
+		maxParallelDeployItemsPerTarget: maxParallelDeployItemsPerTarget,
 	}, nil
 }
 
@@ -42,9 +48,12 @@ type controller struct {
 	client        client.Client
 	eventRecorder record.EventRecorder
 	scheme        *runtime.Scheme
+
+	maxParallelDeployItemsPerTarget *int32
 }
 
 func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	defer metrics.ObserveReconcileDuration(metrics.ExecutionController, time.Now())
 	logger := c.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
 
@@ -102,6 +111,7 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000105, exec); err != nil {
 			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
 		}
+		metrics.RecordExecutionPhase(exec)
 	}
 
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Init {
@@ -123,12 +133,29 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000133)
 		}
 
-		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
+		if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasItemsWaitingForRetry() &&
+			deployItemClassification.HasFailedItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000134)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			!deployItemClassification.HasItemsAwaitingApproval() && !deployItemClassification.HasItemsWaitingForRetry() &&
+			!deployItemClassification.HasThrottledItems() && deployItemClassification.HasDryRunItems() {
+			// nothing has been deployed by the dry-run items, so that the execution must neither succeed nor export data
+			msg := fmt.Sprintf("deploy items %s have only performed a dry-run",
+				strings.Join(deployItemClassification.GetNamesOfDryRunItems(), ", "))
+			err = lserrors.NewError(op, "handlePhaseProgressing", msg, lsv1alpha1.ErrorForInfoOnly)
+			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000166)
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			!deployItemClassification.HasItemsAwaitingApproval() && !deployItemClassification.HasItemsWaitingForRetry() &&
+			!deployItemClassification.HasThrottledItems() && deployItemClassification.HasPendingItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "items could not be started", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000135)
+		} else if !deployItemClassification.HasRunningItems() && deployItemClassification.HasItemsAwaitingApproval() {
+			// remain in progressing until the items are approved
+			msg := fmt.Sprintf("waiting for the approval of deploy items %s",
+				strings.Join(deployItemClassification.GetNamesOfItemsAwaitingApproval(), ", "))
+			err = lserrors.NewError(op, "handlePhaseProgressing", msg, lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly)
+			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000160)
 		} else if !deployItemClassification.AllSucceeded() {
 			// remain in progressing in all other cases
 			err = lserrors.NewError(op, "handlePhaseProgressing", "some running items", lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly)
@@ -178,7 +205,8 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
 			err = lserrors.NewError(op, "handlePhaseDeleting", "has failed items")
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.DeleteFailed, err, read_write_layer.W000143)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			!deployItemClassification.HasThrottledItems() && deployItemClassification.HasPendingItems() {
 			err = lserrors.NewError(op, "handlePhaseDeleting", "has pending items")
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.DeleteFailed, err, read_write_layer.W000144)
 		}
@@ -203,7 +231,8 @@ func (c *controller) handlePhaseInit(ctx context.Context, exec *lsv1alpha1.Execu
 func (c *controller) handlePhaseProgressing(ctx context.Context, exec *lsv1alpha1.Execution) (
 	*execution.DeployItemClassification, lserrors.LsError) {
 	forceReconcile := false
-	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
+	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile).
+		SetMaxParallelDeployItemsPerTarget(c.maxParallelDeployItemsPerTarget)
 
 	return o.TriggerDeployItems(ctx)
 }
@@ -252,7 +281,8 @@ func (c *controller) handlePhaseInitDelete(ctx context.Context, exec *lsv1alpha1
 func (c *controller) handlePhaseDeleting(ctx context.Context, exec *lsv1alpha1.Execution) (
 	*execution.DeployItemClassification, lserrors.LsError) {
 	forceReconcile := false
-	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
+	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile).
+		SetMaxParallelDeployItemsPerTarget(c.maxParallelDeployItemsPerTarget)
 
 	return o.TriggerDeployItemsForDelete(ctx)
 }
@@ -304,8 +334,10 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	exec.Status.LastError = lserrors.TryUpdateLsError(exec.Status.LastError, lsErr)
+	metrics.RecordError(metrics.ExecutionController, lsErr)
 
-	if phase != exec.Status.ExecutionPhase {
+	phaseChanged := phase != exec.Status.ExecutionPhase
+	if phaseChanged {
 		now := metav1.Now()
 		exec.Status.PhaseTransitionTime = &now
 	}
@@ -332,6 +364,11 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		if lsErr == nil {
 			return lserrors.NewWrappedError(err, "setExecutionPhaseAndUpdate", "UpdateExecutionStatus", err.Error())
 		}
+		return lsErr
+	}
+
+	if phaseChanged {
+		metrics.RecordExecutionPhase(exec)
 	}
 
 	return lsErr
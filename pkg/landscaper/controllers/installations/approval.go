@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/landscaper/execution"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// updateApprovalCondition propagates the approval conditions of the execution and the subinstallations of an
+// installation to the installation. This way, the condition of a root installation lists all deploy items
+// of the installation tree that wait for an approval.
+func (c *Controller) updateApprovalCondition(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	currOp := "UpdateApprovalCondition"
+
+	messages := []string{}
+	if ref := inst.Status.ExecutionReference; ref != nil {
+		exec := &lsv1alpha1.Execution{}
+		if err := read_write_layer.GetExecution(ctx, c.Client(), ref.NamespacedName(), exec); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return lserrors.NewWrappedError(err, currOp, "GetExecution", err.Error())
+			}
+		} else if msg, ok := approvalPendingMessage(exec.Status.Conditions); ok {
+			messages = append(messages, msg)
+		}
+	}
+	for _, ref := range inst.Status.InstallationReferences {
+		subInst := &lsv1alpha1.Installation{}
+		if err := read_write_layer.GetInstallation(ctx, c.Client(), ref.Reference.NamespacedName(), subInst); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return lserrors.NewWrappedError(err, currOp, "GetSubinstallation", err.Error())
+			}
+		} else if msg, ok := approvalPendingMessage(subInst.Status.Conditions); ok {
+			messages = append(messages, msg)
+		}
+	}
+
+	cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.ApprovalPendingCondition)
+	var newCond lsv1alpha1.Condition
+	if len(messages) != 0 {
+		newCond = lsv1alpha1helper.UpdatedCondition(lsv1alpha1helper.GetOrInitCondition(inst.Status.Conditions, lsv1alpha1.ApprovalPendingCondition),
+			lsv1alpha1.ConditionTrue, execution.ApprovalPendingReason, strings.Join(messages, "; "))
+	} else {
+		if cond == nil || cond.Status == lsv1alpha1.ConditionFalse {
+			return nil
+		}
+		newCond = lsv1alpha1helper.UpdatedCondition(*cond, lsv1alpha1.ConditionFalse, execution.NoApprovalPendingReason,
+			"no deploy items wait for an approval")
+	}
+
+	if cond != nil && cond.Status == newCond.Status && cond.Message == newCond.Message {
+		return nil
+	}
+	inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions, newCond)
+	if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000159, inst); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "UpdateInstallationStatus", err.Error())
+	}
+	return nil
+}
+
+// approvalPendingMessage returns the message of the approval condition if deploy items wait for an approval.
+func approvalPendingMessage(conditions []lsv1alpha1.Condition) (string, bool) {
+	cond := lsv1alpha1helper.GetCondition(conditions, lsv1alpha1.ApprovalPendingCondition)
+	if cond == nil || cond.Status != lsv1alpha1.ConditionTrue {
+		return "", false
+	}
+	return cond.Message, true
+}
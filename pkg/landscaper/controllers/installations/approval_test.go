@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Approval Condition", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		ctrl       reconcile.Reconciler
+		inst       *lsv1alpha1.Installation
+		subInst    *lsv1alpha1.Installation
+		exec       *lsv1alpha1.Execution
+	)
+
+	setApprovalCondition := func(obj client.Object, conditions *[]lsv1alpha1.Condition, status lsv1alpha1.ConditionStatus, msg string) {
+		cond := lsv1alpha1helper.GetOrInitCondition(*conditions, lsv1alpha1.ApprovalPendingCondition)
+		*conditions = lsv1alpha1helper.MergeConditions(*conditions,
+			lsv1alpha1helper.UpdatedCondition(cond, status, "test", msg))
+		Expect(kubeClient.Status().Update(ctx, obj)).To(Succeed())
+	}
+
+	getApprovalCondition := func() *lsv1alpha1.Condition {
+		res := &lsv1alpha1.Installation{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(inst), res)).To(Succeed())
+		return lsv1alpha1helper.GetCondition(res.Status.Conditions, lsv1alpha1.ApprovalPendingCondition)
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+
+		exec = &lsv1alpha1.Execution{}
+		exec.Name = "root"
+		exec.Namespace = "default"
+
+		subInst = &lsv1alpha1.Installation{}
+		subInst.Name = "sub"
+		subInst.Namespace = "default"
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = "default"
+		inst.Finalizers = []string{lsv1alpha1.LandscaperFinalizer}
+		inst.Status.JobID = "01"
+		inst.Status.JobIDFinished = "01"
+		inst.Status.ExecutionReference = &lsv1alpha1.ObjectReference{Name: exec.Name, Namespace: exec.Namespace}
+		inst.Status.InstallationReferences = []lsv1alpha1.NamedObjectReference{
+			{Name: "sub", Reference: lsv1alpha1.ObjectReference{Name: subInst.Name, Namespace: subInst.Namespace}},
+		}
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst, subInst, exec).Build()
+		op := lsoperation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024))
+		ctrl = installationsctl.NewTestActuator(*op, logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{})
+	})
+
+	It("should propagate the approval conditions of the execution and the subinstallations", func() {
+		setApprovalCondition(exec, &exec.Status.Conditions, lsv1alpha1.ConditionTrue, "item a waits")
+		setApprovalCondition(subInst, &subInst.Status.Conditions, lsv1alpha1.ConditionTrue, "item b waits")
+
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(inst)})
+		Expect(err).ToNot(HaveOccurred())
+		cond := getApprovalCondition()
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+		Expect(cond.Message).To(Equal("item a waits; item b waits"))
+
+		setApprovalCondition(exec, &exec.Status.Conditions, lsv1alpha1.ConditionFalse, "")
+		_, err = ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(inst)})
+		Expect(err).ToNot(HaveOccurred())
+		cond = getApprovalCondition()
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+		Expect(cond.Message).To(Equal("item b waits"))
+
+		setApprovalCondition(subInst, &subInst.Status.Conditions, lsv1alpha1.ConditionFalse, "")
+		_, err = ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(inst)})
+		Expect(err).ToNot(HaveOccurred())
+		cond = getApprovalCondition()
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+	})
+
+	It("should not set the approval condition if no deploy items wait for an approval", func() {
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(inst)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getApprovalCondition()).To(BeNil())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
 	"github.com/google/uuid"
@@ -34,6 +35,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -91,6 +93,7 @@ type Controller struct {
 }
 
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	defer metrics.ObserveReconcileDuration(metrics.InstallationController, time.Now())
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
 
 	inst := &lsv1alpha1.Installation{}
@@ -163,6 +166,17 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.PlanOperation) && inst.DeletionTimestamp.IsZero() {
+		if inst.Status.JobID == inst.Status.JobIDFinished {
+			if err := c.handlePlanOperation(ctx, inst); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{}, nil
+		}
+		// the plan is computed as soon as the current job is finished
+		logger.Info("Postponing plan operation until the current job is finished")
+	}
+
 	if !installations.IsRootInstallation(inst) && lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
 		// only root installations could be triggered with operation annotation to prevent that end users interfere with overall
 		// algorithm
@@ -175,11 +189,30 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 
 	// generate new jobID
 	isFirstDelete := !inst.DeletionTimestamp.IsZero() && !inst.Status.InstallationPhase.IsDeletion()
+	hasReconcileOperation := lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	if inst.Status.MaintenanceStatus != nil && !hasReconcileOperation && !isFirstDelete {
+		// the postponed reconcile is not requested anymore
+		if err := c.resetMaintenanceStatus(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if installations.IsRootInstallation(inst) &&
-		(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) || isFirstDelete) &&
+		(hasReconcileOperation || isFirstDelete) &&
 		inst.Status.JobID == inst.Status.JobIDFinished {
 
+		if !isFirstDelete {
+			// deletions are not restricted by the maintenance policy
+			allowed, result, err := c.checkMaintenancePolicy(ctx, inst)
+			if !allowed || err != nil {
+				return result, err
+			}
+		}
+
+		now := metav1.Now()
 		inst.Status.JobID = uuid.New().String()
+		inst.Status.JobIDGenerationTime = &now
+		inst.Status.MaintenanceStatus = nil
 		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000082, inst); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -191,6 +224,10 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if err := c.updateApprovalCondition(ctx, inst); err != nil {
+		return utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+	}
+
 	// handle reconcile
 	if inst.Status.JobID != inst.Status.JobIDFinished {
 		err := c.handleReconcilePhase(ctx, inst)
@@ -300,13 +337,15 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		lc.KeyMethod, op)
 
 	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
+	metrics.RecordError(metrics.InstallationController, lsError)
 
 	if inst.Status.LastError != nil {
 		lastErr := inst.Status.LastError
 		c.EventRecorder().Event(inst, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
 	}
 
-	if phase != inst.Status.InstallationPhase {
+	phaseChanged := phase != inst.Status.InstallationPhase
+	if phaseChanged {
 		now := metav1.Now()
 		inst.Status.PhaseTransitionTime = &now
 	}
@@ -349,5 +388,9 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		return lsError
 	}
 
+	if phaseChanged {
+		metrics.RecordInstallationPhase(inst)
+	}
+
 	return lsError
 }
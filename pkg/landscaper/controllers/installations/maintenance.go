@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// maintenanceRecheckInterval is the maximal time after which a postponed reconcile is checked again,
+// as changes of the maintenance policy of a context do not trigger a reconcile of its installations.
+const maintenanceRecheckInterval = 10 * time.Minute
+
+// checkMaintenancePolicy checks whether the maintenance policy of a root installation allows to start a new job.
+// If the job is postponed, this is recorded in the status of the installation and the returned result
+// requeues the installation.
+func (c *Controller) checkMaintenancePolicy(ctx context.Context, inst *lsv1alpha1.Installation) (bool, reconcile.Result, error) {
+	currOp := "CheckMaintenancePolicy"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	var lsCtx *lsv1alpha1.Context
+	if len(inst.Spec.Context) != 0 {
+		lsCtx = &lsv1alpha1.Context{}
+		if err := c.Client().Get(ctx, kutil.ObjectKey(inst.Spec.Context, inst.Namespace), lsCtx); err != nil {
+			return false, reconcile.Result{}, lserrors.NewWrappedError(err, currOp, "GetContext", err.Error())
+		}
+	}
+
+	now := c.clock.Now()
+	status, err := installations.CheckMaintenancePolicy(installations.GetMaintenancePolicy(inst, lsCtx), now)
+	if err != nil {
+		return false, reconcile.Result{}, lserrors.NewWrappedError(err, currOp, "CheckMaintenanceWindows", err.Error(),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+	if status == nil {
+		return true, reconcile.Result{}, nil
+	}
+
+	old := inst.Status.MaintenanceStatus
+	if old == nil {
+		status.PendingSince = metav1.NewTime(now)
+	} else {
+		status.PendingSince = old.PendingSince
+	}
+
+	if !maintenanceStatusEqual(old, status) {
+		if old == nil {
+			logger.Info(status.Message, "reason", string(status.Reason))
+			c.EventRecorder().Event(inst, corev1.EventTypeNormal, string(status.Reason), status.Message)
+		}
+		inst.Status.MaintenanceStatus = status
+		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000154, inst); err != nil {
+			return false, reconcile.Result{}, err
+		}
+	}
+
+	requeueAfter := maintenanceRecheckInterval
+	if status.NextWindowStart != nil {
+		if untilWindow := status.NextWindowStart.Sub(now); untilWindow < requeueAfter {
+			requeueAfter = untilWindow
+		}
+	}
+	return false, reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// resetMaintenanceStatus removes the status of a postponed reconcile that is not requested anymore.
+func (c *Controller) resetMaintenanceStatus(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	inst.Status.MaintenanceStatus = nil
+	return c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000155, inst)
+}
+
+func maintenanceStatusEqual(a, b *lsv1alpha1.MaintenanceStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Reason != b.Reason || a.Message != b.Message || !a.PendingSince.Equal(&b.PendingSince) {
+		return false
+	}
+	if a.NextWindowStart == nil || b.NextWindowStart == nil {
+		return a.NextWindowStart == b.NextWindowStart
+	}
+	return a.NextWindowStart.Equal(b.NextWindowStart)
+}
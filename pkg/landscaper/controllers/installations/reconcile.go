@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -47,6 +48,7 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000115, inst); err != nil {
 			return lserrors.NewWrappedError(err, op, "InitialPhaseSetting", err.Error())
 		}
+		metrics.RecordInstallationPhase(inst)
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Init {
@@ -259,6 +261,17 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation) (*
 		return nil, nil, "", nil, nil, normalError
 	}
 
+	// the blockers are stored in the status which is updated together with the phase and the error of this reconcile
+	blockers, err := rh.GetBlockers(inst, predecessorMap)
+	if err != nil {
+		normalError := lserrors.NewWrappedError(err, currentOperation, "GetBlockers", err.Error())
+		return nil, nil, "", nil, nil, normalError
+	}
+	inst.Status.BlockedBy = nil
+	if len(blockers) != 0 {
+		inst.Status.BlockedBy = blockers
+	}
+
 	if err = rh.AllPredecessorsFinished(inst, predecessorMap); err != nil {
 		normalError := lserrors.NewWrappedError(err, currentOperation, "AllPredecessorsFinished", err.Error())
 		return nil, nil, "", nil, nil, normalError
@@ -328,7 +341,9 @@ func (c *Controller) handlePhaseCleanupOrphaned(ctx context.Context, inst *lsv1a
 
 	for _, next := range subInstsToDelete {
 		if next.Status.JobID != inst.Status.JobID {
+			now := metav1.Now()
 			next.Status.JobID = inst.Status.JobID
+			next.Status.JobIDGenerationTime = &now
 			if err = c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000076, next); err != nil {
 				return nil, lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
 			}
@@ -367,7 +382,9 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 	// trigger subinstallations
 	for _, next := range subInsts {
 		if next.Status.JobID != inst.Status.JobID {
+			now := metav1.Now()
 			next.Status.JobID = inst.Status.JobID
+			next.Status.JobIDGenerationTime = &now
 			if err = c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
 				return lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
 			}
@@ -382,7 +399,9 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 		}
 
 		if exec.Status.JobID != inst.Status.JobID {
+			now := metav1.Now()
 			exec.Status.JobID = inst.Status.JobID
+			exec.Status.JobIDGenerationTime = &now
 			if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000084, exec); err != nil {
 				return lserrors.NewWrappedError(err, currentOperation, "UpdateExecutionStatus", err.Error())
 			}
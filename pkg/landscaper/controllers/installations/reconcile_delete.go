@@ -101,7 +101,9 @@ func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, ins
 	}
 
 	if exec != nil && exec.Status.JobID != inst.Status.JobID {
+		now := metav1.Now()
 		exec.Status.JobID = inst.Status.JobID
+		exec.Status.JobIDGenerationTime = &now
 		if err = c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000093, exec); err != nil {
 			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
 		}
@@ -114,7 +116,9 @@ func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, ins
 
 	for _, subInst := range subInsts {
 		if subInst.Status.JobID != inst.Status.JobID {
+			now := metav1.Now()
 			subInst.Status.JobID = inst.Status.JobID
+			subInst.Status.JobIDGenerationTime = &now
 			if err = c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000094, subInst); err != nil {
 				return lserrors.NewWrappedError(err, op, "UpdateInstallationStatus", err.Error())
 			}
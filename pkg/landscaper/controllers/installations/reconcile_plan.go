@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/plan"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// PlanResultImportsKey is the key of the rendered imports in the plan result secret.
+	PlanResultImportsKey = "imports"
+	// PlanResultSubinstallationsKey is the key of the rendered subinstallation specifications in the plan result secret.
+	PlanResultSubinstallationsKey = "subinstallations"
+	// PlanResultDeployItemsKey is the key of the rendered deploy item templates in the plan result secret.
+	PlanResultDeployItemsKey = "deployItems"
+)
+
+// handlePlanOperation renders the installation without applying it and stores the result in the installation status.
+// The plan annotation is removed afterwards.
+func (c *Controller) handlePlanOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+
+	instPlan := &lsv1alpha1.InstallationPlan{
+		PlanTime:           metav1.Now(),
+		ObservedGeneration: inst.GetGeneration(),
+	}
+	if err := c.computePlan(ctx, inst.DeepCopy(), instPlan); err != nil {
+		logger.Info("unable to compute plan", lc.KeyError, err.Error())
+		instPlan.Error = lserrors.TryUpdateLsError(nil, err)
+	}
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000150, inst); err != nil {
+		return err
+	}
+
+	inst.Status.Plan = instPlan
+	return c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000151, inst)
+}
+
+// computePlan renders the imports, subinstallations and deploy items of the installation exactly like a reconcile would
+// and compares them to the current subinstallations and the deploy items of the current execution.
+// The given installation is modified in memory and must therefore be a copy.
+func (c *Controller) computePlan(ctx context.Context, inst *lsv1alpha1.Installation, instPlan *lsv1alpha1.InstallationPlan) lserrors.LsError {
+	currOp := "ComputePlan"
+
+	instOp, imps, _, _, fatalError, normalError := c.init(ctx, inst)
+	if fatalError != nil {
+		return fatalError
+	} else if normalError != nil {
+		return normalError
+	}
+	instOp.DryRun = true
+
+	constructor := imports.NewConstructor(instOp)
+	if err := constructor.Construct(ctx, imps); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
+	}
+	if err := constructor.RenderImportExecutions(); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "RenderImportExecutions", err.Error())
+	}
+
+	subInstOp := subinstallations.New(instOp)
+	renderedSubInsts, err := subInstOp.RenderSubinstallations()
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "RenderSubinstallations", err.Error())
+	}
+	currentSubInsts, err := subInstOp.GetSubInstallations(ctx, inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "GetSubinstallations", err.Error())
+	}
+	instPlan.Subinstallations, err = plan.ComputeSubinstallationChanges(currentSubInsts, renderedSubInsts)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ComputeSubinstallationChanges", err.Error())
+	}
+
+	execTemplates, err := executions.New(instOp).RenderDeployItemTemplates(ctx, instOp.Inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "RenderDeployItemTemplates", err.Error())
+	}
+	renderedDeployItems := lsv1alpha1.DeployItemTemplateList{}
+	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&execTemplates, &renderedDeployItems, nil); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ConvertDeployItemTemplates", err.Error())
+	}
+	currentDeployItems := lsv1alpha1.DeployItemTemplateList{}
+	exec, err := executions.GetExecutionForInstallation(ctx, c.Client(), inst)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "GetExecution", err.Error())
+	}
+	if exec != nil {
+		currentDeployItems = exec.Spec.DeployItems
+	}
+	instPlan.DeployItems, err = plan.ComputeDeployItemChanges(currentDeployItems, renderedDeployItems)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ComputeDeployItemChanges", err.Error())
+	}
+
+	instPlan.ResultReference, err = c.storePlanResult(ctx, inst, instOp.Inst.GetImports(), renderedSubInsts, renderedDeployItems)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "StorePlanResult", err.Error())
+	}
+	return nil
+}
+
+// storePlanResult stores the rendered imports, subinstallations and deploy items in a secret that is owned by the installation.
+// A secret is used as the rendered objects may contain sensitive data.
+func (c *Controller) storePlanResult(ctx context.Context, inst *lsv1alpha1.Installation,
+	renderedImports map[string]interface{},
+	renderedSubInsts map[string]*lsv1alpha1.InstallationSpec,
+	renderedDeployItems lsv1alpha1.DeployItemTemplateList) (*lsv1alpha1.ObjectReference, error) {
+
+	importsData, err := yaml.Marshal(renderedImports)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal rendered imports: %w", err)
+	}
+	subInstsData, err := yaml.Marshal(renderedSubInsts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal rendered subinstallations: %w", err)
+	}
+	deployItemsData, err := yaml.Marshal(renderedDeployItems)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal rendered deploy items: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = fmt.Sprintf("%s-plan", inst.Name)
+	secret.Namespace = inst.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.Client(), secret, func() error {
+		secret.Data = map[string][]byte{
+			PlanResultImportsKey:          importsData,
+			PlanResultSubinstallationsKey: subInstsData,
+			PlanResultDeployItemsKey:      deployItemsData,
+		}
+		return controllerutil.SetControllerReference(inst, secret, api.LandscaperScheme)
+	}); err != nil {
+		return nil, fmt.Errorf("unable to create or update plan result secret: %w", err)
+	}
+
+	return &lsv1alpha1.ObjectReference{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+	}, nil
+}
@@ -17,6 +17,7 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -108,8 +109,15 @@ func (r *retryHelper) isRetryActivatedForFailed(inst *lsv1alpha1.Installation) b
 }
 
 func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alpha1.Installation, oldResult reconcile.Result, oldError error) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	retryStatus := inst.Status.AutomaticReconcileStatus
 
+	action := r.getRetryActionForFailed(inst)
+	if action == lsv1alpha1.RetryActionNever {
+		logger.Info("no retry of failed installation because of a retry rule")
+		return oldResult, oldError
+	}
+
 	// first failure, or installation changed
 	if retryStatus == nil {
 		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
@@ -122,7 +130,7 @@ func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alp
 		return reconcile.Result{}, nil
 	}
 
-	if r.maxNumberOfRetriesDoneForFailed(inst) {
+	if action != lsv1alpha1.RetryActionAlways && r.maxNumberOfRetriesDoneForFailed(inst) {
 		return oldResult, oldError
 	}
 
@@ -232,6 +240,11 @@ func (r *retryHelper) addReconcileAnnotation(ctx context.Context, inst *lsv1alph
 	return nil
 }
 
+// getRetryActionForFailed evaluates the retry rules for failed installations against the last error of the installation.
+func (r *retryHelper) getRetryActionForFailed(inst *lsv1alpha1.Installation) lsv1alpha1.RetryAction {
+	return lsutil.GetRetryAction(inst.Spec.AutomaticReconcile.FailedReconcile.Rules, inst.Status.LastError)
+}
+
 func (r *retryHelper) maxNumberOfRetriesDoneForFailed(inst *lsv1alpha1.Installation) bool {
 	alreadyExecutedRetries := 0
 	if inst.Status.AutomaticReconcileStatus != nil {
@@ -268,11 +281,13 @@ func (r *retryHelper) getNextRetryTimeForSucceeded(inst *lsv1alpha1.Installation
 }
 
 func (r *retryHelper) getRetryIntervalForFailed(inst *lsv1alpha1.Installation) time.Duration {
-	retryInterval := inst.Spec.AutomaticReconcile.FailedReconcile.Interval
-	if retryInterval == nil {
-		return defaultRetryDurationForFailed
+	failedReconcile := inst.Spec.AutomaticReconcile.FailedReconcile
+	retryInterval := defaultRetryDurationForFailed
+	if failedReconcile.Interval != nil {
+		retryInterval = failedReconcile.Interval.Duration
 	}
-	return retryInterval.Duration
+	return lsutil.GetRetryInterval(retryInterval, failedReconcile.Backoff,
+		inst.Status.AutomaticReconcileStatus.NumberOfReconciles, string(inst.GetUID()))
 }
 
 func (r *retryHelper) getRetryIntervalForSucceeded(inst *lsv1alpha1.Installation) time.Duration {
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the rollout controller to the manager.
+// The controller watches rollouts as well as installations, so that the next wave is started as soon as
+// the installations of the current wave are finished. Contexts and ComponentVersionOverwrites are watched to keep
+// the contexts of the rollouts in sync with the original contexts, and namespaces are watched to update
+// the installations of namespaces whose labels change.
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager) error {
+	log := logger.Reconciles("rollout", "Rollout")
+	ctrl := NewController(log, mgr.GetClient(), mgr.GetEventRecorderFor("Landscaper"))
+
+	return builder.ControllerManagedBy(mgr).
+		For(&lsv1alpha1.Rollout{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &lsv1alpha1.Installation{}}, handler.EnqueueRequestsFromMapFunc(ctrl.rolloutsForInstallation)).
+		Watches(&source.Kind{Type: &lsv1alpha1.Context{}}, handler.EnqueueRequestsFromMapFunc(ctrl.allRollouts)).
+		Watches(&source.Kind{Type: &lsv1alpha1.ComponentVersionOverwrites{}}, handler.EnqueueRequestsFromMapFunc(ctrl.allRollouts)).
+		Watches(&source.Kind{Type: &corev1.Namespace{}}, handler.EnqueueRequestsFromMapFunc(ctrl.allRollouts),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
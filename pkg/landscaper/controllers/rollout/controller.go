@@ -0,0 +1,631 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/metrics"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// requeueInterval is the interval in which a progressing rollout is checked,
+// in addition to the checks triggered by changes of the installations.
+const requeueInterval = 5 * time.Minute
+
+// NewController creates a new rollout controller.
+func NewController(logger logging.Logger, kubeClient client.Client, eventRecorder record.EventRecorder) *Controller {
+	return &Controller{
+		log:           logger,
+		client:        kubeClient,
+		eventRecorder: eventRecorder,
+	}
+}
+
+// Controller is the controller that updates the installations selected by a rollout in waves.
+// The user-owned component reference of the installations is never modified while the rollout is running. Instead, the
+// installations of a wave are switched to a context of the rollout in their namespace, which references
+// ComponentVersionOverwrites of the rollout that replace the version of the rolled out component.
+// Every version of a rollout uses its own contexts, so that installations are also moved to a new version in waves.
+type Controller struct {
+	log           logging.Logger
+	client        client.Client
+	eventRecorder record.EventRecorder
+}
+
+// Reconcile reconciles requests for rollouts.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	defer metrics.ObserveReconcileDuration(metrics.RolloutController, time.Now())
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	rollout := &lsv1alpha1.Rollout{}
+	if err := c.client.Get(ctx, req.NamespacedName, rollout); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	var (
+		result reconcile.Result
+		err    error
+	)
+	if rollout.DeletionTimestamp.IsZero() {
+		result, err = c.reconcile(ctx, rollout)
+	} else {
+		err = c.delete(ctx, rollout)
+	}
+	if err != nil {
+		metrics.RecordError(metrics.RolloutController, err)
+		logger.Error(err, "reconciling rollout failed")
+	}
+	return result, err
+}
+
+func (c *Controller) reconcile(ctx context.Context, rollout *lsv1alpha1.Rollout) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if !controllerutil.ContainsFinalizer(rollout, lsv1alpha1.LandscaperFinalizer) {
+		controllerutil.AddFinalizer(rollout, lsv1alpha1.LandscaperFinalizer)
+		if err := c.client.Update(ctx, rollout); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.Selector)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("invalid label selector: %w", err)
+	}
+	namespaces, err := c.selectedNamespaces(ctx, rollout)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.client, instList); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	oldStatus := rollout.Status.DeepCopy()
+	if rollout.Status.ObservedGeneration != rollout.Generation {
+		// the rollout has been changed, e.g. to roll out another version, so that the waves start from the beginning.
+		// The installations keep their current version until they are moved to the new version by a wave.
+		rollout.Status = lsv1alpha1.RolloutStatus{
+			ObservedGeneration: rollout.Generation,
+		}
+	}
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if !isSwitchedBy(rollout, inst) || !inst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if !isSelected(rollout, selector, namespaces, inst) {
+			if err := c.revertInstallation(ctx, inst); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	state := computeRolloutState(rollout, selector, namespaces, instList.Items)
+	result := reconcile.Result{}
+
+	// keep the contexts of the rollout in sync with the original contexts of the installations
+	for key := range state.contexts {
+		if err := c.ensureRolloutContext(ctx, rollout, key); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	switch {
+	case len(state.failed) > 0:
+		rollout.Status.Phase = lsv1alpha1.RolloutPhaseFailed
+		rollout.Status.Message = fmt.Sprintf("rollout is halted because the following installations failed: %s",
+			strings.Join(state.failed, ", "))
+		if oldStatus.Phase != lsv1alpha1.RolloutPhaseFailed {
+			c.eventRecorder.Event(rollout, corev1.EventTypeWarning, "RolloutHalted", rollout.Status.Message)
+		}
+
+	case state.inProgress > 0:
+		rollout.Status.Phase = lsv1alpha1.RolloutPhaseProgressing
+		rollout.Status.Message = fmt.Sprintf("waiting for %d installations of wave %d", state.inProgress, rollout.Status.Wave)
+		result.RequeueAfter = requeueInterval
+
+	case len(state.pending) > 0:
+		waveSize, err := getWaveSize(rollout.Spec.Strategy.WaveSize, state.total)
+		if err != nil {
+			rollout.Status.Phase = lsv1alpha1.RolloutPhaseFailed
+			rollout.Status.Message = err.Error()
+			break
+		}
+		if waveSize > len(state.pending) {
+			waveSize = len(state.pending)
+		}
+
+		wave := state.pending[:waveSize]
+		logger.Info("Starting wave", "wave", rollout.Status.Wave+1, "installations", len(wave))
+		for _, inst := range wave {
+			key := rolloutContextKey{
+				namespace:       inst.Namespace,
+				originalContext: originalContext(rollout, inst),
+				version:         rollout.Spec.Version,
+			}
+			if _, ok := state.contexts[key]; !ok {
+				if err := c.ensureRolloutContext(ctx, rollout, key); err != nil {
+					return reconcile.Result{}, err
+				}
+				state.contexts[key] = struct{}{}
+			}
+			if err := c.updateInstallation(ctx, rollout, inst); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		rollout.Status.Wave++
+		state.updated += len(wave)
+		rollout.Status.Phase = lsv1alpha1.RolloutPhaseProgressing
+		rollout.Status.Message = fmt.Sprintf("waiting for %d installations of wave %d", len(wave), rollout.Status.Wave)
+		c.eventRecorder.Eventf(rollout, corev1.EventTypeNormal, "WaveStarted",
+			"started wave %d with %d installations", rollout.Status.Wave, len(wave))
+		result.RequeueAfter = requeueInterval
+
+	default:
+		rollout.Status.Phase = lsv1alpha1.RolloutPhaseSucceeded
+		rollout.Status.Message = fmt.Sprintf("all %d installations have been updated", state.total)
+	}
+
+	rollout.Status.TotalInstallations = int32(state.total)
+	rollout.Status.UpdatedInstallations = int32(state.updated)
+	rollout.Status.SucceededInstallations = int32(state.succeeded)
+	rollout.Status.FailedInstallations = state.failed
+
+	if err := c.removeUnusedContexts(ctx, rollout, instList.Items); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := c.updateStatus(ctx, rollout, oldStatus); err != nil {
+		return reconcile.Result{}, err
+	}
+	return result, nil
+}
+
+// delete removes all installations from the rollout.
+// Installations that have been updated successfully stay on the rolled out version, so that deleting the rollout completes it.
+// All other installations are reverted to their original context.
+// The contexts and ComponentVersionOverwrites of the rollout are garbage collected by their owner reference.
+func (c *Controller) delete(ctx context.Context, rollout *lsv1alpha1.Rollout) error {
+	if !controllerutil.ContainsFinalizer(rollout, lsv1alpha1.LandscaperFinalizer) {
+		return nil
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.client, instList); err != nil {
+		return err
+	}
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if !isSwitchedBy(rollout, inst) || !inst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		var err error
+		if isFinished(inst) && inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded {
+			err = c.completeInstallation(ctx, inst)
+		} else {
+			err = c.revertInstallation(ctx, inst)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(rollout, lsv1alpha1.LandscaperFinalizer)
+	return c.client.Update(ctx, rollout)
+}
+
+// selectedNamespaces returns the names of the namespaces that are selected by the namespace selector of the rollout.
+func (c *Controller) selectedNamespaces(ctx context.Context, rollout *lsv1alpha1.Rollout) (map[string]struct{}, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	nsList := &corev1.NamespaceList{}
+	if err := c.client.List(ctx, nsList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to list namespaces: %w", err)
+	}
+	namespaces := make(map[string]struct{}, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		namespaces[ns.Name] = struct{}{}
+	}
+	return namespaces, nil
+}
+
+// ensureRolloutContext creates or updates the context and the ComponentVersionOverwrites of the rollout
+// for installations in the given namespace with the given original context and version.
+// The context of the rollout is a copy of the original context. Installations without a context use a copy
+// of the default context, whose deploy items would otherwise use the default context.
+// The ComponentVersionOverwrites of the rollout replace the version of the rolled out component,
+// followed by the overwrites of the original context.
+// Existing objects that are not controlled by the rollout are never overwritten.
+func (c *Controller) ensureRolloutContext(ctx context.Context, rollout *lsv1alpha1.Rollout, key rolloutContextKey) error {
+	name := rolloutContextName(rollout, key.originalContext, key.version)
+
+	base := &lsv1alpha1.Context{}
+	if len(key.originalContext) != 0 {
+		if err := c.client.Get(ctx, kutil.ObjectKey(key.originalContext, key.namespace), base); err != nil {
+			return fmt.Errorf("unable to get context %s/%s: %w", key.namespace, key.originalContext, err)
+		}
+	} else {
+		if err := c.client.Get(ctx, kutil.ObjectKey(lsv1alpha1.DefaultContextName, key.namespace), base); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to get context %s/%s: %w", key.namespace, lsv1alpha1.DefaultContextName, err)
+		}
+		// installations without context are processed without overwrites
+		base.ComponentVersionOverwritesReference = ""
+	}
+
+	overwrites := lsv1alpha1.ComponentVersionOverwriteList{
+		{
+			Source: lsv1alpha1.ComponentVersionOverwriteReference{
+				ComponentName: rollout.Spec.ComponentName,
+			},
+			Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+				Version: key.version,
+			},
+		},
+	}
+	if len(base.ComponentVersionOverwritesReference) != 0 {
+		baseCvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		if err := c.client.Get(ctx, kutil.ObjectKey(base.ComponentVersionOverwritesReference, key.namespace), baseCvo); err != nil {
+			return fmt.Errorf("unable to get ComponentVersionOverwrites %s/%s of context %q: %w",
+				key.namespace, base.ComponentVersionOverwritesReference, key.originalContext, err)
+		}
+		for _, overwrite := range baseCvo.Overwrites {
+			// overwrites that replace the version of the rolled out component are never applied after the overwrite
+			// of the rollout, and they would be rejected as conflicting with it
+			if overwrite.Source.ComponentName == rollout.Spec.ComponentName && len(overwrite.Substitution.Version) != 0 {
+				continue
+			}
+			overwrites = append(overwrites, overwrite)
+		}
+	}
+
+	cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+	cvo.Name = name
+	cvo.Namespace = key.namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.client, cvo, func() error {
+		if err := checkControlledBy(rollout, cvo); err != nil {
+			return err
+		}
+		cvo.Overwrites = overwrites
+		return controllerutil.SetControllerReference(rollout, cvo, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to create or update ComponentVersionOverwrites %s/%s: %w", key.namespace, name, err)
+	}
+
+	lsCtx := &lsv1alpha1.Context{}
+	lsCtx.Name = name
+	lsCtx.Namespace = key.namespace
+	if _, err := read_write_layer.NewWriter(c.client).CreateOrPatchCoreContext(ctx, read_write_layer.W000164, lsCtx, func() error {
+		if err := checkControlledBy(rollout, lsCtx); err != nil {
+			return err
+		}
+		objectMeta := lsCtx.ObjectMeta
+		*lsCtx = *base.DeepCopy()
+		lsCtx.ObjectMeta = objectMeta
+		lsCtx.ComponentVersionOverwritesReference = name
+		return controllerutil.SetControllerReference(rollout, lsCtx, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to create or update context %s/%s: %w", key.namespace, name, err)
+	}
+	return nil
+}
+
+// removeUnusedContexts deletes the contexts and ComponentVersionOverwrites of the rollout that are not used by any
+// installation anymore, e.g. the contexts of a previous version after all installations have been moved to the new version.
+func (c *Controller) removeUnusedContexts(ctx context.Context, rollout *lsv1alpha1.Rollout, instList []lsv1alpha1.Installation) error {
+	used := map[client.ObjectKey]struct{}{}
+	for i := range instList {
+		inst := &instList[i]
+		if isSwitchedBy(rollout, inst) {
+			used[kutil.ObjectKey(inst.Spec.Context, inst.Namespace)] = struct{}{}
+		}
+	}
+
+	ctxList := &lsv1alpha1.ContextList{}
+	if err := c.client.List(ctx, ctxList); err != nil {
+		return fmt.Errorf("unable to list contexts: %w", err)
+	}
+	for i := range ctxList.Items {
+		lsCtx := &ctxList.Items[i]
+		if _, ok := used[client.ObjectKeyFromObject(lsCtx)]; ok || !metav1.IsControlledBy(lsCtx, rollout) {
+			continue
+		}
+		if err := read_write_layer.NewWriter(c.client).DeleteContext(ctx, read_write_layer.W000168, lsCtx); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete unused context %s: %w", client.ObjectKeyFromObject(lsCtx).String(), err)
+		}
+		cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		cvo.Name = lsCtx.Name
+		cvo.Namespace = lsCtx.Namespace
+		if err := c.client.Delete(ctx, cvo); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete unused ComponentVersionOverwrites %s: %w", client.ObjectKeyFromObject(cvo).String(), err)
+		}
+	}
+	return nil
+}
+
+// updateInstallation switches the installation to the context of the rollout for the rolled out version and triggers
+// its reconciliation. The original context is kept in an annotation, so that it can be restored.
+func (c *Controller) updateInstallation(ctx context.Context, rollout *lsv1alpha1.Rollout, inst *lsv1alpha1.Installation) error {
+	originalContext := originalContext(rollout, inst)
+	contextName := rolloutContextName(rollout, originalContext, rollout.Spec.Version)
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyResource, client.ObjectKeyFromObject(inst).String()})
+	logger.Info("Updating installation", "version", rollout.Spec.Version, "context", contextName)
+
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.RolloutAnnotation, rollout.Name)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.RolloutOriginalContextAnnotation, originalContext)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.RolloutVersionAnnotation, rollout.Spec.Version)
+	inst.Spec.Context = contextName
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	return read_write_layer.NewWriter(c.client).UpdateInstallation(ctx, read_write_layer.W000156, inst)
+}
+
+// revertInstallation restores the original context of an installation and triggers its reconciliation.
+func (c *Controller) revertInstallation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	originalContext := inst.Annotations[lsv1alpha1.RolloutOriginalContextAnnotation]
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyResource, client.ObjectKeyFromObject(inst).String()})
+	logger.Info("Reverting installation", "context", originalContext)
+
+	inst.Spec.Context = originalContext
+	removeRolloutAnnotations(inst)
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	return read_write_layer.NewWriter(c.client).UpdateInstallation(ctx, read_write_layer.W000165, inst)
+}
+
+// completeInstallation restores the original context of an installation that has been updated successfully
+// and sets the version of its component reference to the rolled out version, so that it stays on this version.
+// The installation is not reconciled again, as it has already been processed with this version.
+func (c *Controller) completeInstallation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	version := inst.Annotations[lsv1alpha1.RolloutVersionAnnotation]
+	cd := inst.Spec.ComponentDescriptor
+	if len(version) == 0 || cd == nil || cd.Reference == nil {
+		return c.revertInstallation(ctx, inst)
+	}
+
+	originalContext := inst.Annotations[lsv1alpha1.RolloutOriginalContextAnnotation]
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyResource, client.ObjectKeyFromObject(inst).String()})
+	logger.Info("Completing installation", "version", version, "context", originalContext)
+
+	cd.Reference.Version = version
+	inst.Spec.Context = originalContext
+	removeRolloutAnnotations(inst)
+	return read_write_layer.NewWriter(c.client).UpdateInstallation(ctx, read_write_layer.W000167, inst)
+}
+
+func (c *Controller) updateStatus(ctx context.Context, rollout *lsv1alpha1.Rollout, oldStatus *lsv1alpha1.RolloutStatus) error {
+	oldStatus.LastUpdateTime = rollout.Status.LastUpdateTime
+	if apiequality.Semantic.DeepEqual(oldStatus, &rollout.Status) {
+		return nil
+	}
+	now := metav1.Now()
+	rollout.Status.LastUpdateTime = &now
+	return c.client.Status().Update(ctx, rollout)
+}
+
+// rolloutsForInstallation returns reconcile requests for all rollouts whose label selector matches the given installation
+// or that have switched it to their context.
+func (c *Controller) rolloutsForInstallation(obj client.Object) []reconcile.Request {
+	rolloutList := &lsv1alpha1.RolloutList{}
+	if err := c.client.List(context.Background(), rolloutList); err != nil {
+		c.log.Error(err, "unable to list rollouts", lc.KeyResource, client.ObjectKeyFromObject(obj).String())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, rollout := range rolloutList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.Selector)
+		selected := err == nil && selector.Matches(labels.Set(obj.GetLabels()))
+		if !selected && obj.GetAnnotations()[lsv1alpha1.RolloutAnnotation] != rollout.Name {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rollout)})
+	}
+	return requests
+}
+
+// allRollouts returns reconcile requests for all rollouts that do not control the given object.
+// It is used to keep the contexts of the rollouts in sync with the original contexts and their overwrites,
+// and to update the selected installations if the labels of namespaces change.
+func (c *Controller) allRollouts(obj client.Object) []reconcile.Request {
+	rolloutList := &lsv1alpha1.RolloutList{}
+	if err := c.client.List(context.Background(), rolloutList); err != nil {
+		c.log.Error(err, "unable to list rollouts", lc.KeyResource, client.ObjectKeyFromObject(obj).String())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, rollout := range rolloutList.Items {
+		if metav1.IsControlledBy(obj, &rollout) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&rollout)})
+	}
+	return requests
+}
+
+// rolloutContextKey identifies a context of a rollout.
+type rolloutContextKey struct {
+	namespace       string
+	originalContext string
+	version         string
+}
+
+// rolloutState describes the state of the installations selected by a rollout.
+type rolloutState struct {
+	// total is the number of installations that are selected by the rollout.
+	total int
+	// pending contains the selected installations that do not yet use the context of the rollout for its current version.
+	pending []*lsv1alpha1.Installation
+	// updated is the number of selected installations that use the context of the rollout for its current version.
+	updated int
+	// inProgress is the number of updated installations that are currently processed or waiting to be processed.
+	inProgress int
+	// succeeded is the number of updated installations that have been processed successfully.
+	succeeded int
+	// failed contains the namespaces and names of the updated installations that failed.
+	failed []string
+	// contexts contains the contexts of the rollout that are used by installations.
+	contexts map[rolloutContextKey]struct{}
+}
+
+// computeRolloutState computes the state of a rollout from the installations in the selected namespaces.
+// Only root installations that match the label selector and reference the component of the rollout are taken into account.
+func computeRolloutState(rollout *lsv1alpha1.Rollout, selector labels.Selector, namespaces map[string]struct{},
+	instList []lsv1alpha1.Installation) *rolloutState {
+	sort.Slice(instList, func(i, j int) bool {
+		if instList[i].Namespace != instList[j].Namespace {
+			return instList[i].Namespace < instList[j].Namespace
+		}
+		return instList[i].Name < instList[j].Name
+	})
+
+	state := &rolloutState{
+		contexts: map[rolloutContextKey]struct{}{},
+	}
+	for i := range instList {
+		inst := &instList[i]
+		if isSwitchedBy(rollout, inst) {
+			state.contexts[rolloutContextKey{
+				namespace:       inst.Namespace,
+				originalContext: inst.Annotations[lsv1alpha1.RolloutOriginalContextAnnotation],
+				version:         inst.Annotations[lsv1alpha1.RolloutVersionAnnotation],
+			}] = struct{}{}
+		}
+		if !isSelected(rollout, selector, namespaces, inst) {
+			continue
+		}
+		state.total++
+
+		if !isSwitchedBy(rollout, inst) || inst.Annotations[lsv1alpha1.RolloutVersionAnnotation] != rollout.Spec.Version {
+			state.pending = append(state.pending, inst)
+			continue
+		}
+
+		state.updated++
+		switch {
+		case !isFinished(inst):
+			state.inProgress++
+		case inst.Status.InstallationPhase.IsFailed():
+			state.failed = append(state.failed, client.ObjectKeyFromObject(inst).String())
+		case inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded:
+			state.succeeded++
+		}
+	}
+	return state
+}
+
+// isSelected checks whether an installation is updated by a rollout.
+// Installations that have been switched to the context of another rollout are ignored.
+func isSelected(rollout *lsv1alpha1.Rollout, selector labels.Selector, namespaces map[string]struct{}, inst *lsv1alpha1.Installation) bool {
+	if !inst.DeletionTimestamp.IsZero() || !installations.IsRootInstallation(inst) {
+		return false
+	}
+	if _, ok := namespaces[inst.Namespace]; !ok || !selector.Matches(labels.Set(inst.Labels)) {
+		return false
+	}
+	if owner, ok := inst.Annotations[lsv1alpha1.RolloutAnnotation]; ok && owner != rollout.Name {
+		return false
+	}
+	cd := inst.Spec.ComponentDescriptor
+	return cd != nil && cd.Reference != nil && cd.Reference.ComponentName == rollout.Spec.ComponentName
+}
+
+// isSwitchedBy checks whether an installation has been switched to a context of the rollout.
+func isSwitchedBy(rollout *lsv1alpha1.Rollout, inst *lsv1alpha1.Installation) bool {
+	if inst.Annotations[lsv1alpha1.RolloutAnnotation] != rollout.Name {
+		return false
+	}
+	originalContext, ok := inst.Annotations[lsv1alpha1.RolloutOriginalContextAnnotation]
+	if !ok {
+		return false
+	}
+	version, ok := inst.Annotations[lsv1alpha1.RolloutVersionAnnotation]
+	return ok && inst.Spec.Context == rolloutContextName(rollout, originalContext, version)
+}
+
+// isFinished checks whether the current job of an installation is finished and no new job has been requested.
+func isFinished(inst *lsv1alpha1.Installation) bool {
+	return !lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
+		inst.Status.JobID == inst.Status.JobIDFinished
+}
+
+// originalContext returns the context of an installation before it was switched to a context of the rollout.
+func originalContext(rollout *lsv1alpha1.Rollout, inst *lsv1alpha1.Installation) string {
+	if isSwitchedBy(rollout, inst) {
+		return inst.Annotations[lsv1alpha1.RolloutOriginalContextAnnotation]
+	}
+	return inst.Spec.Context
+}
+
+// removeRolloutAnnotations removes the annotations that are set on installations switched to the context of a rollout.
+func removeRolloutAnnotations(inst *lsv1alpha1.Installation) {
+	delete(inst.Annotations, lsv1alpha1.RolloutAnnotation)
+	delete(inst.Annotations, lsv1alpha1.RolloutOriginalContextAnnotation)
+	delete(inst.Annotations, lsv1alpha1.RolloutVersionAnnotation)
+}
+
+// checkControlledBy returns an error if the object already exists and is not controlled by the rollout.
+func checkControlledBy(rollout *lsv1alpha1.Rollout, obj client.Object) error {
+	if len(obj.GetResourceVersion()) != 0 && !metav1.IsControlledBy(obj, rollout) {
+		return fmt.Errorf("%s already exists and is not controlled by rollout %s", client.ObjectKeyFromObject(obj).String(), rollout.Name)
+	}
+	return nil
+}
+
+// rolloutContextName returns the name of the context and the ComponentVersionOverwrites of a rollout
+// for installations with the given original context and version.
+// The name is suffixed by a hash of the original context and the version, so that every version uses its own context.
+func rolloutContextName(rollout *lsv1alpha1.Rollout, originalContext, version string) string {
+	hash := sha256.Sum256([]byte(originalContext + "/" + version))
+	return fmt.Sprintf("%s-%s", rollout.Name, hex.EncodeToString(hash[:])[:10])
+}
+
+// getWaveSize returns the number of installations of one wave.
+// The wave size is either an absolute number or a percentage of the total number of selected installations.
+func getWaveSize(waveSize string, total int) (int, error) {
+	if len(waveSize) == 0 {
+		return 1, nil
+	}
+	value := intstr.Parse(waveSize)
+	size, err := intstr.GetScaledValueFromIntOrPercent(&value, total, true)
+	if err != nil {
+		return 0, fmt.Errorf("invalid wave size %q: %w", waveSize, err)
+	}
+	if size < 1 {
+		return 0, fmt.Errorf("invalid wave size %q: must be positive", waveSize)
+	}
+	return size, nil
+}
@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/rollout"
+)
+
+const (
+	componentName = "example.com/my-component"
+	oldVersion    = "v1.0.0"
+	newVersion    = "v1.1.0"
+)
+
+var _ = Describe("Rollout Controller", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		ctrl       *rollout.Controller
+		ro         *lsv1alpha1.Rollout
+	)
+
+	newNamespace := func(name string, labels map[string]string) *corev1.Namespace {
+		ns := &corev1.Namespace{}
+		ns.Name = name
+		ns.Labels = labels
+		return ns
+	}
+
+	newInstallation := func(namespace, name string, labels map[string]string) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = namespace
+		inst.Labels = labels
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: &lsv1alpha1.ComponentDescriptorReference{
+				ComponentName: componentName,
+				Version:       oldVersion,
+			},
+		}
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		return inst
+	}
+
+	// getInstallation returns the installation with the given name, the names of the test installations are unique
+	// across all namespaces.
+	getInstallation := func(name string) *lsv1alpha1.Installation {
+		instList := &lsv1alpha1.InstallationList{}
+		Expect(kubeClient.List(ctx, instList)).To(Succeed())
+		for i := range instList.Items {
+			if instList.Items[i].Name == name {
+				return &instList.Items[i]
+			}
+		}
+		Fail(fmt.Sprintf("installation %s not found", name))
+		return nil
+	}
+
+	// finishInstallation simulates the processing of an installation by the installation controller.
+	finishInstallation := func(name string, phase lsv1alpha1.InstallationPhase) {
+		inst := getInstallation(name)
+		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+		inst.Status.InstallationPhase = phase
+		Expect(kubeClient.Update(ctx, inst)).To(Succeed())
+	}
+
+	reconcileRollout := func() *lsv1alpha1.Rollout {
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ro)})
+		Expect(err).ToNot(HaveOccurred())
+		res := &lsv1alpha1.Rollout{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(ro), res)).To(Succeed())
+		return res
+	}
+
+	// switchedInstallations returns the names of the installations that have been switched to the context
+	// of the rollout for the given version.
+	switchedInstallations := func(version string) []string {
+		instList := &lsv1alpha1.InstallationList{}
+		Expect(kubeClient.List(ctx, instList)).To(Succeed())
+		names := []string{}
+		for _, inst := range instList.Items {
+			if inst.Annotations[lsv1alpha1.RolloutAnnotation] == ro.Name &&
+				inst.Annotations[lsv1alpha1.RolloutVersionAnnotation] == version {
+				names = append(names, inst.Name)
+			}
+		}
+		return names
+	}
+
+	getOverwrites := func(namespace, name string) lsv1alpha1.ComponentVersionOverwriteList {
+		lsCtx := &lsv1alpha1.Context{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, lsCtx)).To(Succeed())
+		Expect(lsCtx.ComponentVersionOverwritesReference).To(Equal(name))
+		Expect(metav1.IsControlledBy(lsCtx, ro)).To(BeTrue())
+		cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, cvo)).To(Succeed())
+		return cvo.Overwrites
+	}
+
+	rolloutOverwrite := func(version string) lsv1alpha1.ComponentVersionOverwrite {
+		return lsv1alpha1.ComponentVersionOverwrite{
+			Source:       lsv1alpha1.ComponentVersionOverwriteReference{ComponentName: componentName},
+			Substitution: lsv1alpha1.ComponentVersionOverwriteReference{Version: version},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		ro = &lsv1alpha1.Rollout{}
+		ro.Name = "my-rollout"
+		ro.UID = "my-rollout-uid"
+		ro.Generation = 1
+		ro.Spec = lsv1alpha1.RolloutSpec{
+			NamespaceSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"rollout": "enabled"},
+			},
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "my-app"},
+			},
+			ComponentName: componentName,
+			Version:       newVersion,
+			Strategy: lsv1alpha1.RolloutStrategy{
+				WaveSize: "40%",
+			},
+		}
+
+		selected := map[string]string{"app": "my-app"}
+		objects := []client.Object{
+			ro,
+			newNamespace("ns-a", map[string]string{"rollout": "enabled"}),
+			newNamespace("ns-b", map[string]string{"rollout": "enabled"}),
+			newNamespace("ns-c", nil),
+			newInstallation("ns-a", "inst-0", selected),
+			newInstallation("ns-a", "inst-1", selected),
+			newInstallation("ns-a", "inst-2", selected),
+			newInstallation("ns-b", "inst-3", selected),
+			newInstallation("ns-b", "inst-4", selected),
+			newInstallation("ns-a", "not-selected", map[string]string{"app": "other"}),
+			newInstallation("ns-c", "other-namespace", selected),
+		}
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(objects...).Build()
+		ctrl = rollout.NewController(logging.Discard(), kubeClient, record.NewFakeRecorder(100))
+	})
+
+	It("should update the installations of the selected namespaces in waves", func() {
+		res := reconcileRollout()
+		Expect(res.Status.Phase).To(Equal(lsv1alpha1.RolloutPhaseProgressing))
+		Expect(res.Status.Wave).To(Equal(int32(1)))
+		Expect(res.Status.TotalInstallations).To(Equal(int32(5)))
+		Expect(res.Status.UpdatedInstallations).To(Equal(int32(2)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1"))
+
+		inst := getInstallation("inst-0")
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal(oldVersion))
+		Expect(inst.Spec.Context).To(HavePrefix(ro.Name + "-"))
+		Expect(getInstallation("inst-1").Spec.Context).To(Equal(inst.Spec.Context))
+		Expect(getInstallation("inst-2").Spec.Context).To(BeEmpty())
+		Expect(getOverwrites("ns-a", inst.Spec.Context)).To(ConsistOf(rolloutOverwrite(newVersion)))
+
+		By("waiting for the installations of the current wave")
+		finishInstallation("inst-0", lsv1alpha1.InstallationPhases.Succeeded)
+		res = reconcileRollout()
+		Expect(res.Status.Wave).To(Equal(int32(1)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1"))
+
+		By("starting the next wave in both namespaces")
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Succeeded)
+		res = reconcileRollout()
+		Expect(res.Status.Wave).To(Equal(int32(2)))
+		Expect(res.Status.SucceededInstallations).To(Equal(int32(2)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1", "inst-2", "inst-3"))
+		inst = getInstallation("inst-3")
+		Expect(inst.Spec.Context).To(HavePrefix(ro.Name + "-"))
+		Expect(getOverwrites("ns-b", inst.Spec.Context)).To(ConsistOf(rolloutOverwrite(newVersion)))
+
+		finishInstallation("inst-2", lsv1alpha1.InstallationPhases.Succeeded)
+		finishInstallation("inst-3", lsv1alpha1.InstallationPhases.Succeeded)
+		res = reconcileRollout()
+		Expect(res.Status.Wave).To(Equal(int32(3)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1", "inst-2", "inst-3", "inst-4"))
+
+		finishInstallation("inst-4", lsv1alpha1.InstallationPhases.Succeeded)
+		res = reconcileRollout()
+		Expect(res.Status.Phase).To(Equal(lsv1alpha1.RolloutPhaseSucceeded))
+		Expect(res.Status.SucceededInstallations).To(Equal(int32(5)))
+		Expect(getInstallation("not-selected").Spec.Context).To(BeEmpty())
+		Expect(getInstallation("other-namespace").Spec.Context).To(BeEmpty())
+	})
+
+	It("should keep succeeded installations on the rolled out version and revert the others if the rollout is deleted", func() {
+		reconcileRollout()
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1"))
+		finishInstallation("inst-0", lsv1alpha1.InstallationPhases.Succeeded)
+
+		Expect(kubeClient.Delete(ctx, reconcileRollout())).To(Succeed())
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ro)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(ro), &lsv1alpha1.Rollout{})).ToNot(Succeed())
+		Expect(switchedInstallations(newVersion)).To(BeEmpty())
+
+		inst := getInstallation("inst-0")
+		Expect(inst.Spec.Context).To(BeEmpty())
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal(newVersion))
+		Expect(inst.Annotations).ToNot(HaveKey(lsv1alpha1.RolloutOriginalContextAnnotation))
+		Expect(inst.Annotations).ToNot(HaveKey(lsv1alpha1.RolloutVersionAnnotation))
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+
+		inst = getInstallation("inst-1")
+		Expect(inst.Spec.Context).To(BeEmpty())
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal(oldVersion))
+		Expect(inst.Annotations).ToNot(HaveKey(lsv1alpha1.RolloutAnnotation))
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+	})
+
+	It("should revert installations that are no longer selected", func() {
+		reconcileRollout()
+		inst := getInstallation("inst-0")
+		inst.Labels = map[string]string{"app": "other"}
+		Expect(kubeClient.Update(ctx, inst)).To(Succeed())
+
+		res := reconcileRollout()
+		Expect(res.Status.TotalInstallations).To(Equal(int32(4)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-1"))
+		Expect(getInstallation("inst-0").Spec.Context).To(BeEmpty())
+
+		By("reverting the installations of namespaces that are no longer selected")
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Succeeded)
+		reconcileRollout()
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-1", "inst-2", "inst-3"))
+
+		ns := &corev1.Namespace{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "ns-b"}, ns)).To(Succeed())
+		ns.Labels = nil
+		Expect(kubeClient.Update(ctx, ns)).To(Succeed())
+		res = reconcileRollout()
+		Expect(res.Status.TotalInstallations).To(Equal(int32(2)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-1", "inst-2"))
+		Expect(getInstallation("inst-3").Spec.Context).To(BeEmpty())
+	})
+
+	It("should move the installations to a new version in waves if the rollout is changed", func() {
+		reconcileRollout()
+		finishInstallation("inst-0", lsv1alpha1.InstallationPhases.Succeeded)
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Succeeded)
+		res := reconcileRollout()
+		Expect(res.Status.Wave).To(Equal(int32(2)))
+		finishInstallation("inst-2", lsv1alpha1.InstallationPhases.Succeeded)
+		finishInstallation("inst-3", lsv1alpha1.InstallationPhases.Succeeded)
+		oldContext := getInstallation("inst-2").Spec.Context
+
+		res.Spec.Version = "v1.2.0"
+		res.Generation = 2
+		Expect(kubeClient.Update(ctx, res)).To(Succeed())
+		res = reconcileRollout()
+		Expect(res.Status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(res.Status.Wave).To(Equal(int32(1)))
+		Expect(res.Status.UpdatedInstallations).To(Equal(int32(2)))
+		Expect(switchedInstallations("v1.2.0")).To(ConsistOf("inst-0", "inst-1"))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-2", "inst-3"))
+		Expect(getInstallation("inst-4").Spec.Context).To(BeEmpty())
+		Expect(lsv1alpha1helper.HasOperation(getInstallation("inst-2").ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+
+		newContext := getInstallation("inst-0").Spec.Context
+		Expect(newContext).ToNot(Equal(oldContext))
+		Expect(getOverwrites("ns-a", newContext)).To(ConsistOf(rolloutOverwrite("v1.2.0")))
+		Expect(getOverwrites("ns-a", oldContext)).To(ConsistOf(rolloutOverwrite(newVersion)))
+
+		By("removing the contexts of the previous version as soon as they are not used anymore")
+		finishInstallation("inst-0", lsv1alpha1.InstallationPhases.Succeeded)
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Succeeded)
+		reconcileRollout()
+		Expect(switchedInstallations("v1.2.0")).To(ConsistOf("inst-0", "inst-1", "inst-2", "inst-3"))
+		Expect(switchedInstallations(newVersion)).To(BeEmpty())
+		for _, namespace := range []string{"ns-a", "ns-b"} {
+			err := kubeClient.Get(ctx, client.ObjectKey{Name: oldContext, Namespace: namespace}, &lsv1alpha1.Context{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			err = kubeClient.Get(ctx, client.ObjectKey{Name: oldContext, Namespace: namespace}, &lsv1alpha1.ComponentVersionOverwrites{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+	})
+
+	It("should copy the original context and apply its overwrites after the rolled out version", func() {
+		otherOverwrite := lsv1alpha1.ComponentVersionOverwrite{
+			Source:       lsv1alpha1.ComponentVersionOverwriteReference{ComponentName: "example.com/other-component"},
+			Substitution: lsv1alpha1.ComponentVersionOverwriteReference{Version: "v2.0.0"},
+		}
+		supersededOverwrite := lsv1alpha1.ComponentVersionOverwrite{
+			Source:       lsv1alpha1.ComponentVersionOverwriteReference{ComponentName: componentName},
+			Substitution: lsv1alpha1.ComponentVersionOverwriteReference{Version: "v0.9.0"},
+		}
+		cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		cvo.Name = "my-overwrites"
+		cvo.Namespace = "ns-a"
+		cvo.Overwrites = lsv1alpha1.ComponentVersionOverwriteList{supersededOverwrite, otherOverwrite}
+		Expect(kubeClient.Create(ctx, cvo)).To(Succeed())
+
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = "my-context"
+		lsCtx.Namespace = "ns-a"
+		lsCtx.RegistryPullSecrets = []corev1.LocalObjectReference{{Name: "my-pull-secret"}}
+		lsCtx.ComponentVersionOverwritesReference = cvo.Name
+		Expect(kubeClient.Create(ctx, lsCtx)).To(Succeed())
+
+		inst := getInstallation("inst-0")
+		inst.Spec.Context = lsCtx.Name
+		Expect(kubeClient.Update(ctx, inst)).To(Succeed())
+
+		reconcileRollout()
+		inst = getInstallation("inst-0")
+		Expect(inst.Spec.Context).To(HavePrefix(ro.Name + "-"))
+		Expect(inst.Spec.Context).ToNot(Equal(getInstallation("inst-1").Spec.Context))
+		Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.RolloutOriginalContextAnnotation, "my-context"))
+		Expect(getOverwrites("ns-a", inst.Spec.Context)).To(Equal(lsv1alpha1.ComponentVersionOverwriteList{
+			rolloutOverwrite(newVersion), otherOverwrite}))
+
+		rolloutCtx := &lsv1alpha1.Context{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: inst.Spec.Context, Namespace: "ns-a"}, rolloutCtx)).To(Succeed())
+		Expect(rolloutCtx.RegistryPullSecrets).To(Equal(lsCtx.RegistryPullSecrets))
+	})
+
+	It("should not take over contexts that are not controlled by the rollout", func() {
+		reconcileRollout()
+		contextName := getInstallation("inst-0").Spec.Context
+		finishInstallation("inst-0", lsv1alpha1.InstallationPhases.Succeeded)
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Succeeded)
+
+		userCtx := &lsv1alpha1.Context{}
+		userCtx.Name = contextName
+		userCtx.Namespace = "ns-b"
+		userCtx.ComponentVersionOverwritesReference = "user-overwrites"
+		Expect(kubeClient.Create(ctx, userCtx)).To(Succeed())
+
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ro)})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not controlled by rollout"))
+
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(userCtx), userCtx)).To(Succeed())
+		Expect(userCtx.ComponentVersionOverwritesReference).To(Equal("user-overwrites"))
+		Expect(userCtx.OwnerReferences).To(BeEmpty())
+		Expect(getInstallation("inst-3").Spec.Context).To(BeEmpty())
+	})
+
+	It("should halt the rollout if an installation fails", func() {
+		reconcileRollout()
+		finishInstallation("inst-0", lsv1alpha1.InstallationPhases.Succeeded)
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Failed)
+
+		res := reconcileRollout()
+		Expect(res.Status.Phase).To(Equal(lsv1alpha1.RolloutPhaseFailed))
+		Expect(res.Status.FailedInstallations).To(ConsistOf("ns-a/inst-1"))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1"))
+
+		By("continuing the rollout as soon as the failed installation succeeds")
+		finishInstallation("inst-1", lsv1alpha1.InstallationPhases.Succeeded)
+		res = reconcileRollout()
+		Expect(res.Status.Phase).To(Equal(lsv1alpha1.RolloutPhaseProgressing))
+		Expect(res.Status.FailedInstallations).To(BeEmpty())
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-0", "inst-1", "inst-2", "inst-3"))
+	})
+
+	It("should only update installations that reference the rolled out component", func() {
+		inst := getInstallation("inst-0")
+		inst.Spec.ComponentDescriptor.Reference.ComponentName = "example.com/other-component"
+		Expect(kubeClient.Update(ctx, inst)).To(Succeed())
+
+		res := reconcileRollout()
+		Expect(res.Status.TotalInstallations).To(Equal(int32(4)))
+		Expect(switchedInstallations(newVersion)).To(ConsistOf("inst-1", "inst-2"))
+	})
+})
@@ -24,4 +24,5 @@ const (
 	kubeconfigRenewalSeconds    = 12 * 60 * 60
 	kubeconfigExpirationSeconds = 2 * kubeconfigRenewalSeconds
 	kubeconfigKey               = targettypes.DefaultKubeconfigKey
+	configMapKubeconfigKey      = "kubeconfig"
 )
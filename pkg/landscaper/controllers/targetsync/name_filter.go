@@ -8,19 +8,23 @@ import (
 	"fmt"
 	"regexp"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// nameFilter selects objects whose name matches a regular expression and whose labels match an optional label selector.
 type nameFilter struct {
 	nameExpression     string
 	compiledExpression *regexp.Regexp
+	labelSelector      labels.Selector
 }
 
 var _ predicate.Predicate = &nameFilter{}
 
-func newNameFilter(nameExpression string) (*nameFilter, error) {
+func newNameFilter(nameExpression string, labelSelector *metav1.LabelSelector) (*nameFilter, error) {
 	if nameExpression == "*" {
 		nameExpression = ".*"
 	}
@@ -30,14 +34,23 @@ func newNameFilter(nameExpression string) (*nameFilter, error) {
 		return nil, fmt.Errorf("invalid regular expression to filter names: %s", nameExpression)
 	}
 
+	selector := labels.Everything()
+	if labelSelector != nil {
+		selector, err = metav1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+	}
+
 	return &nameFilter{
 		nameExpression:     nameExpression,
 		compiledExpression: compiledExpression,
+		labelSelector:      selector,
 	}, nil
 }
 
 func (p *nameFilter) shouldBeProcessed(obj client.Object) bool {
-	return p.compiledExpression.MatchString(obj.GetName())
+	return p.compiledExpression.MatchString(obj.GetName()) && p.labelSelector.Matches(labels.Set(obj.GetLabels()))
 }
 
 // Create returns true if the Create event should be processed
@@ -40,6 +40,7 @@ import (
 	kutils "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 )
 
@@ -75,6 +76,7 @@ func NewTargetSyncController(logger logging.Logger, targetClient client.Client,
 
 // Reconcile reconciles requests for TargetSyncs
 func (c *TargetSyncController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	defer metrics.ObserveReconcileDuration(metrics.TargetSyncController, time.Now())
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
 
 	targetSync := &lsv1alpha1.TargetSync{}
@@ -112,11 +114,13 @@ func (c *TargetSyncController) Reconcile(ctx context.Context, req reconcile.Requ
 		}
 
 		if err != nil {
+			metrics.RecordError(metrics.TargetSyncController, err)
 			logger.Error(err, "reconciling targetsync object failed")
 			return reconcile.Result{Requeue: true}, nil
 		}
 	} else {
 		if err := c.handleDelete(ctx, targetSync); err != nil {
+			metrics.RecordError(metrics.TargetSyncController, err)
 			logger.Error(err, "deleting target sync object failed")
 			return reconcile.Result{Requeue: true}, nil
 		}
@@ -229,8 +233,9 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	errors := []error{}
 
-	if targetSync.Spec.SecretNameExpression != "" && targetSync.Spec.ShootNameExpression != "" {
-		msg := "a targetsync object with both, secretNameExpression and shootNameExpression, is not allowed"
+	if c.countNameExpressions(targetSync) > 1 {
+		msg := "a targetsync object with more than one of secretNameExpression, shootNameExpression, " +
+			"clusterNameExpression and configMapNameExpression is not allowed"
 		logger.Error(nil, msg)
 		errors = append(errors, fmt.Errorf(msg))
 		return errors
@@ -243,7 +248,7 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 	}
 
 	if targetSync.Spec.SecretNameExpression != "" {
-		secrFilter, err := newNameFilter(targetSync.Spec.SecretNameExpression)
+		secrFilter, err := newNameFilter(targetSync.Spec.SecretNameExpression, targetSync.Spec.LabelSelector)
 		if err != nil {
 			logger.Error(err, "building secret name filter of targetsync object failed: "+targetSync.Spec.SecretNameExpression)
 			errors = append(errors, err)
@@ -274,7 +279,7 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 	}
 
 	if targetSync.Spec.ShootNameExpression != "" {
-		shootFilter, err := newNameFilter(targetSync.Spec.ShootNameExpression)
+		shootFilter, err := newNameFilter(targetSync.Spec.ShootNameExpression, targetSync.Spec.LabelSelector)
 		if err != nil {
 			logger.Error(err, "building shoot name filter of targetsync object failed: "+targetSync.Spec.ShootNameExpression)
 			errors = append(errors, err)
@@ -312,6 +317,70 @@ func (c *TargetSyncController) handleSecretsAndShoots(ctx context.Context, targe
 		}
 	}
 
+	if targetSync.Spec.ClusterNameExpression != "" {
+		clusterFilter, err := newNameFilter(targetSync.Spec.ClusterNameExpression, targetSync.Spec.LabelSelector)
+		if err != nil {
+			logger.Error(err, "building cluster name filter of targetsync object failed: "+targetSync.Spec.ClusterNameExpression)
+			errors = append(errors, err)
+			return errors
+		}
+
+		clusterList, err := clusters.ListClusterAPIClusters(ctx, sourceClient, targetSync.Spec.SourceNamespace)
+		if err != nil {
+			logger.Error(err, "failed to list cluster api clusters for targetsync")
+			errors = append(errors, err)
+			return errors
+		}
+
+		for i := range clusterList.Items {
+			cluster := &clusterList.Items[i]
+			if clusterFilter.shouldBeProcessed(cluster) {
+				clusterLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(cluster).String())
+				clusterCtx := logging.NewContext(ctx, clusterLogger)
+
+				delete(oldTargets, cluster.GetName())
+
+				if err = c.handleCluster(clusterCtx, targetSync, sourceClient, cluster); err != nil {
+					msg := fmt.Sprintf("handling cluster %s of targetsync object failed", client.ObjectKeyFromObject(cluster).String())
+					clusterLogger.Error(err, msg)
+					errors = append(errors, err)
+				}
+			}
+		}
+	}
+
+	if targetSync.Spec.ConfigMapNameExpression != "" {
+		configMapFilter, err := newNameFilter(targetSync.Spec.ConfigMapNameExpression, targetSync.Spec.LabelSelector)
+		if err != nil {
+			logger.Error(err, "building configmap name filter of targetsync object failed: "+targetSync.Spec.ConfigMapNameExpression)
+			errors = append(errors, err)
+			return errors
+		}
+
+		configMaps := &corev1.ConfigMapList{}
+		if err = sourceClient.List(ctx, configMaps, client.InNamespace(targetSync.Spec.SourceNamespace)); err != nil {
+			logger.Error(err, "fetching configmap list for targetsync object failed")
+			errors = append(errors, err)
+			return errors
+		}
+
+		for i := range configMaps.Items {
+			configMap := &configMaps.Items[i]
+			if configMapFilter.shouldBeProcessed(configMap) {
+				configMapLogger := logger.WithValues(lc.KeyResource, client.ObjectKeyFromObject(configMap).String())
+				configMapCtx := logging.NewContext(ctx, configMapLogger)
+
+				delete(oldTargets, configMap.Name)
+
+				if err = c.handleConfigMap(configMapCtx, targetSync, configMap); err != nil {
+					msg := fmt.Sprintf("handling configmap %s of targetsync object failed", client.ObjectKeyFromObject(configMap).String())
+					configMapLogger.Error(err, msg)
+					errors = append(errors, err)
+				}
+			}
+		}
+	}
+
 	if targetSync.Spec.CreateTargetToSource {
 		targetName := targetSync.Spec.TargetToSourceName
 		if targetName == "" {
@@ -397,6 +466,66 @@ func (c *TargetSyncController) handleShoot(ctx context.Context, targetSync *lsv1
 	return nil
 }
 
+// handleCluster creates or updates the target and the secret for a Cluster API cluster.
+// The kubeconfig is copied on every sync, so that a kubeconfig renewed by Cluster API is also renewed in the target.
+func (c *TargetSyncController) handleCluster(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
+	sourceClient client.Client, cluster *unstructured.Unstructured) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	targetName := cluster.GetName()
+
+	if cluster.GetDeletionTimestamp() != nil {
+		logger.Info("targetsync for cluster skipped because the cluster is being deleted")
+		return nil
+	}
+
+	kubeconfig, err := clusters.GetClusterAPIKubeconfig(ctx, sourceClient, cluster)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("targetsync for cluster skipped because the kubeconfig secret does not yet exist")
+			return nil
+		}
+		msg := "targetsync for cluster failed to get kubeconfig"
+		logger.Error(err, msg)
+		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+	}
+
+	if err := c.createOrUpdateSecretWithKubeconfig(ctx, targetSync, targetName, kubeconfig); err != nil {
+		msg := "targetsync for cluster failed: could not create or update secret"
+		logger.Error(err, msg)
+		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+	}
+
+	if err := c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", false); err != nil {
+		msg := "targetsync for cluster failed: could not create or update target"
+		logger.Error(err, msg)
+		return fmt.Errorf("%s; target: %s, error: %w", msg, targetName, err)
+	}
+
+	return nil
+}
+
+// handleConfigMap creates or updates the target and the secret for a configmap containing a kubeconfig.
+func (c *TargetSyncController) handleConfigMap(ctx context.Context, targetSync *lsv1alpha1.TargetSync, configMap *corev1.ConfigMap) error {
+	targetName := configMap.GetName()
+
+	key := targetSync.Spec.ConfigMapKey
+	if key == "" {
+		key = configMapKubeconfigKey
+	}
+
+	kubeconfig, ok := configMap.Data[key]
+	if !ok || len(kubeconfig) == 0 {
+		return fmt.Errorf("configmap %s contains no kubeconfig in key %q", client.ObjectKeyFromObject(configMap).String(), key)
+	}
+
+	if err := c.createOrUpdateSecretWithKubeconfig(ctx, targetSync, targetName, []byte(kubeconfig)); err != nil {
+		return err
+	}
+
+	return c.createOrUpdateTarget(ctx, targetSync, targetName, "", "", false)
+}
+
 func (c *TargetSyncController) isRenewalOfShortLivedKubeconfigDue(ctx context.Context, targetName, targetNamespace string) (due bool, err error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
@@ -487,16 +616,22 @@ func (c *TargetSyncController) createOrUpdateSecret(ctx context.Context, targetS
 func (c *TargetSyncController) createOrUpdateSecretForShoot(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
 	targetName string, kubeconfig string) error {
 
-	newSecret := &corev1.Secret{
-		ObjectMeta: controllerruntime.ObjectMeta{Name: targetName, Namespace: targetSync.Namespace},
-	}
-
 	kubeconfigBytes, err := base64.StdEncoding.DecodeString(kubeconfig)
 	if err != nil {
 		return err
 	}
 
-	_, err = controllerruntime.CreateOrUpdate(ctx, c.targetClient, newSecret, func() error {
+	return c.createOrUpdateSecretWithKubeconfig(ctx, targetSync, targetName, kubeconfigBytes)
+}
+
+func (c *TargetSyncController) createOrUpdateSecretWithKubeconfig(ctx context.Context, targetSync *lsv1alpha1.TargetSync,
+	targetName string, kubeconfigBytes []byte) error {
+
+	newSecret := &corev1.Secret{
+		ObjectMeta: controllerruntime.ObjectMeta{Name: targetName, Namespace: targetSync.Namespace},
+	}
+
+	_, err := controllerruntime.CreateOrUpdate(ctx, c.targetClient, newSecret, func() error {
 		newSecret.ObjectMeta.Labels = map[string]string{
 			labelKeyTargetSync: labelValueOk,
 		}
@@ -727,6 +862,22 @@ func (c *TargetSyncController) deriveTargetNameFromShootName(shootName string) s
 	return shootName
 }
 
+// countNameExpressions returns the number of name expressions that are set in the targetsync object.
+func (c *TargetSyncController) countNameExpressions(targetSync *lsv1alpha1.TargetSync) int {
+	count := 0
+	for _, expression := range []string{
+		targetSync.Spec.SecretNameExpression,
+		targetSync.Spec.ShootNameExpression,
+		targetSync.Spec.ClusterNameExpression,
+		targetSync.Spec.ConfigMapNameExpression,
+	} {
+		if expression != "" {
+			count++
+		}
+	}
+	return count
+}
+
 func (c *TargetSyncController) isTargetSyncSecret(secretName string, targetSync *lsv1alpha1.TargetSync) bool {
 	return secretName == targetSync.Spec.SecretRef.Name
 }
@@ -214,6 +214,36 @@ var _ = Describe("TargetSync Controller", func() {
 			checkTargetAndSecretDoNotExist(ctx, secretName2)
 		})
 
+		It("should sync ConfigMaps matching the label selector", func() {
+			ctx := context.Background()
+
+			const (
+				targetSyncName = "test-target-sync"
+				configMapName1 = "cluster1.kubeconfig"
+				configMapName2 = "cluster2.kubeconfig"
+			)
+
+			var err error
+			state, err = testenv.InitResourcesWithTwoNamespaces(ctx, "./testdata/state/test3")
+			Expect(err).ToNot(HaveOccurred())
+
+			tgs := &lsv1alpha1.TargetSync{}
+			tgs.Name = targetSyncName
+			tgs.Namespace = state.Namespace
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(tgs), tgs))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(tgs))
+
+			checkTarget(ctx, configMapName1, configMapName1, targettypes.DefaultKubeconfigKey)
+			secret := &corev1.Secret{}
+			secret.Name = configMapName1
+			secret.Namespace = state.Namespace
+			testutils.ExpectNoError(state.Client.Get(ctx, kutil.ObjectKeyFromObject(secret), secret))
+			Expect(secret.Data).To(HaveKeyWithValue(targettypes.DefaultKubeconfigKey, []byte("dummy-kubeconfig-1")))
+
+			checkTargetAndSecretDoNotExist(ctx, configMapName2)
+		})
+
 		It("should not sync if there is more than one TargetSync object", func() {
 			ctx := context.Background()
 
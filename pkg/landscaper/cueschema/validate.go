@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cueschema
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	cueerrors "cuelang.org/go/cue/errors"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/mediatype"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
+)
+
+// inlineDefinitionName is the filename of inline CUE definitions that is used in error messages.
+const inlineDefinitionName = "definition.cue"
+
+// ReferenceContext describes the context that is used to resolve referenced CUE definitions.
+type ReferenceContext struct {
+	// BlueprintFs is the virtual filesystem that is used to resolve "blueprint" refs
+	BlueprintFs vfs.FileSystem
+	// ComponentVersion contains the current blueprint's component descriptor.
+	ComponentVersion model.ComponentVersion
+	// RepositoryContext can be used to overwrite the effective repository context of the component descriptor.
+	// If not set, the effective repository context of the ComponentDescriptor will be used.
+	RepositoryContext *types.UnstructuredTypedObject
+}
+
+// Validator validates and defaults values with a CUE definition.
+type Validator struct {
+	Context *ReferenceContext
+	Value   cue.Value
+}
+
+// NewValidator returns a new Validator with the given reference context.
+func NewValidator(context *ReferenceContext) *Validator {
+	if context == nil {
+		context = &ReferenceContext{}
+	}
+	return &Validator{
+		Context: context,
+	}
+}
+
+// ValidateGoStruct validates the given data with the given CUE definition.
+func ValidateGoStruct(def *lsv1alpha1.CueDefinition, data interface{}, context *ReferenceContext) error {
+	_, err := Complete(def, data, context)
+	return err
+}
+
+// Complete validates the given data with the given CUE definition and returns the data with the defaults of the definition applied.
+func Complete(def *lsv1alpha1.CueDefinition, data interface{}, context *ReferenceContext) (interface{}, error) {
+	v := NewValidator(context)
+	if err := v.CompileDefinition(def); err != nil {
+		return nil, err
+	}
+	return v.Complete(data)
+}
+
+// CompileDefinition compiles the given CUE definition and sets it as definition for the validator.
+func (v *Validator) CompileDefinition(def *lsv1alpha1.CueDefinition) error {
+	if def == nil {
+		return errors.New("no cue definition defined")
+	}
+	src, filename, err := v.loadDefinition(def)
+	if err != nil {
+		return err
+	}
+	value := cuecontext.New().CompileBytes(src, cue.Filename(filename))
+	if err := value.Err(); err != nil {
+		return fmt.Errorf("unable to compile cue definition: %w", formatError(err))
+	}
+	if len(def.Path) != 0 {
+		value = value.LookupPath(cue.ParsePath(def.Path))
+		if !value.Exists() {
+			return fmt.Errorf("path %q is not defined in the cue definition", def.Path)
+		}
+		if err := value.Err(); err != nil {
+			return fmt.Errorf("invalid path %q: %w", def.Path, formatError(err))
+		}
+	}
+	v.Value = value
+	return nil
+}
+
+// ValidateGoStruct validates the given data with the compiled definition.
+func (v *Validator) ValidateGoStruct(data interface{}) error {
+	_, err := v.Complete(data)
+	return err
+}
+
+// Complete validates the given data with the compiled definition and returns the data with the defaults of the definition applied.
+// The data has to be concrete after the defaults have been applied.
+func (v *Validator) Complete(data interface{}) (interface{}, error) {
+	if !v.Value.Exists() {
+		return nil, errors.New("internal error: cue definition has not been compiled")
+	}
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal data: %w", err)
+	}
+	// json is valid cue, compiling the json instead of encoding the go struct keeps integers as integers.
+	dataValue := v.Value.Context().CompileBytes(dataBytes)
+	if err := dataValue.Err(); err != nil {
+		return nil, fmt.Errorf("unable to convert data to cue: %w", formatError(err))
+	}
+
+	res := v.Value.Unify(dataValue)
+	if err := res.Validate(cue.Concrete(true), cue.Final()); err != nil {
+		return nil, formatError(err)
+	}
+	resBytes, err := res.MarshalJSON()
+	if err != nil {
+		return nil, formatError(err)
+	}
+	var completed interface{}
+	if err := json.Unmarshal(resBytes, &completed); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal completed data: %w", err)
+	}
+	return completed, nil
+}
+
+// loadDefinition returns the source and the filename of a CUE definition.
+func (v *Validator) loadDefinition(def *lsv1alpha1.CueDefinition) ([]byte, string, error) {
+	if len(def.Definition) != 0 {
+		return []byte(def.Definition), inlineDefinitionName, nil
+	}
+	if len(def.Ref) == 0 {
+		return nil, "", errors.New("either a definition or a ref has to be defined")
+	}
+	uri, err := url.Parse(def.Ref)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid ref %q: %w", def.Ref, err)
+	}
+	switch uri.Scheme {
+	case "blueprint":
+		return v.loadBlueprintDefinition(uri)
+	case "cd":
+		return v.loadComponentDescriptorDefinition(uri)
+	}
+	return nil, "", fmt.Errorf("unsupported scheme %q of ref %q", uri.Scheme, def.Ref)
+}
+
+func (v *Validator) loadBlueprintDefinition(uri *url.URL) ([]byte, string, error) {
+	if v.Context.BlueprintFs == nil {
+		return nil, "", errors.New("no filesystem defined to read a local cue definition")
+	}
+	filePath := filepath.Join(uri.Host, uri.Path)
+	data, err := vfs.ReadFile(v.Context.BlueprintFs, filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read local cue definition from %s: %w", filePath, err)
+	}
+	return data, filePath, nil
+}
+
+func (v *Validator) loadComponentDescriptorDefinition(uri *url.URL) ([]byte, string, error) {
+	if v.Context.ComponentVersion == nil {
+		return nil, "", errors.New("no component descriptor defined to resolve the ref")
+	}
+	cdUri, err := cdutils.ParseURI(uri.String())
+	if err != nil {
+		return nil, "", err
+	}
+	repositoryContext := v.Context.RepositoryContext
+	if repositoryContext == nil {
+		repositoryContext, err = v.Context.ComponentVersion.GetRepositoryContext()
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to get repository context: %w", err)
+		}
+	}
+	_, resource, err := cdUri.GetResource(v.Context.ComponentVersion, repositoryContext)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buf bytes.Buffer
+	info, err := resource.GetBlob(context.Background(), &buf)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to fetch cue definition for '%s': %w", uri.String(), err)
+	}
+
+	mt, err := mediatype.Parse(info.MediaType)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to parse media type %q: %w", info.MediaType, err)
+	}
+	if mt.Type != mediatype.CueArtifactsMediaTypeV1 {
+		return nil, "", fmt.Errorf("unknown media type %s expected %s", info.MediaType, mediatype.CueArtifactsMediaTypeV1)
+	}
+
+	if mt.IsCompressed(mediatype.GZipCompression) {
+		var decompressed bytes.Buffer
+		r, err := gzip.NewReader(&buf)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to decompress cue definition: %w", err)
+		}
+		if _, err := io.Copy(&decompressed, r); err != nil {
+			return nil, "", fmt.Errorf("unable to decompress cue definition: %w", err)
+		}
+		return decompressed.Bytes(), uri.String(), nil
+	}
+	return buf.Bytes(), uri.String(), nil
+}
+
+// formatError converts a cue error into a single error that contains all error messages.
+func formatError(err error) error {
+	errs := cueerrors.Errors(err)
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
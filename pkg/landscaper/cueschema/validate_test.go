@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cueschema_test
+
+import (
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/cueschema"
+)
+
+var _ = Describe("cue schema", func() {
+
+	haDefinition := `
+#Config: {
+	ha:       bool | *false
+	replicas: int & >=1 | *1
+	if ha {
+		replicas: >=3
+	}
+}
+`
+
+	It("should validate data with an inline definition", func() {
+		def := &lsv1alpha1.CueDefinition{Definition: `replicas: int`}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"replicas": 3}, nil)).To(Succeed())
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"replicas": "3"}, nil)).ToNot(Succeed())
+	})
+
+	It("should validate cross-field constraints", func() {
+		def := &lsv1alpha1.CueDefinition{Definition: haDefinition, Path: "#Config"}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"ha": true, "replicas": 3}, nil)).To(Succeed())
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"ha": false, "replicas": 1}, nil)).To(Succeed())
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"ha": true, "replicas": 1}, nil)).ToNot(Succeed())
+	})
+
+	It("should apply the defaults of the definition", func() {
+		def := &lsv1alpha1.CueDefinition{Definition: haDefinition, Path: "#Config"}
+		res, err := cueschema.Complete(def, map[string]interface{}{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(map[string]interface{}{"ha": false, "replicas": float64(1)}))
+	})
+
+	It("should forbid fields that are not defined by a closed definition", func() {
+		def := &lsv1alpha1.CueDefinition{Definition: haDefinition, Path: "#Config"}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"unknown": true}, nil)).ToNot(Succeed())
+	})
+
+	It("should fail if data is not concrete", func() {
+		def := &lsv1alpha1.CueDefinition{Definition: `name: string`}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{}, nil)).ToNot(Succeed())
+	})
+
+	It("should fail if the path is not defined", func() {
+		def := &lsv1alpha1.CueDefinition{Definition: haDefinition, Path: "#Other"}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{}, nil)).ToNot(Succeed())
+	})
+
+	It("should read a definition from the blueprint filesystem", func() {
+		fs := memoryfs.New()
+		Expect(fs.MkdirAll("schemas", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "schemas/config.cue", []byte(haDefinition), 0600)).To(Succeed())
+
+		def := &lsv1alpha1.CueDefinition{Ref: "blueprint://schemas/config.cue", Path: "#Config"}
+		refCtx := &cueschema.ReferenceContext{BlueprintFs: fs}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"ha": true, "replicas": 3}, refCtx)).To(Succeed())
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{"ha": true, "replicas": 2}, refCtx)).ToNot(Succeed())
+	})
+
+	It("should fail if a definition of the blueprint filesystem does not exist", func() {
+		def := &lsv1alpha1.CueDefinition{Ref: "blueprint://schemas/config.cue"}
+		refCtx := &cueschema.ReferenceContext{BlueprintFs: memoryfs.New()}
+		Expect(cueschema.ValidateGoStruct(def, map[string]interface{}{}, refCtx)).ToNot(Succeed())
+	})
+
+})
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// ApprovalPendingReason is the reason of the approval condition if deploy items wait for an approval.
+	ApprovalPendingReason = "WaitingForApproval"
+	// NoApprovalPendingReason is the reason of the approval condition if no deploy items wait for an approval.
+	NoApprovalPendingReason = "NoApprovalPending"
+	// DeployItemApprovedReason is the reason of the event that is emitted when a deploy item has been approved.
+	DeployItemApprovedReason = "DeployItemApproved"
+)
+
+// ApprovalAnnotation returns the annotation of an execution that approves the deploy item with the given name.
+func ApprovalAnnotation(deployItemName string) string {
+	return lsv1alpha1.ApprovalAnnotationPrefix + deployItemName
+}
+
+// checkApprovals holds back the runnable items that require an approval which has not been given for the current job.
+// Approvals are given by the approval annotation of the execution. They are recorded in the status of the execution
+// and the consumed annotations are removed, so that every job has to be approved again.
+func (o *Operation) checkApprovals(ctx context.Context, c *DeployItemClassification) lserrors.LsError {
+	op := "CheckApprovals"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	jobID := o.exec.Status.JobID
+	hasNewApprovals := false
+	c.holdItemsAwaitingApproval(func(item *executionItem) bool {
+		if approval := getApproval(o.exec.Status.Approvals, item.Info.Name); approval != nil && approval.JobID == jobID {
+			return true
+		}
+		approver := o.exec.GetAnnotations()[ApprovalAnnotation(item.Info.Name)]
+		if len(approver) == 0 {
+			return false
+		}
+
+		o.exec.Status.Approvals = setApproval(o.exec.Status.Approvals, lsv1alpha1.DeployItemApproval{
+			Name:         item.Info.Name,
+			ApprovedBy:   approver,
+			ApprovalTime: metav1.Now(),
+			JobID:        jobID,
+		})
+		hasNewApprovals = true
+
+		msg := fmt.Sprintf("deploy item %q has been approved by %q", item.Info.Name, approver)
+		logger.Info(msg)
+		o.EventRecorder().Event(o.exec, corev1.EventTypeNormal, DeployItemApprovedReason, msg)
+		return true
+	})
+
+	if hasNewApprovals {
+		if err := o.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000157, o.exec); err != nil {
+			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+		}
+	}
+
+	// remove the annotations of the approvals that have been consumed by the current job
+	hasConsumedAnnotations := false
+	for _, approval := range o.exec.Status.Approvals {
+		annotation := ApprovalAnnotation(approval.Name)
+		if approval.JobID == jobID && o.exec.GetAnnotations()[annotation] == approval.ApprovedBy {
+			delete(o.exec.Annotations, annotation)
+			hasConsumedAnnotations = true
+		}
+	}
+	if hasConsumedAnnotations {
+		if err := o.Writer().UpdateExecution(ctx, read_write_layer.W000158, o.exec); err != nil {
+			return lserrors.NewWrappedError(err, op, "RemoveApprovalAnnotations", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// updateApprovalCondition sets the approval condition of the execution.
+// The condition is persisted with the next status update of the execution.
+// The installation controller propagates the condition to the installation of the execution and its parents.
+func (o *Operation) updateApprovalCondition(c *DeployItemClassification) {
+	cond := lsv1alpha1helper.GetCondition(o.exec.Status.Conditions, lsv1alpha1.ApprovalPendingCondition)
+	var newCond lsv1alpha1.Condition
+	if c.HasItemsAwaitingApproval() {
+		msg := fmt.Sprintf("deploy items %s of execution %s/%s wait for an approval, approve them with the annotation %q",
+			strings.Join(c.GetNamesOfItemsAwaitingApproval(), ", "), o.exec.Namespace, o.exec.Name,
+			ApprovalAnnotation("<deploy item name>")+": <approver>")
+		newCond = lsv1alpha1helper.UpdatedCondition(lsv1alpha1helper.GetOrInitCondition(o.exec.Status.Conditions, lsv1alpha1.ApprovalPendingCondition),
+			lsv1alpha1.ConditionTrue, ApprovalPendingReason, msg)
+	} else {
+		if cond == nil || cond.Status == lsv1alpha1.ConditionFalse {
+			return
+		}
+		newCond = lsv1alpha1helper.UpdatedCondition(*cond, lsv1alpha1.ConditionFalse, NoApprovalPendingReason,
+			"no deploy items wait for an approval")
+	}
+
+	if cond != nil && cond.Status == newCond.Status && cond.Message == newCond.Message {
+		return
+	}
+	o.exec.Status.Conditions = lsv1alpha1helper.MergeConditions(o.exec.Status.Conditions, newCond)
+}
+
+func getApproval(approvals []lsv1alpha1.DeployItemApproval, name string) *lsv1alpha1.DeployItemApproval {
+	for i := range approvals {
+		if approvals[i].Name == name {
+			return &approvals[i]
+		}
+	}
+	return nil
+}
+
+func setApproval(approvals []lsv1alpha1.DeployItemApproval, approval lsv1alpha1.DeployItemApproval) []lsv1alpha1.DeployItemApproval {
+	for i := range approvals {
+		if approvals[i].Name == approval.Name {
+			approvals[i] = approval
+			return approvals
+		}
+	}
+	return append(approvals, approval)
+}
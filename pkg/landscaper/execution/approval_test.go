@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Approval", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		inst       *lsv1alpha1.Installation
+		exec       *lsv1alpha1.Execution
+	)
+
+	buildRunnableItem := func(name string, requiresApproval bool) *executionItem {
+		return &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name:             name,
+				RequiresApproval: requiresApproval,
+			},
+			DeployItem: &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Status: lsv1alpha1.DeployItemStatus{
+					JobID:         "01",
+					JobIDFinished: "01",
+				},
+			},
+		}
+	}
+
+	newOperation := func() *Operation {
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		return NewOperation(operation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024)), exec, false)
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = "default"
+		exec = &lsv1alpha1.Execution{}
+		exec.Name = "root"
+		exec.Namespace = "default"
+		exec.Status.JobID = "02"
+		Expect(controllerutil.SetControllerReference(inst, exec, api.LandscaperScheme)).To(Succeed())
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst, exec).Build()
+	})
+
+	It("should hold back items that are not approved and set the approval condition", func() {
+		items := []*executionItem{buildRunnableItem("a", false), buildRunnableItem("b", true)}
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+
+		o := newOperation()
+		Expect(o.checkApprovals(ctx, classification)).To(Succeed())
+		Expect(classification.GetRunnableItems()).To(ConsistOf(items[0]))
+		Expect(classification.GetNamesOfItemsAwaitingApproval()).To(ConsistOf("b"))
+
+		o.updateApprovalCondition(classification)
+		cond := lsv1alpha1helper.GetCondition(exec.Status.Conditions, lsv1alpha1.ApprovalPendingCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+		Expect(cond.Message).To(ContainSubstring("b"))
+
+		By("not writing the status of the installation")
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(inst.Status.Conditions).To(BeEmpty())
+
+		By("resetting the condition if no items wait for an approval")
+		classification, err = newDeployItemClassification("02", items[:1])
+		Expect(err).NotTo(HaveOccurred())
+		o.updateApprovalCondition(classification)
+		cond = lsv1alpha1helper.GetCondition(exec.Status.Conditions, lsv1alpha1.ApprovalPendingCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+	})
+
+	It("should consume an approval annotation and record the approver", func() {
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, ApprovalAnnotation("b"), "alice")
+		Expect(kubeClient.Update(ctx, exec)).To(Succeed())
+
+		items := []*executionItem{buildRunnableItem("a", false), buildRunnableItem("b", true)}
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+
+		o := newOperation()
+		Expect(o.checkApprovals(ctx, classification)).To(Succeed())
+		Expect(classification.GetRunnableItems()).To(ConsistOf(items[0], items[1]))
+		Expect(classification.HasItemsAwaitingApproval()).To(BeFalse())
+
+		res := &lsv1alpha1.Execution{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(exec), res)).To(Succeed())
+		Expect(res.Annotations).ToNot(HaveKey(ApprovalAnnotation("b")))
+		Expect(res.Status.Approvals).To(HaveLen(1))
+		Expect(res.Status.Approvals[0].Name).To(Equal("b"))
+		Expect(res.Status.Approvals[0].ApprovedBy).To(Equal("alice"))
+		Expect(res.Status.Approvals[0].JobID).To(Equal("02"))
+
+		By("not requiring another approval in the same job")
+		classification, err = newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().checkApprovals(ctx, classification)).To(Succeed())
+		Expect(classification.HasItemsAwaitingApproval()).To(BeFalse())
+
+		By("requiring a new approval in the next job")
+		exec.Status.JobID = "03"
+		Expect(kubeClient.Status().Update(ctx, exec)).To(Succeed())
+		classification, err = newDeployItemClassification("03", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().checkApprovals(ctx, classification)).To(Succeed())
+		Expect(classification.GetNamesOfItemsAwaitingApproval()).To(ConsistOf("b"))
+	})
+
+})
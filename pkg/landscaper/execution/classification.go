@@ -12,14 +12,22 @@ import (
 // - running items:   they have the same jobID as the execution, but are unfinished
 // - succeeded items: they have the same jobID as the execution, are finished and succeeded
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
+// - dry-run items:   they have the same jobID as the execution, are finished and have only performed a dry-run
 // - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
-// - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - pending items:   they have an old jobID, which can not be updated because of pending or dry-run dependencies
+// - items awaiting approval: they would be runnable, but require a manual approval that has not yet been given
+// - items waiting for retry: they would be failed, but are retried according to their retry policy
+// - throttled items: they would be runnable, but are not started because the maximum number of parallel deploy items is reached
 type DeployItemClassification struct {
-	runningItems   []*executionItem
-	succeededItems []*executionItem
-	failedItems    []*executionItem
-	runnableItems  []*executionItem
-	pendingItems   []*executionItem
+	runningItems          []*executionItem
+	succeededItems        []*executionItem
+	failedItems           []*executionItem
+	dryRunItems           []*executionItem
+	runnableItems         []*executionItem
+	pendingItems          []*executionItem
+	awaitingApprovalItems []*executionItem
+	waitingForRetryItems  []*executionItem
+	throttledItems        []*executionItem
 }
 
 func (c *DeployItemClassification) HasRunningItems() bool {
@@ -34,6 +42,10 @@ func (c *DeployItemClassification) HasFailedItems() bool {
 	return len(c.failedItems) > 0
 }
 
+func (c *DeployItemClassification) HasDryRunItems() bool {
+	return len(c.dryRunItems) > 0
+}
+
 func (c *DeployItemClassification) HasRunnableItems() bool {
 	return len(c.runnableItems) > 0
 }
@@ -42,14 +54,98 @@ func (c *DeployItemClassification) HasPendingItems() bool {
 	return len(c.pendingItems) > 0
 }
 
+func (c *DeployItemClassification) HasItemsAwaitingApproval() bool {
+	return len(c.awaitingApprovalItems) > 0
+}
+
+func (c *DeployItemClassification) HasItemsWaitingForRetry() bool {
+	return len(c.waitingForRetryItems) > 0
+}
+
+func (c *DeployItemClassification) HasThrottledItems() bool {
+	return len(c.throttledItems) > 0
+}
+
 func (c *DeployItemClassification) AllSucceeded() bool {
-	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
+	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasDryRunItems() && !c.HasRunnableItems() && !c.HasPendingItems() &&
+		!c.HasItemsAwaitingApproval() && !c.HasItemsWaitingForRetry() && !c.HasThrottledItems()
 }
 
 func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
 	return c.runnableItems
 }
 
+// GetNamesOfDryRunItems returns the names of the deploy item templates whose deploy items have only performed a dry-run.
+func (c *DeployItemClassification) GetNamesOfDryRunItems() []string {
+	names := make([]string, len(c.dryRunItems))
+	for i, item := range c.dryRunItems {
+		names[i] = item.Info.Name
+	}
+	return names
+}
+
+// GetNamesOfItemsAwaitingApproval returns the names of the deploy item templates that wait for an approval.
+func (c *DeployItemClassification) GetNamesOfItemsAwaitingApproval() []string {
+	names := make([]string, len(c.awaitingApprovalItems))
+	for i, item := range c.awaitingApprovalItems {
+		names[i] = item.Info.Name
+	}
+	return names
+}
+
+// holdItemsAwaitingApproval moves the runnable items that require an approval which has not been given
+// to the items awaiting approval.
+func (c *DeployItemClassification) holdItemsAwaitingApproval(isApproved func(item *executionItem) bool) {
+	runnableItems := []*executionItem{}
+	for _, item := range c.runnableItems {
+		if item.Info.RequiresApproval && !isApproved(item) {
+			c.awaitingApprovalItems = append(c.awaitingApprovalItems, item)
+			continue
+		}
+		runnableItems = append(runnableItems, item)
+	}
+	c.runnableItems = runnableItems
+}
+
+// holdItemsWaitingForRetry moves the failed items that are retried to the items waiting for retry.
+func (c *DeployItemClassification) holdItemsWaitingForRetry(isRetried func(item *executionItem) bool) {
+	failedItems := []*executionItem{}
+	for _, item := range c.failedItems {
+		if isRetried(item) {
+			c.waitingForRetryItems = append(c.waitingForRetryItems, item)
+			continue
+		}
+		failedItems = append(failedItems, item)
+	}
+	c.failedItems = failedItems
+}
+
+// holdThrottledItems moves the runnable items that must not be started yet to the throttled items.
+// The runnable items are checked in their order, so that the function can keep track of the started items.
+func (c *DeployItemClassification) holdThrottledItems(mayStart func(item *executionItem) bool) {
+	runnableItems := []*executionItem{}
+	for _, item := range c.runnableItems {
+		if !mayStart(item) {
+			c.throttledItems = append(c.throttledItems, item)
+			continue
+		}
+		runnableItems = append(runnableItems, item)
+	}
+	c.runnableItems = runnableItems
+}
+
+// numberOfActiveItems returns the number of items that are currently processed by a deployer.
+// This includes the running items and the items waiting for retry whose retry has already been triggered.
+func (c *DeployItemClassification) numberOfActiveItems() int {
+	n := len(c.runningItems)
+	for _, item := range c.waitingForRetryItems {
+		if item.DeployItem != nil && item.DeployItem.Status.GetJobID() != item.DeployItem.Status.JobIDFinished {
+			n++
+		}
+	}
+	return n
+}
+
 func newDeployItemClassification(executionJobID string, items []*executionItem) (*DeployItemClassification, lserrors.LsError) {
 	c := &DeployItemClassification{
 		runningItems:   []*executionItem{},
@@ -72,6 +168,8 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 				c.runningItems = append(c.runningItems, item)
 			} else if item.DeployItem.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded {
 				c.succeededItems = append(c.succeededItems, item)
+			} else if item.DeployItem.Status.Phase == lsv1alpha1.DeployItemPhases.DryRunSucceeded {
+				c.dryRunItems = append(c.dryRunItems, item)
 			} else {
 				c.failedItems = append(c.failedItems, item)
 			}
@@ -108,6 +206,11 @@ func isItemRunnable(executionJobID string, item *executionItem, items []*executi
 		if dependentItem.DeployItem == nil || dependentItem.DeployItem.Status.JobIDFinished != executionJobID {
 			return false, nil
 		}
+
+		// nothing has been deployed by a dry-run, so that the items depending on it must not be started
+		if dependentItem.DeployItem.Status.Phase == lsv1alpha1.DeployItemPhases.DryRunSucceeded {
+			return false, nil
+		}
 	}
 
 	return true, nil
@@ -84,6 +84,52 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
 
+	It("should not start items that depend on dry-run items", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.DryRunSucceeded),
+			buildExecutionItem("b", []string{"a"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.dryRunItems).To(ConsistOf(items[0]))
+		Expect(classification.succeededItems).To(BeEmpty())
+		Expect(classification.failedItems).To(BeEmpty())
+		Expect(classification.runnableItems).To(ConsistOf(items[2]))
+		Expect(classification.pendingItems).To(ConsistOf(items[1]))
+		Expect(classification.GetNamesOfDryRunItems()).To(ConsistOf("a"))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+	})
+
+	It("should hold back runnable items that are not approved", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("b", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("d", []string{"c"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[1].Info.RequiresApproval = true
+		items[2].Info.RequiresApproval = true
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		classification.holdItemsAwaitingApproval(func(item *executionItem) bool {
+			return item.Info.Name == "b"
+		})
+
+		Expect(classification.runnableItems).To(ConsistOf(items[0], items[1]))
+		Expect(classification.awaitingApprovalItems).To(ConsistOf(items[2]))
+		Expect(classification.pendingItems).To(ConsistOf(items[3]))
+		Expect(classification.GetNamesOfItemsAwaitingApproval()).To(ConsistOf("c"))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+	})
+
 	It("should classify execution items for delete", func() {
 		currJobID := "02"
 		prevJobID := "01"
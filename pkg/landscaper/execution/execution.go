@@ -29,6 +29,8 @@ type Operation struct {
 	*operation.Operation
 	exec           *lsv1alpha1.Execution
 	forceReconcile bool
+
+	maxParallelDeployItemsPerTarget *int32
 }
 
 // NewOperation creates a new execution operations
@@ -40,6 +42,13 @@ func NewOperation(op *operation.Operation, exec *lsv1alpha1.Execution, forceReco
 	}
 }
 
+// SetMaxParallelDeployItemsPerTarget sets the maximum number of deploy items that are processed in parallel
+// for the same target. Nil means that the number is not limited.
+func (o *Operation) SetMaxParallelDeployItemsPerTarget(maxParallelDeployItemsPerTarget *int32) *Operation {
+	o.maxParallelDeployItemsPerTarget = maxParallelDeployItemsPerTarget
+	return o
+}
+
 func (o *Operation) UpdateDeployItems(ctx context.Context) lserrors.LsError {
 	op := "UpdateDeployItems"
 
@@ -105,8 +114,20 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 		return nil, lsErr
 	}
 
+	if err := o.retryFailedItems(ctx, classification); err != nil {
+		return nil, err
+	}
+
 	// Start the runnable items, provided there are no failed items
 	if !classification.HasFailedItems() {
+		if err := o.checkApprovals(ctx, classification); err != nil {
+			return nil, err
+		}
+
+		if err := o.limitParallelDeployItems(ctx, classification); err != nil {
+			return nil, err
+		}
+
 		runnableItems := classification.GetRunnableItems()
 		for _, item := range runnableItems {
 			if err := o.triggerDeployItem(ctx, item.DeployItem); err != nil {
@@ -115,6 +136,8 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 		}
 	}
 
+	o.updateApprovalCondition(classification)
+
 	return classification, nil
 }
 
@@ -143,6 +166,10 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 
 	// Start the runnable items, provided there are no failed items
 	if !classification.HasFailedItems() {
+		if err := o.limitParallelDeployItems(ctx, classification); err != nil {
+			return nil, err
+		}
+
 		deletableItems := classification.GetRunnableItems()
 		for _, item := range deletableItems {
 			skip, err := o.skipUninstall(ctx, item.DeployItem)
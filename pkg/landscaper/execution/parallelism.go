@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// limitParallelDeployItems holds back the runnable items that would exceed the maximum number of parallel deploy items
+// of the execution or of their target. The throttled items are started by a later reconcile
+// as soon as running deploy items have finished.
+func (o *Operation) limitParallelDeployItems(ctx context.Context, c *DeployItemClassification) lserrors.LsError {
+	op := "LimitParallelDeployItems"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	maxPerExecution := o.exec.Spec.MaxParallelDeployItems
+	maxPerTarget := o.maxParallelDeployItemsPerTarget
+	if maxPerExecution == nil && maxPerTarget == nil {
+		return nil
+	}
+
+	activeItems := c.numberOfActiveItems()
+	activeItemsPerTarget := map[types.NamespacedName]int{}
+
+	var lsErr lserrors.LsError
+	c.holdThrottledItems(func(item *executionItem) bool {
+		if lsErr != nil {
+			return false
+		}
+		if maxPerExecution != nil && activeItems >= int(*maxPerExecution) {
+			return false
+		}
+
+		target := getTargetKey(item.DeployItem)
+		if maxPerTarget != nil && target != nil {
+			n, ok := activeItemsPerTarget[*target]
+			if !ok {
+				var err error
+				n, err = o.countActiveDeployItemsOfTarget(ctx, *target)
+				if err != nil {
+					lsErr = lserrors.NewWrappedError(err, op, "CountActiveDeployItemsOfTarget", err.Error())
+					return false
+				}
+			}
+			if n >= int(*maxPerTarget) {
+				activeItemsPerTarget[*target] = n
+				return false
+			}
+			activeItemsPerTarget[*target] = n + 1
+		}
+
+		activeItems++
+		return true
+	})
+	if lsErr != nil {
+		return lsErr
+	}
+
+	if c.HasThrottledItems() {
+		logger.Info("maximum number of parallel deploy items reached, deferring runnable deploy items",
+			"throttledDeployItems", len(c.throttledItems))
+	}
+	return nil
+}
+
+// countActiveDeployItemsOfTarget returns the number of deploy items of all executions that reference the given target
+// and whose current job is not yet finished.
+func (o *Operation) countActiveDeployItemsOfTarget(ctx context.Context, target types.NamespacedName) (int, error) {
+	deployItems := &lsv1alpha1.DeployItemList{}
+	if err := read_write_layer.ListDeployItems(ctx, o.Client(), deployItems, client.InNamespace(target.Namespace)); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for i := range deployItems.Items {
+		di := &deployItems.Items[i]
+		if t := getTargetKey(di); t != nil && *t == target && di.Status.GetJobID() != di.Status.JobIDFinished {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// getTargetKey returns the key of the target of a deploy item, or nil if the deploy item has no target.
+// Targets without namespace are located in the namespace of the deploy item.
+func getTargetKey(di *lsv1alpha1.DeployItem) *types.NamespacedName {
+	if di == nil || di.Spec.Target == nil || len(di.Spec.Target.Name) == 0 {
+		return nil
+	}
+	key := di.Spec.Target.NamespacedName()
+	if len(key.Namespace) == 0 {
+		key.Namespace = di.Namespace
+	}
+	return &key
+}
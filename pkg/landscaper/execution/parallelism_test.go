@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Parallel Deploy Items", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		exec       *lsv1alpha1.Execution
+	)
+
+	buildItem := func(name, target, jobID, jobIDFinished string) *executionItem {
+		return &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name: name,
+			},
+			DeployItem: &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: lsv1alpha1.DeployItemSpec{
+					Target: &lsv1alpha1.ObjectReference{Name: target, Namespace: "default"},
+				},
+				Status: lsv1alpha1.DeployItemStatus{
+					Phase:         lsv1alpha1.DeployItemPhases.Succeeded,
+					JobID:         jobID,
+					JobIDFinished: jobIDFinished,
+				},
+			},
+		}
+	}
+
+	setup := func(objects ...client.Object) {
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(objects...).Build()
+	}
+
+	newOperation := func(maxParallelDeployItemsPerTarget *int32) *Operation {
+		return NewOperation(operation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024)), exec, false).
+			SetMaxParallelDeployItemsPerTarget(maxParallelDeployItemsPerTarget)
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+
+		exec = &lsv1alpha1.Execution{}
+		exec.Name = "root"
+		exec.Namespace = "default"
+		exec.Status.JobID = "02"
+	})
+
+	It("should start all runnable items if no limit is defined", func() {
+		items := []*executionItem{
+			buildItem("a", "target-1", "01", "01"),
+			buildItem("b", "target-1", "01", "01"),
+		}
+		setup(exec, items[0].DeployItem, items[1].DeployItem)
+
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation(nil).limitParallelDeployItems(ctx, classification)).To(Succeed())
+		Expect(classification.GetRunnableItems()).To(ConsistOf(items[0], items[1]))
+		Expect(classification.HasThrottledItems()).To(BeFalse())
+	})
+
+	It("should start no more items than the limit of the execution allows", func() {
+		exec.Spec.MaxParallelDeployItems = pointer.Int32(2)
+		items := []*executionItem{
+			buildItem("a", "target-1", "02", "01"),
+			buildItem("b", "target-1", "01", "01"),
+			buildItem("c", "target-2", "01", "01"),
+		}
+		setup(exec, items[0].DeployItem, items[1].DeployItem, items[2].DeployItem)
+
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation(nil).limitParallelDeployItems(ctx, classification)).To(Succeed())
+		Expect(classification.GetRunnableItems()).To(ConsistOf(items[1]))
+		Expect(classification.throttledItems).To(ConsistOf(items[2]))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+	})
+
+	It("should start no more items than the limit per target allows", func() {
+		// a deploy item of another execution that is still running on target-1
+		other := buildItem("other", "target-1", "07", "06")
+		items := []*executionItem{
+			buildItem("a", "target-1", "01", "01"),
+			buildItem("b", "target-1", "01", "01"),
+			buildItem("c", "target-2", "01", "01"),
+			buildItem("d", "target-2", "01", "01"),
+		}
+		setup(exec, other.DeployItem, items[0].DeployItem, items[1].DeployItem, items[2].DeployItem, items[3].DeployItem)
+
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation(pointer.Int32(2)).limitParallelDeployItems(ctx, classification)).To(Succeed())
+		Expect(classification.GetRunnableItems()).To(ConsistOf(items[0], items[2], items[3]))
+		Expect(classification.throttledItems).To(ConsistOf(items[1]))
+	})
+})
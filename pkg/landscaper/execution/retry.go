@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DeployItemRetriedReason is the reason of the event that is emitted when a failed deploy item is retried.
+	DeployItemRetriedReason = "DeployItemRetried"
+
+	// interruptOperation is the operation of the error of deploy items that have been interrupted.
+	// Interrupted deploy items are never retried.
+	interruptOperation = "InterruptOperation"
+)
+
+var defaultRetryIntervalForFailedDeployItems = time.Minute
+
+// retryFailedItems retries the failed items of the current job whose retry policy allows a retry.
+// The first retry is triggered immediately, subsequent retries after the configured interval.
+// Retried items and items whose next retry is not yet due are moved to the items waiting for a retry.
+func (o *Operation) retryFailedItems(ctx context.Context, c *DeployItemClassification) lserrors.LsError {
+	op := "RetryFailedItems"
+
+	var lsErr lserrors.LsError
+	hasNewRetries := false
+	c.holdItemsWaitingForRetry(func(item *executionItem) bool {
+		if lsErr != nil {
+			return false
+		}
+		waiting, retried, err := o.retryFailedItem(ctx, item)
+		if err != nil {
+			lsErr = err
+			return false
+		}
+		hasNewRetries = hasNewRetries || retried
+		return waiting
+	})
+	if lsErr != nil {
+		return lsErr
+	}
+
+	if hasNewRetries {
+		if err := o.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000162, o.exec); err != nil {
+			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// retryFailedItem checks whether a failed item is retried and triggers the retry if it is due.
+// It returns whether the item waits for a retry and whether a retry has been triggered.
+func (o *Operation) retryFailedItem(ctx context.Context, item *executionItem) (bool, bool, lserrors.LsError) {
+	op := "RetryFailedItem"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	policy := item.Info.Retry
+	if policy == nil || item.DeployItem == nil {
+		return false, false, nil
+	}
+
+	lastError := item.DeployItem.Status.GetLastError()
+	if lastError != nil && lastError.Operation == interruptOperation {
+		return false, false, nil
+	}
+
+	action := lsutil.GetRetryAction(policy.Rules, lastError)
+	if action == lsv1alpha1.RetryActionNever {
+		return false, false, nil
+	}
+
+	jobID := o.exec.Status.JobID
+	numberOfRetries := 0
+	retryStatus := getRetryStatus(o.exec.Status.Retries, item.Info.Name)
+	if retryStatus != nil && retryStatus.JobID == jobID {
+		numberOfRetries = retryStatus.NumberOfRetries
+	}
+
+	if action != lsv1alpha1.RetryActionAlways && policy.NumberOfRetries != nil && numberOfRetries >= *policy.NumberOfRetries {
+		return false, false, nil
+	}
+
+	if numberOfRetries > 0 {
+		interval := defaultRetryIntervalForFailedDeployItems
+		if policy.Interval != nil {
+			interval = policy.Interval.Duration
+		}
+		interval = lsutil.GetRetryInterval(interval, policy.Backoff, numberOfRetries, string(item.DeployItem.GetUID()))
+		if time.Now().Before(retryStatus.LastRetryTime.Add(interval)) {
+			// too early
+			return true, false, nil
+		}
+	}
+
+	// reset the finished job of the deploy item, so that the deployer processes the current job again
+	di := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, o.Client(), kutil.ObjectKeyFromObject(item.DeployItem), di); err != nil {
+		return false, false, lserrors.NewWrappedError(err, op, "GetDeployItem", err.Error())
+	}
+	di.Status.JobIDFinished = ""
+	if err := o.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000161, di); err != nil {
+		return false, false, lserrors.NewWrappedError(err, op, "UpdateDeployItemStatus", err.Error())
+	}
+	item.DeployItem = di
+
+	o.exec.Status.Retries = setRetryStatus(o.exec.Status.Retries, lsv1alpha1.DeployItemRetryStatus{
+		Name:            item.Info.Name,
+		JobID:           jobID,
+		NumberOfRetries: numberOfRetries + 1,
+		LastRetryTime:   metav1.Now(),
+	})
+
+	msg := fmt.Sprintf("retrying failed deploy item %q (retry %d)", item.Info.Name, numberOfRetries+1)
+	logger.Info(msg)
+	o.EventRecorder().Event(o.exec, corev1.EventTypeNormal, DeployItemRetriedReason, msg)
+	return true, true, nil
+}
+
+func getRetryStatus(retries []lsv1alpha1.DeployItemRetryStatus, name string) *lsv1alpha1.DeployItemRetryStatus {
+	for i := range retries {
+		if retries[i].Name == name {
+			return &retries[i]
+		}
+	}
+	return nil
+}
+
+func setRetryStatus(retries []lsv1alpha1.DeployItemRetryStatus, retry lsv1alpha1.DeployItemRetryStatus) []lsv1alpha1.DeployItemRetryStatus {
+	for i := range retries {
+		if retries[i].Name == retry.Name {
+			retries[i] = retry
+			return retries
+		}
+	}
+	return append(retries, retry)
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Retry", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		exec       *lsv1alpha1.Execution
+	)
+
+	buildFailedItem := func(name string, retry *lsv1alpha1.DeployItemRetryPolicy, codes ...lsv1alpha1.ErrorCode) *executionItem {
+		return &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name:  name,
+				Retry: retry,
+			},
+			DeployItem: &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Status: lsv1alpha1.DeployItemStatus{
+					Phase:         lsv1alpha1.DeployItemPhases.Failed,
+					JobID:         "02",
+					JobIDFinished: "02",
+					LastError: &lsv1alpha1.Error{
+						Operation: "Reconcile",
+						Reason:    "Apply",
+						Codes:     codes,
+					},
+				},
+			},
+		}
+	}
+
+	newOperation := func() *Operation {
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		return NewOperation(operation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024)), exec, false)
+	}
+
+	setup := func(items ...*executionItem) {
+		objects := []client.Object{exec}
+		for _, item := range items {
+			objects = append(objects, item.DeployItem)
+		}
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(objects...).Build()
+	}
+
+	// failAgain simulates that the deployer has finished the retry of an item without success
+	failAgain := func(item *executionItem) {
+		item.DeployItem.Status.JobIDFinished = "02"
+		Expect(kubeClient.Status().Update(ctx, item.DeployItem)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+
+		exec = &lsv1alpha1.Execution{}
+		exec.Name = "root"
+		exec.Namespace = "default"
+		exec.Status.JobID = "02"
+	})
+
+	It("should retry a failed item until the maximal number of retries is reached", func() {
+		items := []*executionItem{
+			buildFailedItem("a", &lsv1alpha1.DeployItemRetryPolicy{
+				NumberOfRetries: pointer.Int(2),
+				Interval:        &lsv1alpha1.Duration{Duration: time.Hour},
+			}),
+			buildFailedItem("b", nil),
+		}
+		setup(items...)
+
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().retryFailedItems(ctx, classification)).To(Succeed())
+		Expect(classification.HasItemsWaitingForRetry()).To(BeTrue())
+		Expect(classification.failedItems).To(ConsistOf(items[1]))
+
+		di := &lsv1alpha1.DeployItem{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(items[0].DeployItem), di)).To(Succeed())
+		Expect(di.Status.JobIDFinished).To(BeEmpty())
+		res := &lsv1alpha1.Execution{}
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(exec), res)).To(Succeed())
+		Expect(res.Status.Retries).To(HaveLen(1))
+		Expect(res.Status.Retries[0].Name).To(Equal("a"))
+		Expect(res.Status.Retries[0].JobID).To(Equal("02"))
+		Expect(res.Status.Retries[0].NumberOfRetries).To(Equal(1))
+
+		By("waiting for the interval before the next retry")
+		failAgain(items[0])
+		classification, err = newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().retryFailedItems(ctx, classification)).To(Succeed())
+		Expect(classification.HasItemsWaitingForRetry()).To(BeTrue())
+		Expect(exec.Status.Retries[0].NumberOfRetries).To(Equal(1))
+
+		By("retrying again after the interval")
+		exec.Status.Retries[0].LastRetryTime = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		Expect(kubeClient.Status().Update(ctx, exec)).To(Succeed())
+		classification, err = newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().retryFailedItems(ctx, classification)).To(Succeed())
+		Expect(classification.HasItemsWaitingForRetry()).To(BeTrue())
+		Expect(exec.Status.Retries[0].NumberOfRetries).To(Equal(2))
+
+		By("failing after the maximal number of retries")
+		failAgain(items[0])
+		exec.Status.Retries[0].LastRetryTime = metav1.NewTime(time.Now().Add(-5 * time.Hour))
+		Expect(kubeClient.Status().Update(ctx, exec)).To(Succeed())
+		classification, err = newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().retryFailedItems(ctx, classification)).To(Succeed())
+		Expect(classification.HasItemsWaitingForRetry()).To(BeFalse())
+		Expect(classification.failedItems).To(ConsistOf(items[0], items[1]))
+	})
+
+	It("should apply the retry rules to the last error of the item", func() {
+		retry := &lsv1alpha1.DeployItemRetryPolicy{
+			NumberOfRetries: pointer.Int(0),
+			Rules: []lsv1alpha1.RetryRule{
+				{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}, Action: lsv1alpha1.RetryActionNever},
+				{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}, Action: lsv1alpha1.RetryActionAlways},
+			},
+		}
+		items := []*executionItem{
+			buildFailedItem("a", retry, lsv1alpha1.ErrorConfigurationProblem),
+			buildFailedItem("b", retry, lsv1alpha1.ErrorTimeout),
+			buildFailedItem("c", retry),
+		}
+		setup(items...)
+
+		classification, err := newDeployItemClassification("02", items)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().retryFailedItems(ctx, classification)).To(Succeed())
+		Expect(classification.waitingForRetryItems).To(ConsistOf(items[1]))
+		Expect(classification.failedItems).To(ConsistOf(items[0], items[2]))
+	})
+
+	It("should not retry interrupted items", func() {
+		item := buildFailedItem("a", &lsv1alpha1.DeployItemRetryPolicy{})
+		item.DeployItem.Status.LastError.Operation = "InterruptOperation"
+		setup(item)
+
+		classification, err := newDeployItemClassification("02", []*executionItem{item})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newOperation().retryFailedItems(ctx, classification)).To(Succeed())
+		Expect(classification.HasItemsWaitingForRetry()).To(BeFalse())
+		Expect(classification.HasFailedItems()).To(BeTrue())
+	})
+
+})
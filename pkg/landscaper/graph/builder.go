@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Builder builds the dependency graph of a root installation from the objects in the cluster.
+type Builder struct {
+	kubeClient client.Reader
+}
+
+// NewBuilder creates a new graph builder.
+func NewBuilder(kubeClient client.Reader) *Builder {
+	return &Builder{
+		kubeClient: kubeClient,
+	}
+}
+
+// Build builds the graph of the given installation and all its subinstallations, executions, deploy items,
+// and the data objects, configmaps, secrets and targets that are imported or exported by the installations.
+func (b *Builder) Build(ctx context.Context, inst *lsv1alpha1.Installation) (*Graph, error) {
+	g := New()
+	if _, err := b.addInstallation(ctx, g, inst); err != nil {
+		return nil, err
+	}
+	g.Sort()
+	return g, nil
+}
+
+// addInstallation adds the installation and its subtree to the graph and returns the node of the installation.
+func (b *Builder) addInstallation(ctx context.Context, g *Graph, inst *lsv1alpha1.Installation) (*Node, error) {
+	instNode := g.AddNode(&Node{
+		Kind:      InstallationKind,
+		Name:      inst.Name,
+		Namespace: inst.Namespace,
+		Phase:     string(inst.Status.InstallationPhase),
+		LastError: errorMessage(inst.Status.LastError),
+	})
+
+	if err := b.addExecution(ctx, g, inst, instNode); err != nil {
+		return nil, err
+	}
+	if err := b.addImportsAndExports(ctx, g, inst, instNode); err != nil {
+		return nil, err
+	}
+
+	subInsts := []*lsv1alpha1.Installation{}
+	for _, ref := range inst.Status.InstallationReferences {
+		subInst := &lsv1alpha1.Installation{}
+		if err := read_write_layer.GetInstallation(ctx, b.kubeClient, ref.Reference.NamespacedName(), subInst); err != nil {
+			if apierrors.IsNotFound(err) {
+				missing := g.AddNode(&Node{
+					Kind:      InstallationKind,
+					Name:      ref.Reference.Name,
+					Namespace: ref.Reference.Namespace,
+					Missing:   true,
+				})
+				g.AddEdge(instNode.ID, missing.ID, SubinstallationEdge, false)
+				continue
+			}
+			return nil, fmt.Errorf("unable to get subinstallation %s: %w", ref.Reference.NamespacedName().String(), err)
+		}
+		subInsts = append(subInsts, subInst)
+
+		subNode, err := b.addInstallation(ctx, g, subInst)
+		if err != nil {
+			return nil, err
+		}
+		g.AddEdge(instNode.ID, subNode.ID, SubinstallationEdge, false)
+	}
+
+	// the order of the sibling subinstallations is derived from their imports and exports
+	for _, subInst := range subInsts {
+		subNode := g.GetNode(NodeID(InstallationKind, subInst.Namespace, subInst.Name))
+		predecessors := dependencies.FetchPredecessorsFromInstallation(subInst, subInsts)
+		for _, predecessorName := range predecessors.List() {
+			predecessorNode := g.GetNode(NodeID(InstallationKind, subInst.Namespace, predecessorName))
+			if predecessorNode == nil {
+				continue
+			}
+			g.AddEdge(predecessorNode.ID, subNode.ID, DependsOnEdge, isBlockingDependency(predecessorNode, subNode))
+		}
+	}
+
+	return instNode, nil
+}
+
+// addExecution adds the execution of an installation together with its deploy items to the graph.
+func (b *Builder) addExecution(ctx context.Context, g *Graph, inst *lsv1alpha1.Installation, instNode *Node) error {
+	if inst.Status.ExecutionReference == nil {
+		return nil
+	}
+
+	exec := &lsv1alpha1.Execution{}
+	if err := read_write_layer.GetExecution(ctx, b.kubeClient, inst.Status.ExecutionReference.NamespacedName(), exec); err != nil {
+		if apierrors.IsNotFound(err) {
+			missing := g.AddNode(&Node{
+				Kind:      ExecutionKind,
+				Name:      inst.Status.ExecutionReference.Name,
+				Namespace: inst.Status.ExecutionReference.Namespace,
+				Missing:   true,
+			})
+			g.AddEdge(instNode.ID, missing.ID, ExecutionEdge, false)
+			return nil
+		}
+		return fmt.Errorf("unable to get execution of installation %s: %w", kutil.ObjectKeyFromObject(inst).String(), err)
+	}
+	execNode := g.AddNode(&Node{
+		Kind:      ExecutionKind,
+		Name:      exec.Name,
+		Namespace: exec.Namespace,
+		Phase:     string(exec.Status.ExecutionPhase),
+		LastError: errorMessage(exec.Status.LastError),
+	})
+	g.AddEdge(instNode.ID, execNode.ID, ExecutionEdge, false)
+
+	deployItems := &lsv1alpha1.DeployItemList{}
+	if err := read_write_layer.ListDeployItems(ctx, b.kubeClient, deployItems, client.InNamespace(exec.Namespace),
+		client.MatchingLabels{lsv1alpha1.ExecutionManagedByLabel: exec.Name}); err != nil {
+		return fmt.Errorf("unable to list deploy items of execution %s: %w", kutil.ObjectKeyFromObject(exec).String(), err)
+	}
+
+	// deploy item nodes by the name of their deploy item template
+	itemNodes := map[string]*Node{}
+	for _, di := range deployItems.Items {
+		diNode := g.AddNode(&Node{
+			Kind:      DeployItemKind,
+			Name:      di.Name,
+			Namespace: di.Namespace,
+			Phase:     string(di.Status.Phase),
+			LastError: errorMessage(di.Status.GetLastError()),
+		})
+		g.AddEdge(execNode.ID, diNode.ID, DeployItemEdge, false)
+		if name, ok := di.Labels[lsv1alpha1.ExecutionManagedNameLabel]; ok {
+			itemNodes[name] = diNode
+		}
+	}
+
+	for _, tmpl := range exec.Spec.DeployItems {
+		diNode, ok := itemNodes[tmpl.Name]
+		if !ok {
+			continue
+		}
+		for _, dependsOn := range tmpl.DependsOn {
+			predecessorNode, ok := itemNodes[dependsOn]
+			if !ok {
+				continue
+			}
+			g.AddEdge(predecessorNode.ID, diNode.ID, DependsOnEdge, isBlockingDependency(predecessorNode, diNode))
+		}
+	}
+
+	return nil
+}
+
+// addImportsAndExports adds the data objects, configmaps, secrets and targets that are imported and exported by an installation.
+// Imported objects that do not exist are added as missing nodes and block the installation.
+func (b *Builder) addImportsAndExports(ctx context.Context, g *Graph, inst *lsv1alpha1.Installation, instNode *Node) error {
+	contextName := installations.GetInstallationContextName(inst)
+
+	for _, dataImport := range inst.Spec.Imports.Data {
+		var (
+			node *Node
+			err  error
+		)
+		switch {
+		case len(dataImport.DataRef) != 0:
+			node, err = b.addObject(ctx, g, &lsv1alpha1.DataObject{}, DataObjectKind, inst.Namespace,
+				lsv1alpha1helper.GenerateDataObjectName(contextName, dataImport.DataRef))
+		case dataImport.ConfigMapRef != nil:
+			node, err = b.addObject(ctx, g, &corev1.ConfigMap{}, ConfigMapKind, inst.Namespace, dataImport.ConfigMapRef.Name)
+		case dataImport.SecretRef != nil:
+			node, err = b.addObject(ctx, g, &corev1.Secret{}, SecretKind, inst.Namespace, dataImport.SecretRef.Name)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		g.AddEdge(node.ID, instNode.ID, ImportEdge, node.Missing)
+	}
+
+	for _, targetImport := range inst.Spec.Imports.Targets {
+		var objectNames []string
+		switch {
+		case len(targetImport.Target) != 0:
+			objectNames = []string{lsv1alpha1helper.GenerateDataObjectName(contextName, targetImport.Target)}
+		case len(targetImport.TargetListReference) != 0:
+			var err error
+			objectNames, err = b.listTargetListObjectNames(ctx, inst.Namespace, contextName, targetImport.TargetListReference)
+			if err != nil {
+				return err
+			}
+		default:
+			for _, targetName := range targetImport.Targets {
+				objectNames = append(objectNames, lsv1alpha1helper.GenerateDataObjectName(contextName, targetName))
+			}
+		}
+		for _, objectName := range objectNames {
+			node, err := b.addObject(ctx, g, &lsv1alpha1.Target{}, TargetKind, inst.Namespace, objectName)
+			if err != nil {
+				return err
+			}
+			g.AddEdge(node.ID, instNode.ID, ImportEdge, node.Missing)
+		}
+	}
+
+	for _, dataExport := range inst.Spec.Exports.Data {
+		node, err := b.addObject(ctx, g, &lsv1alpha1.DataObject{}, DataObjectKind, inst.Namespace,
+			lsv1alpha1helper.GenerateDataObjectName(contextName, dataExport.DataRef))
+		if err != nil {
+			return err
+		}
+		g.AddEdge(instNode.ID, node.ID, ExportEdge, false)
+	}
+
+	for _, targetExport := range inst.Spec.Exports.Targets {
+		node, err := b.addObject(ctx, g, &lsv1alpha1.Target{}, TargetKind, inst.Namespace,
+			lsv1alpha1helper.GenerateDataObjectName(contextName, targetExport.Target))
+		if err != nil {
+			return err
+		}
+		g.AddEdge(instNode.ID, node.ID, ExportEdge, false)
+	}
+
+	return nil
+}
+
+// listTargetListObjectNames returns the names of the targets of a target list that is imported via a targetListRef.
+// The targets are selected by their labels the same way as by the installation controller.
+func (b *Builder) listTargetListObjectNames(ctx context.Context, namespace, contextName, targetListRef string) ([]string, error) {
+	selector := labels.NewSelector()
+	contextRequirement, err := labels.NewRequirement(lsv1alpha1.DataObjectContextLabel, selection.DoesNotExist, nil)
+	if len(contextName) != 0 {
+		contextRequirement, err = labels.NewRequirement(lsv1alpha1.DataObjectContextLabel, selection.Equals, []string{contextName})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	keyRequirement, err := labels.NewRequirement(lsv1alpha1.DataObjectKeyLabel, selection.Equals, []string{targetListRef})
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	sourceTypeRequirement, err := labels.NewRequirement(lsv1alpha1.DataObjectSourceTypeLabel, selection.Equals, []string{string(lsv1alpha1.ImportDataObjectSourceType)})
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	selector = selector.Add(*contextRequirement, *keyRequirement, *sourceTypeRequirement)
+
+	targets := &lsv1alpha1.TargetList{}
+	if err := b.kubeClient.List(ctx, targets, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to list targets of target list %s in namespace %s: %w", targetListRef, namespace, err)
+	}
+	names := make([]string, 0, len(targets.Items))
+	for _, target := range targets.Items {
+		names = append(names, target.Name)
+	}
+	return names, nil
+}
+
+// addObject adds the node of a data object, configmap, secret or target. The node is marked as missing if the object does not exist.
+func (b *Builder) addObject(ctx context.Context, g *Graph, obj client.Object, kind NodeKind, namespace, name string) (*Node, error) {
+	if node := g.GetNode(NodeID(kind, namespace, name)); node != nil {
+		return node, nil
+	}
+
+	missing := false
+	if err := b.kubeClient.Get(ctx, kutil.ObjectKey(name, namespace), obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to get %s %s/%s: %w", kind, namespace, name, err)
+		}
+		missing = true
+	}
+	return g.AddNode(&Node{
+		Kind:      kind,
+		Name:      name,
+		Namespace: namespace,
+		Missing:   missing,
+	}), nil
+}
+
+func errorMessage(err *lsv1alpha1.Error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Message
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/graph"
+)
+
+var _ = Describe("Builder", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		root       *lsv1alpha1.Installation
+	)
+
+	newInstallation := func(name string, phase lsv1alpha1.InstallationPhase) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = "default"
+		inst.Status.InstallationPhase = phase
+		return inst
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// root
+		// ├── a (exports x)
+		// ├── b (imports x and target t)
+		// └── execution root with deploy items d1 and d2 (depends on d1)
+		root = newInstallation("root", lsv1alpha1.InstallationPhases.Progressing)
+		root.Status.InstallationReferences = []lsv1alpha1.NamedObjectReference{
+			{Name: "a", Reference: lsv1alpha1.ObjectReference{Name: "a", Namespace: "default"}},
+			{Name: "b", Reference: lsv1alpha1.ObjectReference{Name: "b", Namespace: "default"}},
+		}
+		root.Status.ExecutionReference = &lsv1alpha1.ObjectReference{Name: "root", Namespace: "default"}
+
+		a := newInstallation("a", lsv1alpha1.InstallationPhases.Progressing)
+		a.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "x", DataRef: "x"}}
+		Expect(controllerutil.SetControllerReference(root, a, api.LandscaperScheme)).To(Succeed())
+
+		b := newInstallation("b", lsv1alpha1.InstallationPhases.Init)
+		b.Spec.Imports.Data = []lsv1alpha1.DataImport{{Name: "x", DataRef: "x"}}
+		b.Spec.Imports.Targets = []lsv1alpha1.TargetImport{{Name: "t", Target: "t"}}
+		Expect(controllerutil.SetControllerReference(root, b, api.LandscaperScheme)).To(Succeed())
+
+		exec := &lsv1alpha1.Execution{}
+		exec.Name = "root"
+		exec.Namespace = "default"
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Progressing
+		exec.Spec.DeployItems = []lsv1alpha1.DeployItemTemplate{
+			{Name: "d1"},
+			{Name: "d2", DependsOn: []string{"d1"}},
+		}
+
+		newDeployItem := func(name string, phase lsv1alpha1.DeployItemPhase) *lsv1alpha1.DeployItem {
+			di := &lsv1alpha1.DeployItem{}
+			di.Name = "di-" + name
+			di.Namespace = "default"
+			di.Labels = map[string]string{
+				lsv1alpha1.ExecutionManagedByLabel:   exec.Name,
+				lsv1alpha1.ExecutionManagedNameLabel: name,
+			}
+			di.Status.Phase = phase
+			di.Status.LastError = &lsv1alpha1.Error{Message: "not yet ready"}
+			return di
+		}
+
+		do := &lsv1alpha1.DataObject{}
+		do.Name = lsv1alpha1helper.GenerateDataObjectName(lsv1alpha1helper.DataObjectSourceFromInstallation(root), "x")
+		do.Namespace = "default"
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(root, a, b, exec, do,
+			newDeployItem("d1", lsv1alpha1.DeployItemPhases.Failed),
+			newDeployItem("d2", lsv1alpha1.DeployItemPhases.Init)).Build()
+	})
+
+	It("should build the graph of a root installation", func() {
+		g, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+
+		ids := []string{}
+		for _, node := range g.Nodes {
+			ids = append(ids, node.ID)
+		}
+		doName := lsv1alpha1helper.GenerateDataObjectName(lsv1alpha1helper.DataObjectSourceFromInstallation(root), "x")
+		targetName := lsv1alpha1helper.GenerateDataObjectName(lsv1alpha1helper.DataObjectSourceFromInstallation(root), "t")
+		Expect(ids).To(ConsistOf(
+			"Installation/default/root",
+			"Installation/default/a",
+			"Installation/default/b",
+			"Execution/default/root",
+			"DeployItem/default/di-d1",
+			"DeployItem/default/di-d2",
+			"DataObject/default/"+doName,
+			"Target/default/"+targetName,
+		))
+		Expect(g.GetNode("Target/default/" + targetName).Missing).To(BeTrue())
+		Expect(g.GetNode("DataObject/default/" + doName).Missing).To(BeFalse())
+		Expect(g.GetNode("DeployItem/default/di-d1").LastError).To(Equal("not yet ready"))
+
+		Expect(g.Edges).To(ContainElements(
+			&graph.Edge{From: "Installation/default/root", To: "Installation/default/a", Kind: graph.SubinstallationEdge},
+			&graph.Edge{From: "Installation/default/root", To: "Execution/default/root", Kind: graph.ExecutionEdge},
+			&graph.Edge{From: "Execution/default/root", To: "DeployItem/default/di-d2", Kind: graph.DeployItemEdge},
+			&graph.Edge{From: "Installation/default/a", To: "DataObject/default/" + doName, Kind: graph.ExportEdge},
+			&graph.Edge{From: "DataObject/default/" + doName, To: "Installation/default/b", Kind: graph.ImportEdge},
+		))
+		Expect(g.BlockingEdges()).To(ConsistOf(
+			&graph.Edge{From: "Installation/default/a", To: "Installation/default/b", Kind: graph.DependsOnEdge, Blocking: true},
+			&graph.Edge{From: "DeployItem/default/di-d1", To: "DeployItem/default/di-d2", Kind: graph.DependsOnEdge, Blocking: true},
+			&graph.Edge{From: "Target/default/" + targetName, To: "Installation/default/b", Kind: graph.ImportEdge, Blocking: true},
+		))
+	})
+
+	It("should not block successors of succeeded installations", func() {
+		a := &lsv1alpha1.Installation{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "a", Namespace: "default"}, a)).To(Succeed())
+		a.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		Expect(kubeClient.Status().Update(ctx, a)).To(Succeed())
+
+		g, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(g.Edges).To(ContainElement(
+			&graph.Edge{From: "Installation/default/a", To: "Installation/default/b", Kind: graph.DependsOnEdge},
+		))
+	})
+
+	It("should render the graph as json and dot", func() {
+		g, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := g.ToJSON()
+		Expect(err).NotTo(HaveOccurred())
+		res := &graph.Graph{}
+		Expect(json.Unmarshal(data, res)).To(Succeed())
+		Expect(res.Nodes).To(HaveLen(len(g.Nodes)))
+		Expect(res.Edges).To(HaveLen(len(g.Edges)))
+
+		dot := g.ToDOT()
+		Expect(dot).To(HavePrefix("digraph landscape {"))
+		Expect(dot).To(ContainSubstring(`"Installation/default/root" [label="Installation\nroot\nProgressing", shape=box, style="filled", fillcolor="lightyellow"];`))
+		Expect(dot).To(ContainSubstring(`"DeployItem/default/di-d1" [label="DeployItem\ndi-d1\nFailed", shape=component, style="filled", fillcolor="salmon", tooltip="not yet ready"];`))
+		Expect(dot).To(ContainSubstring(`"Installation/default/a" -> "Installation/default/b" [label="dependsOn", color="red", fontcolor="red", penwidth=2];`))
+		Expect(dot).To(ContainSubstring(`style="filled,dashed", fillcolor="lightgrey"`))
+	})
+
+	It("should be stable", func() {
+		g1, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+		g2, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(g1.ToDOT()).To(Equal(g2.ToDOT()))
+	})
+
+	It("should add imported configmaps, secrets and target lists", func() {
+		b := &lsv1alpha1.Installation{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "b", Namespace: "default"}, b)).To(Succeed())
+		b.Spec.Imports.Data = append(b.Spec.Imports.Data,
+			lsv1alpha1.DataImport{Name: "cm", ConfigMapRef: &lsv1alpha1.LocalConfigMapReference{Name: "my-cm", Key: "config"}},
+			lsv1alpha1.DataImport{Name: "secret", SecretRef: &lsv1alpha1.LocalSecretReference{Name: "my-secret", Key: "password"}},
+		)
+		b.Spec.Imports.Targets = append(b.Spec.Imports.Targets, lsv1alpha1.TargetImport{Name: "tl", TargetListReference: "tl"})
+		Expect(kubeClient.Update(ctx, b)).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = "default"
+		Expect(kubeClient.Create(ctx, cm)).To(Succeed())
+
+		contextName := lsv1alpha1helper.DataObjectSourceFromInstallation(root)
+		newListTarget := func(name, contextName string) *lsv1alpha1.Target {
+			target := &lsv1alpha1.Target{}
+			target.Name = name
+			target.Namespace = "default"
+			target.Labels = map[string]string{
+				lsv1alpha1.DataObjectContextLabel:    contextName,
+				lsv1alpha1.DataObjectKeyLabel:        "tl",
+				lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ImportDataObjectSourceType),
+			}
+			return target
+		}
+		Expect(kubeClient.Create(ctx, newListTarget("tl-0", contextName))).To(Succeed())
+		Expect(kubeClient.Create(ctx, newListTarget("tl-1", contextName))).To(Succeed())
+		Expect(kubeClient.Create(ctx, newListTarget("other-context", "other"))).To(Succeed())
+
+		g, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(g.GetNode("ConfigMap/default/my-cm").Missing).To(BeFalse())
+		Expect(g.GetNode("Secret/default/my-secret").Missing).To(BeTrue())
+		Expect(g.GetNode("Target/default/tl-0").Missing).To(BeFalse())
+		Expect(g.GetNode("Target/default/tl-1").Missing).To(BeFalse())
+		Expect(g.GetNode("Target/default/other-context")).To(BeNil())
+		Expect(g.Edges).To(ContainElements(
+			&graph.Edge{From: "ConfigMap/default/my-cm", To: "Installation/default/b", Kind: graph.ImportEdge},
+			&graph.Edge{From: "Secret/default/my-secret", To: "Installation/default/b", Kind: graph.ImportEdge, Blocking: true},
+			&graph.Edge{From: "Target/default/tl-0", To: "Installation/default/b", Kind: graph.ImportEdge},
+			&graph.Edge{From: "Target/default/tl-1", To: "Installation/default/b", Kind: graph.ImportEdge},
+		))
+	})
+
+	It("should mark missing subinstallations", func() {
+		root.Status.InstallationReferences = append(root.Status.InstallationReferences, lsv1alpha1.NamedObjectReference{
+			Name: "c", Reference: lsv1alpha1.ObjectReference{Name: "c", Namespace: "default"},
+		})
+		g, err := graph.NewBuilder(kubeClient).Build(ctx, root)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(g.GetNode("Installation/default/c")).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Missing": BeTrue(),
+		})))
+	})
+})
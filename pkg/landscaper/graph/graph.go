@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NodeKind is the kind of the object that is represented by a node.
+type NodeKind string
+
+const (
+	InstallationKind NodeKind = "Installation"
+	ExecutionKind    NodeKind = "Execution"
+	DeployItemKind   NodeKind = "DeployItem"
+	DataObjectKind   NodeKind = "DataObject"
+	TargetKind       NodeKind = "Target"
+	ConfigMapKind    NodeKind = "ConfigMap"
+	SecretKind       NodeKind = "Secret"
+)
+
+// EdgeKind is the kind of the relationship that is represented by an edge.
+type EdgeKind string
+
+const (
+	// SubinstallationEdge connects an installation with its subinstallations.
+	SubinstallationEdge EdgeKind = "subinstallation"
+	// ExecutionEdge connects an installation with its execution.
+	ExecutionEdge EdgeKind = "execution"
+	// DeployItemEdge connects an execution with its deploy items.
+	DeployItemEdge EdgeKind = "deployItem"
+	// DependsOnEdge connects a sibling installation or deploy item with the installation or deploy item that depends on it.
+	DependsOnEdge EdgeKind = "dependsOn"
+	// ImportEdge connects a data object, configmap, secret or target with the installation that imports it.
+	ImportEdge EdgeKind = "import"
+	// ExportEdge connects an installation with the data object or target that it exports.
+	ExportEdge EdgeKind = "export"
+)
+
+// succeededPhase is the phase of succeeded installations, executions and deploy items.
+const succeededPhase = "Succeeded"
+
+// Node is an object of the landscape of a root installation.
+type Node struct {
+	// ID uniquely identifies the node in the graph.
+	ID        string   `json:"id"`
+	Kind      NodeKind `json:"kind"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	// Phase is the phase of installations, executions and deploy items.
+	Phase string `json:"phase,omitempty"`
+	// LastError is the message of the last error of installations, executions and deploy items.
+	LastError string `json:"lastError,omitempty"`
+	// Missing is true if the object is referenced but does not exist.
+	Missing bool `json:"missing,omitempty"`
+}
+
+// Edge is a relationship between two nodes.
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+	// Blocking is true if the edge currently prevents the target node from being processed,
+	// e.g. because a predecessor has not succeeded or an imported object is missing.
+	Blocking bool `json:"blocking,omitempty"`
+}
+
+// Graph is the dependency graph of the installations, executions, deploy items, data objects, configmaps, secrets
+// and targets of a root installation.
+type Graph struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+
+	nodes map[string]*Node
+	edges map[string]*Edge
+}
+
+// New creates a new empty graph.
+func New() *Graph {
+	return &Graph{
+		Nodes: []*Node{},
+		Edges: []*Edge{},
+		nodes: map[string]*Node{},
+		edges: map[string]*Edge{},
+	}
+}
+
+// NodeID returns the id of the node of an object.
+func NodeID(kind NodeKind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// AddNode adds a node to the graph. If a node with the same id already exists, the existing node is returned.
+func (g *Graph) AddNode(node *Node) *Node {
+	if len(node.ID) == 0 {
+		node.ID = NodeID(node.Kind, node.Namespace, node.Name)
+	}
+	if existing, ok := g.nodes[node.ID]; ok {
+		return existing
+	}
+	g.nodes[node.ID] = node
+	g.Nodes = append(g.Nodes, node)
+	return node
+}
+
+// GetNode returns the node with the given id or nil if no such node exists.
+func (g *Graph) GetNode(id string) *Node {
+	return g.nodes[id]
+}
+
+// AddEdge adds an edge between two nodes. Duplicate edges are ignored.
+func (g *Graph) AddEdge(from, to string, kind EdgeKind, blocking bool) {
+	key := fmt.Sprintf("%s|%s|%s", from, to, kind)
+	if existing, ok := g.edges[key]; ok {
+		existing.Blocking = existing.Blocking || blocking
+		return
+	}
+	edge := &Edge{From: from, To: to, Kind: kind, Blocking: blocking}
+	g.edges[key] = edge
+	g.Edges = append(g.Edges, edge)
+}
+
+// BlockingEdges returns all edges that currently block the processing of their target node.
+func (g *Graph) BlockingEdges() []*Edge {
+	result := []*Edge{}
+	for _, edge := range g.Edges {
+		if edge.Blocking {
+			result = append(result, edge)
+		}
+	}
+	return result
+}
+
+// Sort orders the nodes and edges by their ids, so that the rendered graph is stable.
+func (g *Graph) Sort() {
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].ID < g.Nodes[j].ID
+	})
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From < g.Edges[j].From
+		}
+		if g.Edges[i].To != g.Edges[j].To {
+			return g.Edges[i].To < g.Edges[j].To
+		}
+		return g.Edges[i].Kind < g.Edges[j].Kind
+	})
+}
+
+// isBlockingDependency checks whether the dependency between a predecessor and its successor currently blocks
+// the successor, which is the case if neither of them has succeeded.
+func isBlockingDependency(predecessor, successor *Node) bool {
+	return predecessor.Phase != succeededPhase && successor.Phase != succeededPhase
+}
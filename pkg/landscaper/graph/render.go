@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// nodeShapes defines the graphviz shape of the nodes of every kind.
+var nodeShapes = map[NodeKind]string{
+	InstallationKind: "box",
+	ExecutionKind:    "hexagon",
+	DeployItemKind:   "component",
+	DataObjectKind:   "note",
+	TargetKind:       "cylinder",
+	ConfigMapKind:    "tab",
+	SecretKind:       "octagon",
+}
+
+// ToJSON renders the graph as json.
+func (g *Graph) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// ToDOT renders the graph in the graphviz dot format.
+// Nodes are coloured by their phase, missing objects are drawn dashed and blocking edges are highlighted in red.
+func (g *Graph) ToDOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph landscape {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [style=filled, fontname=\"Helvetica\"];\n")
+	sb.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	for _, node := range g.Nodes {
+		label := fmt.Sprintf("%s\n%s", node.Kind, node.Name)
+		if len(node.Phase) != 0 {
+			label = fmt.Sprintf("%s\n%s", label, node.Phase)
+		}
+		style := "filled"
+		if node.Missing {
+			label = fmt.Sprintf("%s\n(missing)", label)
+			style = "filled,dashed"
+		}
+		fmt.Fprintf(&sb, "  %s [label=%s, shape=%s, style=%q, fillcolor=%q",
+			strconv.Quote(node.ID), strconv.Quote(label), nodeShapes[node.Kind], style, phaseColor(node))
+		if len(node.LastError) != 0 {
+			fmt.Fprintf(&sb, ", tooltip=%s", strconv.Quote(node.LastError))
+		}
+		sb.WriteString("];\n")
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -> %s [label=%q", strconv.Quote(edge.From), strconv.Quote(edge.To), edge.Kind)
+		if edge.Blocking {
+			sb.WriteString(", color=\"red\", fontcolor=\"red\", penwidth=2")
+		} else if edge.Kind == SubinstallationEdge || edge.Kind == ExecutionEdge || edge.Kind == DeployItemEdge {
+			sb.WriteString(", style=\"dotted\"")
+		}
+		sb.WriteString("];\n")
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// phaseColor returns the fill colour of a node depending on its phase.
+func phaseColor(node *Node) string {
+	if node.Missing {
+		return "lightgrey"
+	}
+	switch node.Phase {
+	case "":
+		return "white"
+	case succeededPhase:
+		return "palegreen"
+	case "Failed", "DeleteFailed":
+		return "salmon"
+	default:
+		// Init, Progressing, Completing, Deleting and other unfinished phases
+		return "lightyellow"
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 	secretresolver "github.com/gardener/landscaper/pkg/utils/targetresolver/secret"
@@ -59,9 +60,13 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context, inst
 	templateStateHandler := template.KubernetesStateHandler{
 		KubeClient: o.Client(),
 		Inst:       inst.GetInstallation(),
+		ReadOnly:   o.DryRun,
 	}
 	targetResolver := secretresolver.New(o.Client())
-	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler))
+	tmpl := template.New(
+		gotemplate.New(templateStateHandler, targetResolver),
+		spiff.New(templateStateHandler, targetResolver),
+		jsonnet.New(templateStateHandler, targetResolver))
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -133,6 +138,8 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context, inst
 			Timeout:            timeout,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 			OnDelete:           elem.OnDelete,
+			RequiresApproval:   elem.RequiresApproval,
+			Retry:              elem.Retry,
 		}
 	}
 
@@ -170,6 +177,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 	if _, err := o.Writer().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
 		exec.Spec.Context = inst.GetInstallation().Spec.Context
 		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.MaxParallelDeployItems = o.getMaxParallelDeployItems(inst)
 
 		if lsv1alpha1helper.HasOperation(inst.GetInstallation().ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
@@ -215,6 +223,15 @@ func GetExecutionForInstallation(ctx context.Context, kubeClient client.Client,
 	return exec, nil
 }
 
+// getMaxParallelDeployItems returns the maximum number of deploy items of the execution that are processed in parallel.
+// The limit of the blueprint overwrites the limit of the context of the installation.
+func (o *ExecutionOperation) getMaxParallelDeployItems(inst *installations.InstallationImportsAndBlueprint) *int32 {
+	if blueprint := inst.GetBlueprint(); blueprint != nil && blueprint.Info != nil && blueprint.Info.MaxParallelDeployItems != nil {
+		return blueprint.Info.MaxParallelDeployItems
+	}
+	return o.Context().External.MaxParallelDeployItems
+}
+
 func (o *ExecutionOperation) deployItemSpecificationError(cond lsv1alpha1.Condition, name, message string, args ...interface{}) error {
 	err := fmt.Errorf(fmt.Sprintf("invalid deployitem specification %q: ", name)+message, args...)
 	o.Inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
@@ -333,7 +333,7 @@ func getShootAdminKubeconfigGoFunc(targetResolver targetresolver.TargetResolver)
 			return "", fmt.Errorf("templating function getShootAdminKubeconfig expects a string as 2nd argument, namely the shoot namespace")
 		}
 
-		expirationSeconds, err := toInt64(args[2])
+		expirationSeconds, err := lstmpl.ToInt64(args[2])
 		if err != nil {
 			return "", fmt.Errorf("templating function getShootAdminKubeconfig expects an integer as 3rd argument, namely the expiration seconds: %w", err)
 		}
@@ -376,7 +376,7 @@ func getServiceAccountKubeconfigGoFunc(targetResolver targetresolver.TargetResol
 			return "", fmt.Errorf("templating function getServiceAccountToken expects a string as 2nd argument, namely the service account namespace")
 		}
 
-		expirationSeconds, err := toInt64(args[2])
+		expirationSeconds, err := lstmpl.ToInt64(args[2])
 		if err != nil {
 			return "", fmt.Errorf("templating function getServiceAccountToken expects an integer as 3rd argument, namely the expiration seconds: %w", err)
 		}
@@ -435,24 +435,3 @@ func getOidcKubeconfigGoFunc(targetResolver targetresolver.TargetResolver) func(
 		return clusters.BuildOIDCKubeconfig(ctx, issuerURL, clientID, target, targetResolver)
 	}
 }
-
-func toInt64(value interface{}) (int64, error) {
-	switch n := value.(type) {
-	case int64:
-		return n, nil
-	case int32:
-		return int64(n), nil
-	case int16:
-		return int64(n), nil
-	case int8:
-		return int64(n), nil
-	case int:
-		return int64(n), nil
-	case float64:
-		return int64(n), nil
-	case float32:
-		return int64(n), nil
-	default:
-		return 0, fmt.Errorf("unsupported type %T", value)
-	}
-}
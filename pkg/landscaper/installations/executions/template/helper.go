@@ -169,3 +169,25 @@ func ParseOCIReference(ref string) [2]string {
 		"",
 	}
 }
+
+// ToInt64 converts a numeric value that was passed as argument to a templating function into an int64.
+func ToInt64(value interface{}) (int64, error) {
+	switch n := value.(type) {
+	case int64:
+		return n, nil
+	case int32:
+		return int64(n), nil
+	case int16:
+		return int64(n), nil
+	case int8:
+		return int64(n), nil
+	case int:
+		return int64(n), nil
+	case float64:
+		return int64(n), nil
+	case float32:
+		return int64(n), nil
+	default:
+		return 0, fmt.Errorf("unsupported type %T", value)
+	}
+}
@@ -0,0 +1,455 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/codec"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	imagevector "github.com/gardener/image-vector/pkg"
+	"github.com/google/go-jsonnet"
+	"github.com/google/go-jsonnet/ast"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/clusters"
+	"github.com/gardener/landscaper/pkg/utils/targetresolver"
+)
+
+// LandscaperJsonnetFuncs returns all additional landscaper functions that are
+// available as native functions in jsonnet templates, e.g. std.native("getResource")(cd, {name: "my-resource"}).
+func LandscaperJsonnetFuncs(fs vfs.FileSystem,
+	componentVersion model.ComponentVersion,
+	componentVersions *model.ComponentVersionList,
+	targetResolver targetresolver.TargetResolver) ([]*jsonnet.NativeFunction, error) {
+
+	cd, err := model.GetComponentDescriptor(componentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get component descriptor to register jsonnet functions: %w", err)
+	}
+
+	cdList, err := model.ConvertComponentVersionList(componentVersions)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert component descriptor list to register jsonnet functions: %w", err)
+	}
+
+	return []*jsonnet.NativeFunction{
+		nativeFunction("readFile", []string{"path"}, readFile(fs)),
+		nativeFunction("readDir", []string{"path"}, readDir(fs)),
+		nativeFunction("resolve", []string{"access"}, resolveArtifact(componentVersion)),
+		nativeFunction("getResource", []string{"cd", "selector"}, getResource(cd)),
+		nativeFunction("getResources", []string{"cd", "selector"}, getResources(cd)),
+		nativeFunction("getComponent", []string{"cd", "selector"}, getComponent(cd, cdList)),
+		nativeFunction("getRepositoryContext", []string{"cd"}, getEffectiveRepositoryContext),
+		nativeFunction("generateImageOverwrite", []string{"cd", "components"}, generateImageOverwrite(cd, cdList)),
+		nativeFunction("parseOCIRef", []string{"ref"}, parseOCIReference),
+		nativeFunction("ociRefRepo", []string{"ref"}, getOCIReferenceRepository),
+		nativeFunction("ociRefVersion", []string{"ref"}, getOCIReferenceVersion),
+		nativeFunction("getShootAdminKubeconfig", []string{"shootName", "shootNamespace", "expirationSeconds", "target"},
+			getShootAdminKubeconfig(targetResolver)),
+		nativeFunction("getServiceAccountKubeconfig", []string{"serviceAccountName", "serviceAccountNamespace", "expirationSeconds", "target"},
+			getServiceAccountKubeconfig(targetResolver)),
+		nativeFunction("getOidcKubeconfig", []string{"issuerURL", "clientID", "target"}, getOidcKubeconfig(targetResolver)),
+	}, nil
+}
+
+// nativeFunction creates a jsonnet native function.
+// Some of the shared templating helpers panic on invalid input, these panics are returned as errors of the function.
+func nativeFunction(name string, params []string, f func(args []interface{}) (interface{}, error)) *jsonnet.NativeFunction {
+	identifiers := make(ast.Identifiers, len(params))
+	for i, param := range params {
+		identifiers[i] = ast.Identifier(param)
+	}
+	return &jsonnet.NativeFunction{
+		Name:   name,
+		Params: identifiers,
+		Func: func(args []interface{}) (res interface{}, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					res = nil
+					err = fmt.Errorf("templating function %s failed: %v", name, r)
+				}
+			}()
+			return f(args)
+		},
+	}
+}
+
+// toJsonnetValue converts the given go object into a value that can be returned by a native function.
+func toJsonnetValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// fromJsonnetValue converts an argument of a native function into the given go object.
+func fromJsonnetValue(arg interface{}, obj interface{}) error {
+	data, err := json.Marshal(arg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, obj)
+}
+
+// selectorArgs converts an optional component descriptor and a selector object into the arguments
+// that are expected by the shared resource and component helpers.
+func selectorArgs(cd interface{}, selector interface{}) ([]interface{}, error) {
+	selectorMap, ok := selector.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object as selector, e.g. {name: \"my-resource\"}")
+	}
+
+	args := []interface{}{}
+	if cd != nil {
+		if _, ok := cd.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("expected a component descriptor or null as 1st argument")
+		}
+		args = append(args, cd)
+	}
+
+	keys := make([]string, 0, len(selectorMap))
+	for key := range selectorMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, ok := selectorMap[key].(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string as value of selector key %q", key)
+		}
+		args = append(args, key, value)
+	}
+	return args, nil
+}
+
+// readFile returns a function that reads a file from the blueprint's filesystem.
+// The content of the file is returned as string.
+func readFile(fs vfs.FileSystem) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		path, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("readFile expects a string as argument, namely the path of the file")
+		}
+		if fs == nil {
+			return nil, fmt.Errorf("unable to read file %q: no filesystem defined", path)
+		}
+		data, err := vfs.ReadFile(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file %q: %w", path, err)
+		}
+		return string(data), nil
+	}
+}
+
+// readDir returns a function that lists all files and directories of a directory in the blueprint's filesystem.
+// Every entry is returned as object with the keys "name", "size" and "isDir".
+func readDir(fs vfs.FileSystem) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		path, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("readDir expects a string as argument, namely the path of the directory")
+		}
+		if fs == nil {
+			return nil, fmt.Errorf("unable to read directory %q: no filesystem defined", path)
+		}
+		files, err := vfs.ReadDir(fs, path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read directory %q: %w", path, err)
+		}
+
+		entries := make([]interface{}, len(files))
+		for i, file := range files {
+			entries[i] = map[string]interface{}{
+				"name":  file.Name(),
+				"size":  float64(file.Size()),
+				"isDir": file.IsDir(),
+			}
+		}
+		return entries, nil
+	}
+}
+
+// resolveArtifact returns a function that resolves an artifact defined by a typed access.
+// As artifacts may contain binary data, the artifact is returned as base64 encoded string.
+func resolveArtifact(componentVersion model.ComponentVersion) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		if componentVersion == nil {
+			return nil, fmt.Errorf("unable to resolve artifact, because no component version is provided")
+		}
+		access, ok := args[0].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("resolve expects an access object as argument")
+		}
+		accessType, ok := access["type"].(string)
+		if !ok {
+			return nil, fmt.Errorf("resolve expects an access object with a type as argument")
+		}
+
+		blobResolver, err := componentVersion.GetBlobResolver()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get blob resolver to resolve artifact: %w", err)
+		}
+
+		ctx := context.Background()
+		defer ctx.Done()
+		var data bytes.Buffer
+		if _, err := blobResolver.Resolve(ctx, types.Resource{Access: cdv2.NewUnstructuredType(accessType, access)}, &data); err != nil {
+			return nil, fmt.Errorf("unable to resolve artifact: %w", err)
+		}
+		return base64.StdEncoding.EncodeToString(data.Bytes()), nil
+	}
+}
+
+func getResource(cd *types.ComponentDescriptor) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		resources, err := resolveResources(cd, args)
+		if err != nil {
+			return nil, err
+		}
+		// resources must be at least one, otherwise an error will be thrown
+		return toJsonnetValue(resources[0])
+	}
+}
+
+func getResources(cd *types.ComponentDescriptor) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		resources, err := resolveResources(cd, args)
+		if err != nil {
+			return nil, err
+		}
+		return toJsonnetValue(resources)
+	}
+}
+
+func resolveResources(cd *types.ComponentDescriptor, args []interface{}) ([]types.Resource, error) {
+	if cd == nil && args[0] == nil {
+		return nil, fmt.Errorf("unable to search for a resource as no component descriptor is defined")
+	}
+	selector, err := selectorArgs(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	return template.ResolveResources(cd, selector)
+}
+
+func getComponent(cd *types.ComponentDescriptor, cdList *types.ComponentDescriptorList) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		if cd == nil && args[0] == nil {
+			return nil, fmt.Errorf("unable to search for a component as no component descriptor is defined")
+		}
+		selector, err := selectorArgs(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		components, err := template.ResolveComponents(cd, cdList, selector)
+		if err != nil {
+			return nil, err
+		}
+		// components must be at least one, otherwise an error will be thrown
+		return toJsonnetValue(components[0])
+	}
+}
+
+func getEffectiveRepositoryContext(args []interface{}) (interface{}, error) {
+	if args[0] == nil {
+		return nil, fmt.Errorf("unable to get the effective repository context as no component descriptor is defined")
+	}
+	data, err := json.Marshal(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid component descriptor: %w", err)
+	}
+	cd := &types.ComponentDescriptor{}
+	if err := codec.Decode(data, cd); err != nil {
+		return nil, fmt.Errorf("invalid component descriptor: %w", err)
+	}
+	return toJsonnetValue(cd.GetEffectiveRepositoryContext())
+}
+
+// generateImageOverwrite returns a function that generates an image vector.
+// The component descriptor and the component descriptor list are optional and default to the ones of the installation.
+func generateImageOverwrite(cd *types.ComponentDescriptor, cdList *types.ComponentDescriptorList) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		internalCd := cd
+		internalComponents := cdList
+
+		if args[0] != nil {
+			data, err := json.Marshal(args[0])
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal first argument to json: %w", err)
+			}
+			internalCd = &types.ComponentDescriptor{}
+			if err := codec.Decode(data, internalCd); err != nil {
+				return nil, fmt.Errorf("unable to decode first argument to component descriptor: %w", err)
+			}
+		}
+
+		if args[1] != nil {
+			data, err := json.Marshal(args[1])
+			if err != nil {
+				return nil, fmt.Errorf("unable to marshal second argument to json: %w", err)
+			}
+			internalComponents = &types.ComponentDescriptorList{}
+			if err := codec.Decode(data, internalComponents); err != nil {
+				return nil, fmt.Errorf("unable to decode second argument to component descriptor list: %w", err)
+			}
+		}
+
+		if internalCd == nil {
+			return nil, fmt.Errorf("no component descriptor is defined")
+		}
+		if internalComponents == nil {
+			return nil, fmt.Errorf("no component descriptor list is defined")
+		}
+
+		cdResolver, err := ctf.NewListResolver(internalComponents)
+		if err != nil {
+			return nil, fmt.Errorf("list component resolver could not be build: %w", err)
+		}
+
+		vector, err := imagevector.GenerateImageOverwrite(context.TODO(), cdResolver, internalCd, imagevector.GenerateImageOverwriteOptions{
+			Components: internalComponents,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return toJsonnetValue(vector)
+	}
+}
+
+func parseOCIReference(args []interface{}) (interface{}, error) {
+	ref, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("parseOCIRef expects a string as argument, namely the oci reference")
+	}
+	parsed := template.ParseOCIReference(ref)
+	return []interface{}{parsed[0], parsed[1]}, nil
+}
+
+func getOCIReferenceRepository(args []interface{}) (interface{}, error) {
+	ref, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("ociRefRepo expects a string as argument, namely the oci reference")
+	}
+	return template.ParseOCIReference(ref)[0], nil
+}
+
+func getOCIReferenceVersion(args []interface{}) (interface{}, error) {
+	ref, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("ociRefVersion expects a string as argument, namely the oci reference")
+	}
+	return template.ParseOCIReference(ref)[1], nil
+}
+
+func getShootAdminKubeconfig(targetResolver targetresolver.TargetResolver) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		shootName, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("templating function getShootAdminKubeconfig expects a string as 1st argument, namely the shoot name")
+		}
+
+		shootNamespace, ok := args[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("templating function getShootAdminKubeconfig expects a string as 2nd argument, namely the shoot namespace")
+		}
+
+		expirationSeconds, err := template.ToInt64(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("templating function getShootAdminKubeconfig expects an integer as 3rd argument, namely the expiration seconds: %w", err)
+		}
+
+		target := &lsv1alpha1.Target{}
+		if err := fromJsonnetValue(args[3], target); err != nil {
+			return nil, fmt.Errorf("templating function getShootAdminKubeconfig expects a target object as 4th argument: %w", err)
+		}
+
+		if targetResolver == nil {
+			return nil, fmt.Errorf("templating function getShootAdminKubeconfig is not supported: no target resolver defined")
+		}
+
+		ctx := context.Background()
+		shootClient, err := clusters.NewShootClientFromTarget(ctx, target, targetResolver)
+		if err != nil {
+			return nil, err
+		}
+		return shootClient.GetShootAdminKubeconfig(ctx, shootName, shootNamespace, expirationSeconds)
+	}
+}
+
+func getServiceAccountKubeconfig(targetResolver targetresolver.TargetResolver) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		serviceAccountName, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("templating function getServiceAccountKubeconfig expects a string as 1st argument, namely the service account name")
+		}
+
+		serviceAccountNamespace, ok := args[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("templating function getServiceAccountKubeconfig expects a string as 2nd argument, namely the service account namespace")
+		}
+
+		expirationSeconds, err := template.ToInt64(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("templating function getServiceAccountKubeconfig expects an integer as 3rd argument, namely the expiration seconds: %w", err)
+		}
+
+		target := &lsv1alpha1.Target{}
+		if err := fromJsonnetValue(args[3], target); err != nil {
+			return nil, fmt.Errorf("templating function getServiceAccountKubeconfig expects a target object as 4th argument: %w", err)
+		}
+
+		if targetResolver == nil {
+			return nil, fmt.Errorf("templating function getServiceAccountKubeconfig is not supported: no target resolver defined")
+		}
+
+		ctx := context.Background()
+		tokenClient, err := clusters.NewTokenClientFromTarget(ctx, target, targetResolver)
+		if err != nil {
+			return nil, err
+		}
+		return tokenClient.GetServiceAccountKubeconfig(ctx, serviceAccountName, serviceAccountNamespace, expirationSeconds)
+	}
+}
+
+func getOidcKubeconfig(targetResolver targetresolver.TargetResolver) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		issuerURL, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("templating function getOidcKubeconfig expects a string as 1st argument, namely the issuer url")
+		}
+
+		clientID, ok := args[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("templating function getOidcKubeconfig expects a string as 2nd argument, namely the client id")
+		}
+
+		target := &lsv1alpha1.Target{}
+		if err := fromJsonnetValue(args[2], target); err != nil {
+			return nil, fmt.Errorf("templating function getOidcKubeconfig expects a target object as 3rd argument: %w", err)
+		}
+
+		if targetResolver == nil {
+			return nil, fmt.Errorf("templating function getOidcKubeconfig is not supported: no target resolver defined")
+		}
+
+		return clusters.BuildOIDCKubeconfig(context.Background(), issuerURL, clientID, target, targetResolver)
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/google/go-jsonnet"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+)
+
+// BlueprintImporter resolves jsonnet imports from the filesystem of a blueprint.
+// Relative paths are resolved relative to the importing file, inline templates import relative to the blueprint root.
+type BlueprintImporter struct {
+	fs    vfs.FileSystem
+	cache map[string]jsonnet.Contents
+}
+
+var _ jsonnet.Importer = &BlueprintImporter{}
+
+// NewBlueprintImporter creates a new importer for the given blueprint filesystem.
+func NewBlueprintImporter(fs vfs.FileSystem) *BlueprintImporter {
+	return &BlueprintImporter{
+		fs:    fs,
+		cache: map[string]jsonnet.Contents{},
+	}
+}
+
+// Import implements the jsonnet importer interface.
+func (i *BlueprintImporter) Import(importedFrom, importedPath string) (jsonnet.Contents, string, error) {
+	foundAt := importedPath
+	if !path.IsAbs(importedPath) {
+		dir := "/"
+		if len(importedFrom) != 0 {
+			dir = path.Dir(importedFrom)
+		}
+		foundAt = path.Join(dir, importedPath)
+	}
+	foundAt = path.Clean(foundAt)
+
+	if contents, ok := i.cache[foundAt]; ok {
+		return contents, foundAt, nil
+	}
+	if i.fs == nil {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %q: no filesystem defined", importedPath)
+	}
+	data, err := vfs.ReadFile(i.fs, foundAt)
+	if err != nil {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %q: %w", importedPath, err)
+	}
+	contents := jsonnet.MakeContentsRaw(data)
+	i.cache[foundAt] = contents
+	return contents, foundAt, nil
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-jsonnet"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/targetresolver"
+)
+
+// anonymousTemplateName is the name of inline templates that is used in error messages.
+const anonymousTemplateName = "template"
+
+// Templater is the jsonnet implementation for landscaper templating.
+// The template values are available as external variables, e.g. std.extVar("imports"),
+// and the landscaper templating functions as native functions, e.g. std.native("getResource").
+type Templater struct {
+	state          template.GenericStateHandler
+	inputFormatter *template.TemplateInputFormatter
+	targetResolver targetresolver.TargetResolver
+}
+
+// New creates a new jsonnet execution templater.
+func New(state template.GenericStateHandler, targetResolver targetresolver.TargetResolver) *Templater {
+	return &Templater{
+		state:          state,
+		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "targets", "values", "state"),
+		targetResolver: targetResolver,
+	}
+}
+
+// WithInputFormatter ads a custom input formatter to this templater used for error messages.
+func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFormatter) *Templater {
+	t.inputFormatter = inputFormatter
+	return t
+}
+
+// StateTemplateResult describes the state in the result of a jsonnet template.
+type StateTemplateResult struct {
+	State json.RawMessage `json:"state"`
+}
+
+func (t Templater) Type() lsv1alpha1.TemplateType {
+	return lsv1alpha1.JsonnetTemplateType
+}
+
+// TemplateExecution evaluates a jsonnet template with the given values and returns the resulting json document.
+// The filename is used to resolve relative imports and is empty for inline templates.
+func (t *Templater) TemplateExecution(filename, rawTemplate string,
+	blueprint *blueprints.Blueprint,
+	cd model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) ([]byte, error) {
+
+	vm := jsonnet.MakeVM()
+	vm.Importer(NewBlueprintImporter(blueprint.Fs))
+
+	funcs, err := LandscaperJsonnetFuncs(blueprint.Fs, cd, cdList, t.targetResolver)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range funcs {
+		vm.NativeFunction(f)
+	}
+
+	for key, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal template value %q: %w", key, err)
+		}
+		vm.ExtCode(key, string(data))
+	}
+
+	// templates from files are evaluated via the importer so that relative imports are resolved relative to the file.
+	var res string
+	if len(filename) != 0 {
+		res, err = vm.EvaluateFile(filename)
+	} else {
+		res, err = vm.EvaluateAnonymousSnippet(anonymousTemplateName, rawTemplate)
+	}
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+	return []byte(res), nil
+}
+
+// TemplateSubinstallationExecutions is the jsonnet executor for a subinstallation execution.
+func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	cd model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.SubinstallationExecutorOutput, error) {
+
+	filename, rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := t.getDeployExecutionState(ctx, tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(filename, rawTemplate, blueprint, cd, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.storeDeployExecutionState(ctx, tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+	output := &template.SubinstallationExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateImportExecutions is the jsonnet executor for an import execution.
+func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ImportExecutorOutput, error) {
+
+	filename, rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.TemplateExecution(filename, rawTemplate, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &template.ImportExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateDeployExecutions is the jsonnet executor for a deploy execution.
+func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.DeployExecutorOutput, error) {
+
+	filename, rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := t.getDeployExecutionState(ctx, tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(filename, rawTemplate, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.storeDeployExecutionState(ctx, tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+	output := &template.DeployExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateExportExecutions is the jsonnet executor for an export execution.
+func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ExportExecutorOutput, error) {
+
+	filename, rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := t.getExportExecutionState(ctx, tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(filename, rawTemplate, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.storeExportExecutionState(ctx, tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+	output := &template.ExportExecutorOutput{}
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+func (t *Templater) getDeployExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	return t.getState(ctx, "deploy", tmplExec)
+}
+
+func (t *Templater) storeDeployExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	return t.storeState(ctx, "deploy", tmplExec, data)
+}
+
+func (t *Templater) getExportExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	return t.getState(ctx, "export", tmplExec)
+}
+
+func (t *Templater) storeExportExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	return t.storeState(ctx, "export", tmplExec, data)
+}
+
+func (t *Templater) getState(ctx context.Context, prefix string, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	if t.state == nil {
+		return map[string]interface{}{}, nil
+	}
+	data, err := t.state.Get(ctx, prefix+tmplExec.Name)
+	if err != nil {
+		if err == template.StateNotFoundErr {
+			return map[string]interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	var state interface{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (t *Templater) storeState(ctx context.Context, prefix string, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	if t.state == nil {
+		return nil
+	}
+	res := &StateTemplateResult{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return err
+	}
+	if len(res.State) == 0 || string(res.State) == "null" {
+		return nil
+	}
+	return t.state.Store(ctx, prefix+tmplExec.Name, res.State)
+}
+
+// getTemplateFromExecution returns the filename and the source of the template of a template executor.
+// The filename is empty for inline templates.
+func getTemplateFromExecution(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) (string, string, error) {
+	if len(tmplExec.Template.RawMessage) != 0 {
+		var rawTemplate string
+		if err := json.Unmarshal(tmplExec.Template.RawMessage, &rawTemplate); err != nil {
+			return "", "", fmt.Errorf("jsonnet templates must be defined as string: %w", err)
+		}
+		return "", rawTemplate, nil
+	}
+	if len(tmplExec.File) != 0 {
+		rawTemplateBytes, err := vfs.ReadFile(blueprint.Fs, tmplExec.File)
+		if err != nil {
+			return "", "", err
+		}
+		return tmplExec.File, string(rawTemplateBytes), nil
+	}
+	return "", "", fmt.Errorf("no template found")
+}
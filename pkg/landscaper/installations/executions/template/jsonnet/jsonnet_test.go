@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
+)
+
+var _ = Describe("Jsonnet Templater", func() {
+
+	var (
+		fs    vfs.FileSystem
+		bp    *blueprints.Blueprint
+		state template.MemoryStateHandler
+	)
+
+	inlineExecutor := func(name, tmpl string) lsv1alpha1.TemplateExecutor {
+		raw, err := json.Marshal(tmpl)
+		Expect(err).ToNot(HaveOccurred())
+		return lsv1alpha1.TemplateExecutor{
+			Name:     name,
+			Type:     lsv1alpha1.JsonnetTemplateType,
+			Template: lsv1alpha1.NewAnyJSON(raw),
+		}
+	}
+
+	BeforeEach(func() {
+		fs = memoryfs.New()
+		bp = blueprints.New(nil, fs)
+		state = template.NewMemoryStateHandler()
+	})
+
+	It("should render deploy items with the template values", func() {
+		exec := inlineExecutor("deploy", `
+local imports = std.extVar("imports");
+{
+  deployItems: [
+    {
+      name: "init",
+      type: "landscaper.gardener.cloud/mock",
+      config: { replicas: imports.replicas * 2 },
+    },
+  ],
+}`)
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"replicas": 2},
+		}
+
+		res, err := jsonnet.New(state, nil).TemplateDeployExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.DeployItems).To(HaveLen(1))
+		Expect(res.DeployItems[0].Name).To(Equal("init"))
+		Expect(res.DeployItems[0].Configuration.Raw).To(MatchJSON(`{"replicas": 4}`))
+	})
+
+	It("should render a template file that imports other files of the blueprint", func() {
+		Expect(fs.MkdirAll("/lib", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "/lib/utils.libsonnet", []byte(`{ prefix(s):: "my-" + s }`), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "/lib/data.txt", []byte(`bar`), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "/lib/export.jsonnet", []byte(`
+local utils = import "utils.libsonnet";
+{
+  exports: {
+    name: utils.prefix(std.extVar("values").name),
+    data: importstr "data.txt",
+    file: std.native("readFile")("/lib/data.txt"),
+  },
+}`), 0600)).To(Succeed())
+		exec := lsv1alpha1.TemplateExecutor{
+			Name: "export",
+			Type: lsv1alpha1.JsonnetTemplateType,
+			File: "/lib/export.jsonnet",
+		}
+		values := map[string]interface{}{
+			"values": map[string]interface{}{"name": "foo"},
+		}
+
+		res, err := jsonnet.New(state, nil).TemplateExportExecutions(exec, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Exports).To(HaveKeyWithValue("name", "my-foo"))
+		Expect(res.Exports).To(HaveKeyWithValue("data", "bar"))
+		Expect(res.Exports).To(HaveKeyWithValue("file", "bar"))
+	})
+
+	It("should store the state and provide it to the next execution", func() {
+		exec := inlineExecutor("deploy", `
+local state = std.extVar("state");
+local counter = if std.objectHas(state, "counter") then state.counter + 1 else 1;
+{
+  deployItems: [],
+  state: { counter: counter },
+}`)
+		templater := jsonnet.New(state, nil)
+
+		_, err := templater.TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = templater.TemplateDeployExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := state.Get(context.Background(), "deploydeploy")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"counter": 2}`))
+	})
+
+	It("should provide the oci reference helpers as native functions", func() {
+		exec := inlineExecutor("import", `
+local ref = "example.com/my/image:1.0.0";
+{
+  bindings: {
+    parsed: std.native("parseOCIRef")(ref),
+    repo: std.native("ociRefRepo")(ref),
+    version: std.native("ociRefVersion")(ref),
+  },
+}`)
+
+		res, err := jsonnet.New(state, nil).TemplateImportExecutions(exec, bp, nil, nil, map[string]interface{}{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Bindings).To(HaveKeyWithValue("parsed", ConsistOf("example.com/my/image", "1.0.0")))
+		Expect(res.Bindings).To(HaveKeyWithValue("repo", "example.com/my/image"))
+		Expect(res.Bindings).To(HaveKeyWithValue("version", "1.0.0"))
+	})
+
+	It("should return an error that contains the template input", func() {
+		exec := inlineExecutor("deploy", `{ deployItems: [ std.extVar("imports").missing ] }`)
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"foo": "bar"},
+		}
+
+		_, err := jsonnet.New(state, nil).TemplateDeployExecutions(exec, bp, nil, nil, values)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("missing"))
+		Expect(err.Error()).To(ContainSubstring("template input"))
+	})
+})
@@ -5,23 +5,38 @@
 package spiff
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/codec"
 	"github.com/gardener/component-spec/bindings-go/ctf"
 	imagevector "github.com/gardener/image-vector/pkg"
 	"github.com/mandelsoft/spiff/dynaml"
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
+	"github.com/mandelsoft/vfs/pkg/vfs"
 	"sigs.k8s.io/yaml"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/clusters"
+	"github.com/gardener/landscaper/pkg/utils/targetresolver"
 )
 
-func LandscaperSpiffFuncs(functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList) error {
+// LandscaperSpiffFuncs registers all additional landscaper functions that are
+// available in spiff templates.
+func LandscaperSpiffFuncs(functions spiffing.Functions,
+	fs vfs.FileSystem,
+	componentVersion model.ComponentVersion,
+	componentVersions *model.ComponentVersionList,
+	targetResolver targetresolver.TargetResolver) error {
+
 	cd, err := model.GetComponentDescriptor(componentVersion)
 	if err != nil {
 		return fmt.Errorf("unable to get component descriptor to register spiff functions: %w", err)
@@ -32,16 +47,145 @@ func LandscaperSpiffFuncs(functions spiffing.Functions, componentVersion model.C
 		return fmt.Errorf("unable to convert component descriptor list to register spiff functions: %w", err)
 	}
 
+	functions.RegisterFunction("readFile", spiffReadFile(fs))
+	functions.RegisterFunction("readDir", spiffReadDir(fs))
+	functions.RegisterFunction("resolve", spiffResolveArtifact(componentVersion))
 	functions.RegisterFunction("getResource", spiffResolveResources(cd))
+	functions.RegisterFunction("getResources", spiffResolveAllResources(cd))
 	functions.RegisterFunction("getComponent", spiffResolveComponent(cd, cdList))
+	functions.RegisterFunction("getRepositoryContext", spiffGetEffectiveRepositoryContext)
 	functions.RegisterFunction("generateImageOverwrite", spiffGenerateImageOverwrite(cd, cdList))
 	functions.RegisterFunction("parseOCIRef", parseOCIReference)
 	functions.RegisterFunction("ociRefRepo", getOCIReferenceRepository)
 	functions.RegisterFunction("ociRefVersion", getOCIReferenceVersion)
+	functions.RegisterFunction("getShootAdminKubeconfig", spiffGetShootAdminKubeconfig(targetResolver))
+	functions.RegisterFunction("getServiceAccountKubeconfig", spiffGetServiceAccountKubeconfig(targetResolver))
+	functions.RegisterFunction("getOidcKubeconfig", spiffGetOidcKubeconfig(targetResolver))
 
 	return nil
 }
 
+// fromSpiffValue converts a spiff function argument into the given go object.
+func fromSpiffValue(arg interface{}, obj interface{}) error {
+	data, err := spiffyaml.Marshal(spiffyaml.NewNode(arg, ""))
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, obj)
+}
+
+// toSpiffValue converts the given go object into a value that can be returned by a spiff function.
+func toSpiffValue(obj interface{}, binding dynaml.Binding) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	node, err := spiffyaml.Parse("", data)
+	if err != nil {
+		return nil, err
+	}
+	result, err := binding.Flow(node, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value(), nil
+}
+
+// spiffReadFile returns a function that reads a file from the blueprint's filesystem.
+// The content of the file is returned as string.
+func spiffReadFile(fs vfs.FileSystem) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) != 1 {
+			return info.Error("readFile expects exactly one argument: the path of the file")
+		}
+		path, ok := arguments[0].(string)
+		if !ok {
+			return info.Error("readFile expects a string as argument, namely the path of the file")
+		}
+		if fs == nil {
+			return info.Error("unable to read file %q: no filesystem defined", path)
+		}
+		data, err := vfs.ReadFile(fs, path)
+		if err != nil {
+			return info.Error("unable to read file %q: %s", path, err.Error())
+		}
+		return string(data), info, true
+	}
+}
+
+// spiffReadDir returns a function that lists all files and directories of a directory in the blueprint's filesystem.
+// Every entry is returned as map with the keys "name", "size" and "isDir".
+func spiffReadDir(fs vfs.FileSystem) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) != 1 {
+			return info.Error("readDir expects exactly one argument: the path of the directory")
+		}
+		path, ok := arguments[0].(string)
+		if !ok {
+			return info.Error("readDir expects a string as argument, namely the path of the directory")
+		}
+		if fs == nil {
+			return info.Error("unable to read directory %q: no filesystem defined", path)
+		}
+		files, err := vfs.ReadDir(fs, path)
+		if err != nil {
+			return info.Error("unable to read directory %q: %s", path, err.Error())
+		}
+
+		entries := make([]map[string]interface{}, len(files))
+		for i, file := range files {
+			entries[i] = map[string]interface{}{
+				"name":  file.Name(),
+				"size":  file.Size(),
+				"isDir": file.IsDir(),
+			}
+		}
+		result, err := toSpiffValue(entries, binding)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+		return result, info, true
+	}
+}
+
+// spiffResolveArtifact returns a function that resolves an artifact defined by a typed access.
+// As artifacts may contain binary data, the artifact is returned as base64 encoded string.
+func spiffResolveArtifact(componentVersion model.ComponentVersion) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) != 1 {
+			return info.Error("resolve expects exactly one argument: the access of the artifact")
+		}
+		if componentVersion == nil {
+			return info.Error("unable to resolve artifact, because no component version is provided")
+		}
+
+		access := map[string]interface{}{}
+		if err := fromSpiffValue(arguments[0], &access); err != nil {
+			return info.Error("resolve expects an access object as argument: %s", err.Error())
+		}
+		accessType, ok := access["type"].(string)
+		if !ok {
+			return info.Error("resolve expects an access object with a type as argument")
+		}
+
+		blobResolver, err := componentVersion.GetBlobResolver()
+		if err != nil {
+			return info.Error("unable to get blob resolver to resolve artifact: %s", err.Error())
+		}
+
+		ctx := context.Background()
+		defer ctx.Done()
+		var data bytes.Buffer
+		if _, err := blobResolver.Resolve(ctx, types.Resource{Access: cdv2.NewUnstructuredType(accessType, access)}, &data); err != nil {
+			return info.Error("unable to resolve artifact: %s", err.Error())
+		}
+		return base64.StdEncoding.EncodeToString(data.Bytes()), info, true
+	}
+}
+
 func spiffResolveResources(cd *types.ComponentDescriptor) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
 	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
 		info := dynaml.DefaultInfo()
@@ -78,6 +222,56 @@ func spiffResolveResources(cd *types.ComponentDescriptor) func(arguments []inter
 	}
 }
 
+func spiffResolveAllResources(cd *types.ComponentDescriptor) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) < 2 {
+			return info.Error("getResources expects at least 2 arguments")
+		}
+		var val []interface{}
+		if err := fromSpiffValue(arguments, &val); err != nil {
+			return info.Error(err.Error())
+		}
+
+		resources, err := template.ResolveResources(cd, val)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+
+		result, err := toSpiffValue(resources, binding)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+		return result, info, true
+	}
+}
+
+func spiffGetEffectiveRepositoryContext(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	info := dynaml.DefaultInfo()
+	if len(arguments) != 1 {
+		return info.Error("getRepositoryContext expects exactly one argument: the component descriptor")
+	}
+
+	cdMap := map[string]interface{}{}
+	if err := fromSpiffValue(arguments[0], &cdMap); err != nil {
+		return info.Error("invalid component descriptor: %s", err.Error())
+	}
+	data, err := json.Marshal(cdMap)
+	if err != nil {
+		return info.Error("invalid component descriptor: %s", err.Error())
+	}
+	cd := &types.ComponentDescriptor{}
+	if err := codec.Decode(data, cd); err != nil {
+		return info.Error("invalid component descriptor: %s", err.Error())
+	}
+
+	result, err := toSpiffValue(cd.GetEffectiveRepositoryContext(), binding)
+	if err != nil {
+		return info.Error("unable to serialize repository context: %s", err.Error())
+	}
+	return result, info, true
+}
+
 func spiffResolveComponent(cd *types.ComponentDescriptor, cdList *types.ComponentDescriptorList) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
 	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
 		info := dynaml.DefaultInfo()
@@ -262,3 +456,127 @@ func getOCIReferenceVersion(arguments []interface{}, binding dynaml.Binding) (in
 
 	return result.Value(), info, true
 }
+
+func spiffGetShootAdminKubeconfig(targetResolver targetresolver.TargetResolver) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) != 4 {
+			return info.Error("templating function getShootAdminKubeconfig expects 4 arguments: shoot name, shoot namespace, expiration seconds, and target for garden project")
+		}
+
+		shootName, ok := arguments[0].(string)
+		if !ok {
+			return info.Error("templating function getShootAdminKubeconfig expects a string as 1st argument, namely the shoot name")
+		}
+
+		shootNamespace, ok := arguments[1].(string)
+		if !ok {
+			return info.Error("templating function getShootAdminKubeconfig expects a string as 2nd argument, namely the shoot namespace")
+		}
+
+		expirationSeconds, err := template.ToInt64(arguments[2])
+		if err != nil {
+			return info.Error("templating function getShootAdminKubeconfig expects an integer as 3rd argument, namely the expiration seconds: %s", err.Error())
+		}
+
+		target := &lsv1alpha1.Target{}
+		if err := fromSpiffValue(arguments[3], target); err != nil {
+			return info.Error("templating function getShootAdminKubeconfig expects a target object as 4th argument: %s", err.Error())
+		}
+
+		if targetResolver == nil {
+			return info.Error("templating function getShootAdminKubeconfig is not supported: no target resolver defined")
+		}
+
+		ctx := context.Background()
+		shootClient, err := clusters.NewShootClientFromTarget(ctx, target, targetResolver)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+
+		kubeconfig, err := shootClient.GetShootAdminKubeconfig(ctx, shootName, shootNamespace, expirationSeconds)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+		return kubeconfig, info, true
+	}
+}
+
+func spiffGetServiceAccountKubeconfig(targetResolver targetresolver.TargetResolver) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) != 4 {
+			return info.Error("templating function getServiceAccountKubeconfig expects 4 arguments: service account name, service account namespace, expiration seconds, and target")
+		}
+
+		serviceAccountName, ok := arguments[0].(string)
+		if !ok {
+			return info.Error("templating function getServiceAccountKubeconfig expects a string as 1st argument, namely the service account name")
+		}
+
+		serviceAccountNamespace, ok := arguments[1].(string)
+		if !ok {
+			return info.Error("templating function getServiceAccountKubeconfig expects a string as 2nd argument, namely the service account namespace")
+		}
+
+		expirationSeconds, err := template.ToInt64(arguments[2])
+		if err != nil {
+			return info.Error("templating function getServiceAccountKubeconfig expects an integer as 3rd argument, namely the expiration seconds: %s", err.Error())
+		}
+
+		target := &lsv1alpha1.Target{}
+		if err := fromSpiffValue(arguments[3], target); err != nil {
+			return info.Error("templating function getServiceAccountKubeconfig expects a target object as 4th argument: %s", err.Error())
+		}
+
+		if targetResolver == nil {
+			return info.Error("templating function getServiceAccountKubeconfig is not supported: no target resolver defined")
+		}
+
+		ctx := context.Background()
+		tokenClient, err := clusters.NewTokenClientFromTarget(ctx, target, targetResolver)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+
+		kubeconfig, err := tokenClient.GetServiceAccountKubeconfig(ctx, serviceAccountName, serviceAccountNamespace, expirationSeconds)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+		return kubeconfig, info, true
+	}
+}
+
+func spiffGetOidcKubeconfig(targetResolver targetresolver.TargetResolver) func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	return func(arguments []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(arguments) != 3 {
+			return info.Error("templating function getOidcKubeconfig expects 3 arguments: issuer url, client id, and target")
+		}
+
+		issuerURL, ok := arguments[0].(string)
+		if !ok {
+			return info.Error("templating function getOidcKubeconfig expects a string as 1st argument, namely the issuer url")
+		}
+
+		clientID, ok := arguments[1].(string)
+		if !ok {
+			return info.Error("templating function getOidcKubeconfig expects a string as 2nd argument, namely the client id")
+		}
+
+		target := &lsv1alpha1.Target{}
+		if err := fromSpiffValue(arguments[2], target); err != nil {
+			return info.Error("templating function getOidcKubeconfig expects a target object as 3rd argument: %s", err.Error())
+		}
+
+		if targetResolver == nil {
+			return info.Error("templating function getOidcKubeconfig is not supported: no target resolver defined")
+		}
+
+		kubeconfig, err := clusters.BuildOIDCKubeconfig(context.Background(), issuerURL, clientID, target, targetResolver)
+		if err != nil {
+			return info.Error(err.Error())
+		}
+		return kubeconfig, info, true
+	}
+}
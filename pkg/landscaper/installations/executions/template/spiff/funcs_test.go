@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package spiff_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	secretresolver "github.com/gardener/landscaper/pkg/utils/targetresolver/secret"
+)
+
+var _ = Describe("Landscaper Spiff Functions", func() {
+
+	var (
+		fs vfs.FileSystem
+		bp *blueprints.Blueprint
+	)
+
+	BeforeEach(func() {
+		fs = memoryfs.New()
+		Expect(fs.MkdirAll("data", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "data/a.txt", []byte("content of a"), 0600)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "data/b.txt", []byte("b"), 0600)).To(Succeed())
+		Expect(fs.MkdirAll("data/sub", 0755)).To(Succeed())
+		bp = blueprints.New(&lsv1alpha1.Blueprint{}, fs)
+	})
+
+	executeTemplate := func(t *spiff.Templater, tmpl string, values map[string]interface{}) (map[string]interface{}, error) {
+		exec := lsv1alpha1.TemplateExecutor{
+			Name:     "test",
+			Type:     lsv1alpha1.SpiffTemplateType,
+			Template: lsv1alpha1.AnyJSON{RawMessage: json.RawMessage(tmpl)},
+		}
+		res, err := t.TemplateDeployExecutions(exec, bp, nil, nil, values)
+		if err != nil {
+			return nil, err
+		}
+		ExpectWithOffset(1, res.DeployItems).To(HaveLen(1))
+		config := map[string]interface{}{}
+		ExpectWithOffset(1, yaml.Unmarshal(res.DeployItems[0].Configuration.Raw, &config)).To(Succeed())
+		return config, nil
+	}
+
+	It("should read a file from the blueprint filesystem", func() {
+		config, err := executeTemplate(spiff.New(nil, nil), `{"deployItems": [{"name": "init", "type": "mock", "config": {"content": "(( readFile(\"data/a.txt\") ))"}}]}`, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(HaveKeyWithValue("content", "content of a"))
+	})
+
+	It("should return an error if a file does not exist", func() {
+		_, err := executeTemplate(spiff.New(nil, nil), `{"deployItems": [{"name": "init", "type": "mock", "config": {"content": "(( readFile(\"data/c.txt\") ))"}}]}`, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should list the entries of a directory of the blueprint filesystem", func() {
+		config, err := executeTemplate(spiff.New(nil, nil), `{"deployItems": [{"name": "init", "type": "mock", "config": {"entries": "(( readDir(\"data\") ))"}}]}`, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(HaveKeyWithValue("entries", ConsistOf(
+			map[string]interface{}{"name": "a.txt", "size": float64(12), "isDir": false},
+			map[string]interface{}{"name": "b.txt", "size": float64(1), "isDir": false},
+			HaveKeyWithValue("isDir", true),
+		)))
+	})
+
+	It("should return an error if the kubeconfig functions are called with wrong arguments", func() {
+		_, err := executeTemplate(spiff.New(nil, nil), `{"deployItems": [{"name": "init", "type": "mock", "config": {"kubeconfig": "(( getShootAdminKubeconfig(\"name\", \"namespace\") ))"}}]}`, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("getShootAdminKubeconfig expects 4 arguments"))
+
+		_, err = executeTemplate(spiff.New(nil, nil), `{"deployItems": [{"name": "init", "type": "mock", "config": {"kubeconfig": "(( getServiceAccountKubeconfig(\"name\", \"namespace\", \"abc\", {}) ))"}}]}`, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("expects an integer as 3rd argument"))
+	})
+
+	It("should build an oidc kubeconfig from a target", func() {
+		kubeconfig := clientcmdapi.Config{
+			Clusters: map[string]*clientcmdapi.Cluster{
+				"cluster": {Server: "https://api.test.example"},
+			},
+			AuthInfos: map[string]*clientcmdapi.AuthInfo{
+				"user": {Token: "secret-token"},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				"context": {Cluster: "cluster", AuthInfo: "user"},
+			},
+			CurrentContext: "context",
+		}
+		kubeconfigBytes, err := clientcmd.Write(kubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{
+				"cluster": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"type": string(targettypes.KubernetesClusterTargetType),
+						"config": map[string]interface{}{
+							"kubeconfig": string(kubeconfigBytes),
+						},
+					},
+				},
+			},
+		}
+
+		config, err := executeTemplate(spiff.New(nil, secretresolver.New(nil)), `{"deployItems": [{"name": "init", "type": "mock", "config": {"kubeconfig": "(( getOidcKubeconfig(\"https://issuer.example\", \"my-client\", imports.cluster) ))"}}]}`, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(HaveKey("kubeconfig"))
+
+		oidcKubeconfigBytes, err := base64.StdEncoding.DecodeString(config["kubeconfig"].(string))
+		Expect(err).ToNot(HaveOccurred())
+		oidcKubeconfig, err := clientcmd.Load(oidcKubeconfigBytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(oidcKubeconfig.AuthInfos).To(HaveKey("user"))
+		Expect(oidcKubeconfig.AuthInfos["user"].Token).To(BeEmpty())
+		Expect(oidcKubeconfig.AuthInfos["user"].Exec.Args).To(ContainElements(
+			"--oidc-issuer-url=https://issuer.example",
+			"--oidc-client-id=my-client",
+		))
+	})
+})
@@ -34,11 +34,16 @@ type GenericStateHandler interface {
 type KubernetesStateHandler struct {
 	KubeClient client.Client
 	Inst       *lsv1alpha1.Installation
+	// ReadOnly defines that existing state is read but new state is not persisted.
+	ReadOnly bool
 }
 
 var _ GenericStateHandler = &KubernetesStateHandler{}
 
 func (s KubernetesStateHandler) Store(ctx context.Context, name string, data []byte) error {
+	if s.ReadOnly {
+		return nil
+	}
 	name = s.secretName(name)
 	secret, err := s.get(ctx, name)
 	if err != nil {
@@ -150,6 +150,14 @@ type DeployItemSpecification struct {
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
 	OnDelete *core.OnDeleteConfig
+
+	// RequiresApproval specifies that the deploy item is only triggered after a manual approval.
+	// +optional
+	RequiresApproval bool `json:"requiresApproval,omitempty"`
+
+	// Retry configures automatic retries of the deploy item if it fails.
+	// +optional
+	Retry *core.DeployItemRetryPolicy `json:"retry,omitempty"`
 }
 
 // DeployExecutorOutput describes the output of deploy executor.
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	secretresolver "github.com/gardener/landscaper/pkg/utils/targetresolver/secret"
 )
@@ -83,7 +84,8 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 
 	tmpl := template.New(
 		gotemplate.New(stateHdlr, targetResolver),
-		spiff.New(stateHdlr))
+		spiff.New(stateHdlr, targetResolver),
+		jsonnet.New(stateHdlr, targetResolver))
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -109,12 +111,23 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 
 		switch def.Type {
 		case lsv1alpha1.ExportTypeData:
-			validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
-			if err != nil {
-				return nil, nil, fmt.Errorf("%s: validator creation failed: %s", fldPath.String(), err.Error())
+			if def.Schema != nil {
+				validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: validator creation failed: %s", fldPath.String(), err.Error())
+				}
+				if err := validator.ValidateGoStruct(data); err != nil {
+					return nil, nil, fmt.Errorf("%s: exported data does not satisfy the configured schema: %s", fldPath.String(), err.Error())
+				}
 			}
-			if err := validator.ValidateGoStruct(data); err != nil {
-				return nil, nil, fmt.Errorf("%s: exported data does not satisfy the configured schema: %s", fldPath.String(), err.Error())
+			if def.Cue != nil {
+				validator, err := c.CueValidator(def.Cue)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: cue validator creation failed: %s", fldPath.String(), err.Error())
+				}
+				if err := validator.ValidateGoStruct(data); err != nil {
+					return nil, nil, fmt.Errorf("%s: exported data does not satisfy the configured cue definition: %s", fldPath.String(), err.Error())
+				}
 			}
 		case lsv1alpha1.ExportTypeTarget:
 			var targetType string
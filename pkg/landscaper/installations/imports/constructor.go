@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	secretresolver "github.com/gardener/landscaper/pkg/utils/targetresolver/secret"
 )
@@ -145,15 +146,28 @@ func (c *Constructor) constructImports(
 				}
 				return nil, installations.NewImportNotFoundErrorf(nil, "blueprint defines import %q of type %s, which is not satisfied", def.Name, lsv1alpha1.ImportTypeData)
 			}
-			if def.Schema == nil {
+			if def.Schema == nil && def.Cue == nil {
 				return nil, installations.NewErrorf(installations.SchemaValidationFailed, fmt.Errorf("schema is nil"), "%s: no schema defined", defPath.String())
 			}
-			validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
-			if err != nil {
-				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
+			if def.Cue != nil {
+				// the cue definition is evaluated first so that its defaults are also validated by the jsonschema
+				validator, err := c.CueValidator(def.Cue)
+				if err != nil {
+					return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: cue validator creation failed", defPath.String())
+				}
+				imports[def.Name], err = validator.Complete(imports[def.Name])
+				if err != nil {
+					return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not satisfy the cue definition", defPath.String())
+				}
 			}
-			if err := validator.ValidateGoStruct(imports[def.Name]); err != nil {
-				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String())
+			if def.Schema != nil {
+				validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
+				if err != nil {
+					return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
+				}
+				if err := validator.ValidateGoStruct(imports[def.Name]); err != nil {
+					return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String())
+				}
 			}
 			if len(def.ConditionalImports) > 0 {
 				// recursively check conditional imports
@@ -290,11 +304,13 @@ func (c *Constructor) RenderImportExecutions() error {
 	templateStateHandler := template.KubernetesStateHandler{
 		KubeClient: c.Operation.Client(),
 		Inst:       c.Operation.Inst.GetInstallation(),
+		ReadOnly:   c.Operation.DryRun,
 	}
 	targetResolver := secretresolver.New(c.Operation.Client())
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, targetResolver),
-		spiff.New(templateStateHandler))
+		spiff.New(templateStateHandler, targetResolver),
+		jsonnet.New(templateStateHandler, targetResolver))
 	errors, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			c.Operation.Context().External.InjectComponentDescriptorRef(c.Operation.Inst.GetInstallation()),
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// GetMaintenancePolicy returns the maintenance policy that applies to an installation.
+// The maintenance policy of the installation overwrites the one of its context,
+// but a paused context also pauses installations with their own maintenance policy.
+func GetMaintenancePolicy(inst *lsv1alpha1.Installation, lsCtx *lsv1alpha1.Context) *lsv1alpha1.MaintenancePolicy {
+	var ctxPolicy *lsv1alpha1.MaintenancePolicy
+	if lsCtx != nil {
+		ctxPolicy = lsCtx.MaintenancePolicy
+	}
+
+	instPolicy := inst.Spec.MaintenancePolicy
+	if instPolicy == nil {
+		return ctxPolicy
+	}
+	if ctxPolicy != nil && ctxPolicy.Paused && !instPolicy.Paused {
+		policy := instPolicy.DeepCopy()
+		policy.Paused = true
+		return policy
+	}
+	return instPolicy
+}
+
+// CheckMaintenancePolicy checks whether changes may be rolled out at the given time.
+// It returns nil if the rollout is allowed, otherwise a status that describes why the rollout is postponed.
+// The time since when the rollout is pending is not set in the returned status.
+func CheckMaintenancePolicy(policy *lsv1alpha1.MaintenancePolicy, now time.Time) (*lsv1alpha1.MaintenanceStatus, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.Paused {
+		return &lsv1alpha1.MaintenanceStatus{
+			Reason:  lsv1alpha1.MaintenanceReasonPaused,
+			Message: "The rollout is postponed as the maintenance policy is paused",
+		}, nil
+	}
+	if len(policy.Windows) == 0 {
+		return nil, nil
+	}
+
+	var nextStart time.Time
+	for i, window := range policy.Windows {
+		schedule, err := cron.ParseStandard(window.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule of maintenance window %d: %w", i, err)
+		}
+		// the first start after now minus the duration is either the start of the current window or of the next one
+		start := schedule.Next(now.UTC().Add(-window.Duration.Duration))
+		if !start.After(now) {
+			return nil, nil
+		}
+		if nextStart.IsZero() || start.Before(nextStart) {
+			nextStart = start
+		}
+	}
+
+	next := metav1.NewTime(nextStart)
+	return &lsv1alpha1.MaintenanceStatus{
+		Reason: lsv1alpha1.MaintenanceReasonOutsideWindow,
+		Message: fmt.Sprintf("The rollout is postponed until the next maintenance window starts at %s",
+			nextStart.UTC().Format(time.RFC3339)),
+		NextWindowStart: &next,
+	}, nil
+}
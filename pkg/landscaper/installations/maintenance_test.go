@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("Maintenance Policy", func() {
+
+	// a wednesday
+	now := time.Date(2023, 6, 14, 10, 30, 0, 0, time.UTC)
+
+	window := func(schedule string, duration time.Duration) lsv1alpha1.MaintenanceWindow {
+		return lsv1alpha1.MaintenanceWindow{
+			Schedule: schedule,
+			Duration: lsv1alpha1.Duration{Duration: duration},
+		}
+	}
+
+	Context("CheckMaintenancePolicy", func() {
+
+		It("should allow the rollout if no policy is defined", func() {
+			status, err := installations.CheckMaintenancePolicy(nil, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(BeNil())
+
+			status, err = installations.CheckMaintenancePolicy(&lsv1alpha1.MaintenancePolicy{}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+
+		It("should postpone the rollout if the policy is paused", func() {
+			policy := &lsv1alpha1.MaintenancePolicy{
+				Paused:  true,
+				Windows: []lsv1alpha1.MaintenanceWindow{window("0 10 * * *", time.Hour)},
+			}
+			status, err := installations.CheckMaintenancePolicy(policy, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).ToNot(BeNil())
+			Expect(status.Reason).To(Equal(lsv1alpha1.MaintenanceReasonPaused))
+			Expect(status.NextWindowStart).To(BeNil())
+		})
+
+		It("should allow the rollout within a maintenance window", func() {
+			policy := &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{
+					window("0 22 * * *", 2*time.Hour),
+					window("0 10 * * 3", time.Hour),
+				},
+			}
+			status, err := installations.CheckMaintenancePolicy(policy, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+
+		It("should postpone the rollout until the next maintenance window starts", func() {
+			policy := &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{
+					window("0 22 * * *", 2*time.Hour),
+					window("0 8 * * 3", time.Hour),
+				},
+			}
+			status, err := installations.CheckMaintenancePolicy(policy, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).ToNot(BeNil())
+			Expect(status.Reason).To(Equal(lsv1alpha1.MaintenanceReasonOutsideWindow))
+			Expect(status.NextWindowStart).ToNot(BeNil())
+			Expect(status.NextWindowStart.Time).To(BeTemporally("==", time.Date(2023, 6, 14, 22, 0, 0, 0, time.UTC)))
+		})
+
+		It("should evaluate the schedule in the given time zone", func() {
+			policy := &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{window("CRON_TZ=Asia/Tokyo 0 19 * * *", time.Hour)},
+			}
+			// 10:30 UTC is 19:30 in Tokyo
+			status, err := installations.CheckMaintenancePolicy(policy, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+
+		It("should return an error for an invalid schedule", func() {
+			policy := &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{window("every day", time.Hour)},
+			}
+			_, err := installations.CheckMaintenancePolicy(policy, now)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("GetMaintenancePolicy", func() {
+
+		It("should default the policy of the installation to the one of the context", func() {
+			inst := &lsv1alpha1.Installation{}
+			lsCtx := &lsv1alpha1.Context{}
+			Expect(installations.GetMaintenancePolicy(inst, lsCtx)).To(BeNil())
+
+			lsCtx.MaintenancePolicy = &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{window("0 22 * * *", time.Hour)},
+			}
+			Expect(installations.GetMaintenancePolicy(inst, lsCtx)).To(Equal(lsCtx.MaintenancePolicy))
+		})
+
+		It("should overwrite the policy of the context but respect a paused context", func() {
+			inst := &lsv1alpha1.Installation{}
+			inst.Spec.MaintenancePolicy = &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{window("0 10 * * *", time.Hour)},
+			}
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.MaintenancePolicy = &lsv1alpha1.MaintenancePolicy{
+				Windows: []lsv1alpha1.MaintenanceWindow{window("0 22 * * *", time.Hour)},
+			}
+			Expect(installations.GetMaintenancePolicy(inst, lsCtx)).To(Equal(inst.Spec.MaintenancePolicy))
+
+			lsCtx.MaintenancePolicy.Paused = true
+			policy := installations.GetMaintenancePolicy(inst, lsCtx)
+			Expect(policy.Paused).To(BeTrue())
+			Expect(policy.Windows).To(Equal(inst.Spec.MaintenancePolicy.Windows))
+			Expect(inst.Spec.MaintenancePolicy.Paused).To(BeFalse())
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/cueschema"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
@@ -46,6 +47,10 @@ type Operation struct {
 
 	// CurrentOperation is the name of the current operation that is used for the error reporting
 	CurrentOperation string
+
+	// DryRun defines that the templating state is not persisted.
+	// It is used to render the installation without applying it.
+	DryRun bool
 }
 
 // NewInstallationOperationFromOperation creates a new installation operation from an existing common operation.
@@ -116,6 +121,19 @@ func (o *Operation) JSONSchemaValidator(schema []byte) (*jsonschema.Validator, e
 	return v, nil
 }
 
+// CueValidator returns a validator for the given CUE definition.
+func (o *Operation) CueValidator(def *lsv1alpha1.CueDefinition) (*cueschema.Validator, error) {
+	v := cueschema.NewValidator(&cueschema.ReferenceContext{
+		BlueprintFs:       o.Inst.GetBlueprint().Fs,
+		ComponentVersion:  o.ComponentVersion,
+		RepositoryContext: o.context.External.RepositoryContext,
+	})
+	if err := v.CompileDefinition(def); err != nil {
+		return nil, fmt.Errorf("error compiling cue definition: %w", err)
+	}
+	return v, nil
+}
+
 // ListSubinstallations returns a list of all subinstallations of the given installation.
 // Returns nil if no installations can be found
 func (o *Operation) ListSubinstallations(ctx context.Context, filter ...FilterInstallationFunc) ([]*lsv1alpha1.Installation, error) {
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/diff"
+)
+
+// ComputeSubinstallationChanges compares the specifications of the current subinstallations with the rendered ones.
+// Both maps are indexed by the name of the installation template.
+func ComputeSubinstallationChanges(current map[string]*lsv1alpha1.Installation,
+	rendered map[string]*lsv1alpha1.InstallationSpec) ([]lsv1alpha1.PlannedChange, error) {
+
+	currentSpecs := make(map[string]interface{}, len(current))
+	for name, inst := range current {
+		currentSpecs[name] = inst.Spec
+	}
+	renderedSpecs := make(map[string]interface{}, len(rendered))
+	for name, spec := range rendered {
+		renderedSpecs[name] = spec
+	}
+	return computeChanges(currentSpecs, renderedSpecs)
+}
+
+// ComputeDeployItemChanges compares the deploy item templates of the current execution with the rendered ones.
+func ComputeDeployItemChanges(current, rendered lsv1alpha1.DeployItemTemplateList) ([]lsv1alpha1.PlannedChange, error) {
+	currentTemplates := make(map[string]interface{}, len(current))
+	for _, tmpl := range current {
+		currentTemplates[tmpl.Name] = tmpl
+	}
+	renderedTemplates := make(map[string]interface{}, len(rendered))
+	for _, tmpl := range rendered {
+		renderedTemplates[tmpl.Name] = tmpl
+	}
+	return computeChanges(currentTemplates, renderedTemplates)
+}
+
+func computeChanges(current, rendered map[string]interface{}) ([]lsv1alpha1.PlannedChange, error) {
+	changes := make([]lsv1alpha1.PlannedChange, 0, len(rendered))
+	for name, renderedObj := range rendered {
+		currentObj, ok := current[name]
+		if !ok {
+			changes = append(changes, lsv1alpha1.PlannedChange{
+				Name:   name,
+				Action: lsv1alpha1.PlannedChangeActionCreate,
+			})
+			continue
+		}
+
+		changedFields, err := diff.ChangedFields(currentObj, renderedObj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare %q: %w", name, err)
+		}
+		action := lsv1alpha1.PlannedChangeActionNone
+		if len(changedFields) != 0 {
+			action = lsv1alpha1.PlannedChangeActionUpdate
+		}
+		changes = append(changes, lsv1alpha1.PlannedChange{
+			Name:          name,
+			Action:        action,
+			ChangedFields: changedFields,
+		})
+	}
+
+	for name := range current {
+		if _, ok := rendered[name]; !ok {
+			changes = append(changes, lsv1alpha1.PlannedChange{
+				Name:   name,
+				Action: lsv1alpha1.PlannedChangeActionDelete,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes, nil
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/plan"
+)
+
+var _ = Describe("Plan", func() {
+
+	Context("ComputeDeployItemChanges", func() {
+		It("should detect created, updated, deleted and unchanged deploy items", func() {
+			current := lsv1alpha1.DeployItemTemplateList{
+				{Name: "unchanged", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"a":1}`)}},
+				{Name: "updated", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"a":1}`)}},
+				{Name: "deleted", Type: "mock"},
+			}
+			rendered := lsv1alpha1.DeployItemTemplateList{
+				{Name: "unchanged", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"a": 1}`)}},
+				{Name: "updated", Type: "mock", Configuration: &runtime.RawExtension{Raw: []byte(`{"a":2}`)}, DependsOn: []string{"unchanged"}},
+				{Name: "created", Type: "mock"},
+			}
+
+			changes, err := plan.ComputeDeployItemChanges(current, rendered)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(Equal([]lsv1alpha1.PlannedChange{
+				{Name: "created", Action: lsv1alpha1.PlannedChangeActionCreate},
+				{Name: "deleted", Action: lsv1alpha1.PlannedChangeActionDelete},
+				{Name: "unchanged", Action: lsv1alpha1.PlannedChangeActionNone, ChangedFields: []string{}},
+				{Name: "updated", Action: lsv1alpha1.PlannedChangeActionUpdate, ChangedFields: []string{"config.a", "dependsOn"}},
+			}))
+		})
+	})
+
+	Context("ComputeSubinstallationChanges", func() {
+		It("should detect created, updated and deleted subinstallations", func() {
+			current := map[string]*lsv1alpha1.Installation{
+				"updated": {Spec: lsv1alpha1.InstallationSpec{Context: "default"}},
+				"deleted": {Spec: lsv1alpha1.InstallationSpec{Context: "default"}},
+			}
+			rendered := map[string]*lsv1alpha1.InstallationSpec{
+				"updated": {Context: "other"},
+				"created": {Context: "default"},
+			}
+
+			changes, err := plan.ComputeSubinstallationChanges(current, rendered)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(Equal([]lsv1alpha1.PlannedChange{
+				{Name: "created", Action: lsv1alpha1.PlannedChangeActionCreate},
+				{Name: "deleted", Action: lsv1alpha1.PlannedChangeActionDelete},
+				{Name: "updated", Action: lsv1alpha1.PlannedChangeActionUpdate, ChangedFields: []string{"context"}},
+			}))
+		})
+	})
+})
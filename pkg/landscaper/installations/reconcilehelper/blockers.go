@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilehelper
+
+import (
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+// GetBlockers returns the sibling installations, data objects and targets the installation is waiting for.
+// Predecessors are reported if they have a pending reconcile, have not finished the current job or have not succeeded.
+// Imports are reported if the imported object does not exist or if its exporting sibling is recomputing its exports.
+// An empty list is returned if nothing blocks the installation.
+func (rh *ReconcileHelper) GetBlockers(installation *lsv1alpha1.Installation,
+	predecessorMap map[string]*installations.InstallationAndImports) ([]lsv1alpha1.InstallationBlocker, error) {
+	blockers := []lsv1alpha1.InstallationBlocker{}
+
+	predecessorNames := make([]string, 0, len(predecessorMap))
+	for name := range predecessorMap {
+		predecessorNames = append(predecessorNames, name)
+	}
+	sort.Strings(predecessorNames)
+	for _, name := range predecessorNames {
+		if blocker := rh.getPredecessorBlocker(installation, predecessorMap[name].GetInstallation()); blocker != nil {
+			blockers = append(blockers, *blocker)
+		}
+	}
+
+	for _, dataImport := range installation.Spec.Imports.Data {
+		if len(dataImport.DataRef) == 0 {
+			continue
+		}
+		blocker, err := rh.getImportBlocker(installation, &lsv1alpha1.DataObject{},
+			lsv1alpha1.InstallationBlockerKindDataObject, dataImport.Name, dataImport.DataRef)
+		if err != nil {
+			return nil, err
+		}
+		if blocker != nil {
+			blockers = append(blockers, *blocker)
+		}
+	}
+
+	for _, targetImport := range installation.Spec.Imports.Targets {
+		targetNames := targetImport.Targets
+		if len(targetImport.Target) != 0 {
+			targetNames = []string{targetImport.Target}
+		}
+		for _, targetName := range targetNames {
+			blocker, err := rh.getImportBlocker(installation, &lsv1alpha1.Target{},
+				lsv1alpha1.InstallationBlockerKindTarget, targetImport.Name, targetName)
+			if err != nil {
+				return nil, err
+			}
+			if blocker != nil {
+				blockers = append(blockers, *blocker)
+			}
+		}
+	}
+
+	return blockers, nil
+}
+
+// getPredecessorBlocker returns a blocker if the given predecessor prevents the processing of the installation.
+// The checks are the same as in AllPredecessorsFinished and AllPredecessorsSucceeded.
+func (rh *ReconcileHelper) getPredecessorBlocker(installation, predecessor *lsv1alpha1.Installation) *lsv1alpha1.InstallationBlocker {
+	blocker := &lsv1alpha1.InstallationBlocker{
+		Kind: lsv1alpha1.InstallationBlockerKindInstallation,
+		Name: predecessor.Name,
+	}
+
+	if installations.IsRootInstallation(installation) {
+		if lsv1alpha1helper.HasOperation(predecessor.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+			blocker.Reason = lsv1alpha1.InstallationBlockerReasonReconcilePending
+			blocker.Message = fmt.Sprintf("installation %q has a reconcile annotation", predecessor.Name)
+			return blocker
+		}
+		if predecessor.Status.JobID != predecessor.Status.JobIDFinished {
+			blocker.Reason = lsv1alpha1.InstallationBlockerReasonNotFinished
+			blocker.Message = fmt.Sprintf("installation %q has not finished its current job %q", predecessor.Name, predecessor.Status.JobID)
+			return blocker
+		}
+	} else if installation.Status.JobID != predecessor.Status.JobIDFinished {
+		blocker.Reason = lsv1alpha1.InstallationBlockerReasonNotFinished
+		blocker.Message = fmt.Sprintf("installation %q has not finished the current job %q", predecessor.Name, installation.Status.JobID)
+		return blocker
+	}
+
+	if predecessor.Status.InstallationPhase != lsv1alpha1.InstallationPhases.Succeeded {
+		blocker.Reason = lsv1alpha1.InstallationBlockerReasonNotSucceeded
+		blocker.Message = fmt.Sprintf("installation %q is in phase %q", predecessor.Name, predecessor.Status.InstallationPhase)
+		return blocker
+	}
+
+	return nil
+}
+
+// getImportBlocker returns a blocker if the imported data object or target does not exist
+// or if the sibling that exports it currently recomputes its exports.
+func (rh *ReconcileHelper) getImportBlocker(installation *lsv1alpha1.Installation, obj client.Object,
+	kind lsv1alpha1.InstallationBlockerKind, importName, ref string) (*lsv1alpha1.InstallationBlocker, error) {
+	name := lsv1alpha1helper.GenerateDataObjectName(rh.Context().Name, ref)
+	blocker := &lsv1alpha1.InstallationBlocker{
+		Kind:   kind,
+		Name:   name,
+		Import: importName,
+	}
+
+	if err := rh.Client().Get(rh.ctx, kutil.ObjectKey(name, installation.Namespace), obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to get %s %q of import %q: %w", kind, name, importName, err)
+		}
+		blocker.Reason = lsv1alpha1.InstallationBlockerReasonMissingExport
+		blocker.Message = fmt.Sprintf("%s %q referenced by import %q does not exist", kind, ref, importName)
+		return blocker, nil
+	}
+
+	owner := kutil.GetMainOwnerFromOwnerReferences(obj.GetOwnerReferences())
+	if !installations.OwnerReferenceIsInstallationButNoParent(owner, installation) {
+		return nil, nil
+	}
+	exporter, ok := rh.siblings[owner.Name]
+	if !ok {
+		return nil, nil
+	}
+	exporterStatus := exporter.GetInstallation().Status
+	if len(exporterStatus.ConfigGeneration) == 0 || exporterStatus.JobID != exporterStatus.JobIDFinished {
+		blocker.Reason = lsv1alpha1.InstallationBlockerReasonOutdatedConfigGeneration
+		blocker.Message = fmt.Sprintf("%s %q referenced by import %q is outdated because installation %q is recomputing its exports",
+			kind, ref, importName, owner.Name)
+		return blocker, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilehelper_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Blockers", func() {
+
+	var (
+		op *installations.Operation
+
+		fakeInstallations map[string]*lsv1alpha1.Installation
+		fakeClient        client.Client
+	)
+
+	BeforeEach(func() {
+		var (
+			err   error
+			state *envtest.State
+		)
+		fakeClient, state, err = envtest.NewFakeClientFromPath("../imports/testdata/state")
+		Expect(err).ToNot(HaveOccurred())
+
+		createDefaultContextsForNamespaces(fakeClient)
+		fakeInstallations = state.Installations
+
+		registryAccess, err := registries.NewFactory().NewLocalRegistryAccess("../testdata/registry")
+		Expect(err).ToNot(HaveOccurred())
+
+		op = &installations.Operation{
+			Operation: lsoperation.NewOperation(fakeClient, api.LandscaperScheme, record.NewFakeRecorder(1024)).SetComponentsRegistry(registryAccess),
+		}
+	})
+
+	getBlockers := func(ctx context.Context, inst *lsv1alpha1.Installation) []lsv1alpha1.InstallationBlocker {
+		inInst, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), inst)
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInst
+		Expect(op.SetInstallationContext(ctx)).To(Succeed())
+
+		rh, err := reconcilehelper.NewReconcileHelper(ctx, op)
+		Expect(err).ToNot(HaveOccurred())
+		predecessorMap, err := rh.GetPredecessors(inst, rh.FetchPredecessors())
+		Expect(err).ToNot(HaveOccurred())
+
+		blockers, err := rh.GetBlockers(inst, predecessorMap)
+		Expect(err).ToNot(HaveOccurred())
+		return blockers
+	}
+
+	It("should report an unfinished predecessor and its outdated export", func() {
+		ctx := context.Background()
+		instA := fakeInstallations["test1/a"]
+		instA.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Progressing
+		instA.Status.JobID = "2"
+		instA.Status.JobIDFinished = "1"
+		instA.Status.ConfigGeneration = ""
+		Expect(fakeClient.Status().Update(ctx, instA)).To(Succeed())
+
+		instB := fakeInstallations["test1/b"]
+		instB.Status.JobID = "2"
+
+		blockers := getBlockers(ctx, instB)
+		Expect(blockers).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"Kind":   Equal(lsv1alpha1.InstallationBlockerKindInstallation),
+				"Name":   Equal("a"),
+				"Reason": Equal(lsv1alpha1.InstallationBlockerReasonNotFinished),
+			}),
+			MatchFields(IgnoreExtras, Fields{
+				"Kind":   Equal(lsv1alpha1.InstallationBlockerKindDataObject),
+				"Name":   Equal(lsv1alpha1helper.GenerateDataObjectName(op.Context().Name, "a.z")),
+				"Import": Equal("b.a"),
+				"Reason": Equal(lsv1alpha1.InstallationBlockerReasonOutdatedConfigGeneration),
+			}),
+		))
+	})
+
+	It("should report a finished but not succeeded predecessor", func() {
+		ctx := context.Background()
+		instA := fakeInstallations["test1/a"]
+		instA.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Failed
+		instA.Status.JobID = "2"
+		instA.Status.JobIDFinished = "2"
+		Expect(fakeClient.Status().Update(ctx, instA)).To(Succeed())
+
+		instB := fakeInstallations["test1/b"]
+		instB.Status.JobID = "2"
+
+		blockers := getBlockers(ctx, instB)
+		Expect(blockers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Kind":   Equal(lsv1alpha1.InstallationBlockerKindInstallation),
+			"Name":   Equal("a"),
+			"Reason": Equal(lsv1alpha1.InstallationBlockerReasonNotSucceeded),
+		})))
+	})
+
+	It("should not report anything if all predecessors succeeded", func() {
+		ctx := context.Background()
+		instA := fakeInstallations["test1/a"]
+		instA.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		instA.Status.JobID = "2"
+		instA.Status.JobIDFinished = "2"
+		Expect(fakeClient.Status().Update(ctx, instA)).To(Succeed())
+
+		instB := fakeInstallations["test1/b"]
+		instB.Status.JobID = "2"
+
+		Expect(getBlockers(ctx, instB)).To(BeEmpty())
+	})
+
+	It("should report a missing import", func() {
+		ctx := context.Background()
+		blockers := getBlockers(ctx, fakeInstallations["test11/a"])
+		Expect(blockers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Kind":   Equal(lsv1alpha1.InstallationBlockerKindDataObject),
+			"Name":   Equal(lsv1alpha1helper.GenerateDataObjectName("", "foo")),
+			"Import": Equal("a.b"),
+			"Reason": Equal(lsv1alpha1.InstallationBlockerReasonMissingExport),
+		})))
+	})
+})
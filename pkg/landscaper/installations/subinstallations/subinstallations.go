@@ -22,6 +22,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
@@ -42,10 +43,59 @@ func (o *Operation) Ensure(ctx context.Context) error {
 		return err
 	}
 
+	installationTmpl, err := o.renderInstallationTemplates()
+	if err != nil {
+		return err
+	}
+
+	// delete removed subreferences
+	_, err = o.cleanupOrphanedSubInstallations(ctx, subInstallations, installationTmpl)
+	if err != nil {
+		return err
+	}
+
+	if err := o.createOrUpdateSubinstallations(ctx, subInstallations, installationTmpl); err != nil {
+		return err
+	}
+
+	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue,
+		"InstallationsInstalled", "All Installations are successfully installed")
+	return o.UpdateInstallationStatus(ctx, inst, cond)
+}
+
+// RenderSubinstallations renders the specifications of all subinstallations without creating or updating them.
+// The returned specifications are indexed by the name of the installation template.
+func (o *Operation) RenderSubinstallations() (map[string]*lsv1alpha1.InstallationSpec, error) {
+	installationTmpl, err := o.renderInstallationTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(installationTmpl) != 0 {
+		if _, err := dependencies.CheckForCyclesAndDuplicateExports(installationTmpl, false); err != nil {
+			return nil, err
+		}
+	}
+
+	specs := make(map[string]*lsv1alpha1.InstallationSpec, len(installationTmpl))
+	for _, subInstTmpl := range installationTmpl {
+		spec, err := o.newSubinstallationSpec(o.Inst.GetInstallation(), subInstTmpl)
+		if err != nil {
+			err = fmt.Errorf("unable to render installation for %s: %w", subInstTmpl.Name, err)
+			return nil, o.NewError(err, "RenderInstallation", err.Error())
+		}
+		specs[subInstTmpl.Name] = spec
+	}
+	return specs, nil
+}
+
+// renderInstallationTemplates templates the installation templates of the blueprint
+// and validates them.
+func (o *Operation) renderInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
 	installationTmpl, err := o.getInstallationTemplates()
 	if err != nil {
 		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
-		return o.NewError(err, "GetInstallationTemplates", err.Error())
+		return nil, o.NewError(err, "GetInstallationTemplates", err.Error())
 	}
 
 	for _, instT := range installationTmpl {
@@ -62,22 +112,9 @@ func (o *Operation) Ensure(ctx context.Context) error {
 
 	// validate all installation templates before do any follow up actions
 	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
-		return err
-	}
-
-	// delete removed subreferences
-	_, err = o.cleanupOrphanedSubInstallations(ctx, subInstallations, installationTmpl)
-	if err != nil {
-		return err
-	}
-
-	if err := o.createOrUpdateSubinstallations(ctx, subInstallations, installationTmpl); err != nil {
-		return err
+		return nil, err
 	}
-
-	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue,
-		"InstallationsInstalled", "All Installations are successfully installed")
-	return o.UpdateInstallationStatus(ctx, inst, cond)
+	return installationTmpl, nil
 }
 
 // isOptionalParentImport returns true if the specified import data reference
@@ -193,9 +230,13 @@ func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTempla
 		templateStateHandler := template.KubernetesStateHandler{
 			KubeClient: o.Client(),
 			Inst:       o.Inst.GetInstallation(),
+			ReadOnly:   o.DryRun,
 		}
 		targetResolver := secretresolver.New(o.Client())
-		tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler))
+		tmpl := template.New(
+			gotemplate.New(templateStateHandler, targetResolver),
+			spiff.New(templateStateHandler, targetResolver),
+			jsonnet.New(templateStateHandler, targetResolver))
 		templatedTmpls, err := tmpl.TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(o.Inst.GetInstallation().DeepCopy()),
@@ -266,11 +307,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		subInst.Namespace = inst.Namespace
 	}
 
-	subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
-		subInstTmpl,
-		o.ComponentVersion,
-		o.Context().External.RepositoryContext,
-		o.Context().External.Overwriter)
+	subInstSpec, err := o.newSubinstallationSpec(inst, subInstTmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -285,18 +322,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		if err := controllerutil.SetControllerReference(inst, subInst, o.Scheme()); err != nil {
 			return errors.Wrapf(err, "unable to set owner reference")
 		}
-		subInst.Spec = lsv1alpha1.InstallationSpec{
-			Context:             inst.Spec.Context,
-			RegistryPullSecrets: inst.Spec.RegistryPullSecrets,
-			ComponentDescriptor: subCdDef,
-			Blueprint:           *subBlueprint,
-			Imports:             subInstTmpl.Imports,
-			ImportDataMappings:  subInstTmpl.ImportDataMappings,
-			Exports:             subInstTmpl.Exports,
-			ExportDataMappings:  subInstTmpl.ExportDataMappings,
-		}
-
-		o.Scheme().Default(subInst)
+		subInst.Spec = *subInstSpec
 		return nil
 	})
 	if err != nil {
@@ -333,6 +359,34 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 	return subInst, nil
 }
 
+// newSubinstallationSpec creates the defaulted specification of a subinstallation from its installation template.
+func (o *Operation) newSubinstallationSpec(inst *lsv1alpha1.Installation,
+	subInstTmpl *lsv1alpha1.InstallationTemplate) (*lsv1alpha1.InstallationSpec, error) {
+
+	subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
+		subInstTmpl,
+		o.ComponentVersion,
+		o.Context().External.RepositoryContext,
+		o.Context().External.Overwriter)
+	if err != nil {
+		return nil, err
+	}
+
+	subInst := &lsv1alpha1.Installation{}
+	subInst.Spec = lsv1alpha1.InstallationSpec{
+		Context:             inst.Spec.Context,
+		RegistryPullSecrets: inst.Spec.RegistryPullSecrets,
+		ComponentDescriptor: subCdDef,
+		Blueprint:           *subBlueprint,
+		Imports:             subInstTmpl.Imports,
+		ImportDataMappings:  subInstTmpl.ImportDataMappings,
+		Exports:             subInstTmpl.Exports,
+		ExportDataMappings:  subInstTmpl.ExportDataMappings,
+	}
+	o.Scheme().Default(subInst)
+	return &subInst.Spec, nil
+}
+
 // getSubinstallationNameByReference returns the name of subinstallation by the refernce
 func getSubinstallationNameByReference(refs []lsv1alpha1.NamedObjectReference, namespace, name string) (string, bool) {
 	for _, ref := range refs {
@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+const (
+	installationSubsystemName = "installations"
+	executionSubsystemName    = "executions"
+	deployItemSubsystemName   = "deployitems"
+	controllerSubsystemName   = "controllers"
+)
+
+// Names of the controllers that are used as label values.
+const (
+	InstallationController = "installation"
+	ExecutionController    = "execution"
+	DeployItemController   = "deployitem"
+	TargetSyncController   = "targetsync"
+	RolloutController      = "rollout"
+	ContextController      = "context"
+)
+
+const (
+	phaseLabel      = "phase"
+	controllerLabel = "controller"
+	codeLabel       = "code"
+	reasonLabel     = "reason"
+
+	// noErrorCode is used as label value for errors that do not carry any error code.
+	noErrorCode = "NONE"
+)
+
+// jobDurationBuckets covers durations from one second up to roughly 4.5 hours.
+var jobDurationBuckets = prometheus.ExponentialBuckets(1, 2, 15)
+
+var (
+	// InstallationPhaseTransitions counts the phase transitions of installations.
+	InstallationPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of installations by target phase.",
+		},
+		[]string{phaseLabel},
+	)
+
+	// ExecutionPhaseTransitions counts the phase transitions of executions.
+	ExecutionPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of executions by target phase.",
+		},
+		[]string{phaseLabel},
+	)
+
+	// DeployItemPhaseTransitions counts the phase transitions of deploy items.
+	DeployItemPhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of deploy items by target phase.",
+		},
+		[]string{phaseLabel},
+	)
+
+	// InstallationJobDuration observes the time from the creation of a JobID until the installation reached a final phase.
+	InstallationJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: installationSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Duration from the creation of a JobID until the installation reached a final phase.",
+			Buckets:   jobDurationBuckets,
+		},
+		[]string{phaseLabel},
+	)
+
+	// ExecutionJobDuration observes the time from the creation of a JobID until the execution reached a final phase.
+	ExecutionJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: executionSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Duration from the creation of a JobID until the execution reached a final phase.",
+			Buckets:   jobDurationBuckets,
+		},
+		[]string{phaseLabel},
+	)
+
+	// DeployItemJobDuration observes the time from the creation of a JobID until the deploy item reached a final phase.
+	DeployItemJobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "job_duration_seconds",
+			Help:      "Duration from the creation of a JobID until the deploy item reached a final phase.",
+			Buckets:   jobDurationBuckets,
+		},
+		[]string{phaseLabel},
+	)
+
+	// DeployItemTimeouts counts the deploy items that failed because of a pickup or progressing timeout.
+	DeployItemTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: deployItemSubsystemName,
+			Name:      "timeouts_total",
+			Help:      "Total number of deploy items that failed because of a timeout by timeout reason.",
+		},
+		[]string{reasonLabel},
+	)
+
+	// ReconcileDuration observes the duration of a single reconcile per controller.
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: controllerSubsystemName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of a single reconcile by controller.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{controllerLabel},
+	)
+
+	// Errors counts the errors that occurred during reconciles per controller and error code.
+	Errors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: controllerSubsystemName,
+			Name:      "errors_total",
+			Help:      "Total number of reconcile errors by controller and landscaper error code.",
+		},
+		[]string{controllerLabel, codeLabel},
+	)
+)
+
+// RegisterLifecycleMetrics allows to register the installation, execution and deploy item lifecycle metrics
+// with a given prometheus registerer.
+func RegisterLifecycleMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(InstallationPhaseTransitions)
+	reg.MustRegister(ExecutionPhaseTransitions)
+	reg.MustRegister(DeployItemPhaseTransitions)
+	reg.MustRegister(InstallationJobDuration)
+	reg.MustRegister(ExecutionJobDuration)
+	reg.MustRegister(DeployItemJobDuration)
+	reg.MustRegister(DeployItemTimeouts)
+	reg.MustRegister(ReconcileDuration)
+	reg.MustRegister(Errors)
+}
+
+// ObserveReconcileDuration records the time since start as reconcile duration of the given controller.
+// It is meant to be deferred at the beginning of a reconcile:
+//
+//	defer metrics.ObserveReconcileDuration(metrics.InstallationController, time.Now())
+func ObserveReconcileDuration(controller string, start time.Time) {
+	ReconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+}
+
+// RecordError counts the given error once for every landscaper error code it carries.
+// Errors without codes are counted with the code "NONE".
+// Errors that only signal unfinished or informational states are not counted.
+func RecordError(controller string, err error) {
+	if err == nil {
+		return
+	}
+	recordErrorCodes(controller, lserrors.CollectErrorCodes(err))
+}
+
+// RecordLastError counts the last error of an object status once for every error code it carries.
+// Errors without codes are counted with the code "NONE".
+// Errors that only signal unfinished or informational states are not counted.
+func RecordLastError(controller string, lastErr *lsv1alpha1.Error) {
+	if lastErr == nil {
+		return
+	}
+	recordErrorCodes(controller, lastErr.Codes)
+}
+
+// notCountedErrorCodes are the codes of errors that are expected during a normal reconcile,
+// e.g. while deploy items are still running. They would otherwise increase the error counter of every healthy object.
+var notCountedErrorCodes = []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly}
+
+func recordErrorCodes(controller string, codes []lsv1alpha1.ErrorCode) {
+	if lserrors.ContainsAnyErrorCode(codes, notCountedErrorCodes) {
+		return
+	}
+	if len(codes) == 0 {
+		Errors.WithLabelValues(controller, noErrorCode).Inc()
+		return
+	}
+	for _, code := range codes {
+		Errors.WithLabelValues(controller, string(code)).Inc()
+	}
+}
+
+// RecordInstallationPhase records the transition of the installation into its current phase.
+// If the phase is final, the duration since the creation of the current JobID is observed as well.
+func RecordInstallationPhase(inst *lsv1alpha1.Installation) {
+	phase := string(inst.Status.InstallationPhase)
+	InstallationPhaseTransitions.WithLabelValues(phase).Inc()
+	if inst.Status.InstallationPhase.IsFinal() && inst.Status.JobIDGenerationTime != nil {
+		InstallationJobDuration.WithLabelValues(phase).Observe(time.Since(inst.Status.JobIDGenerationTime.Time).Seconds())
+	}
+}
+
+// RecordExecutionPhase records the transition of the execution into its current phase.
+// If the phase is final, the duration since the creation of the current JobID is observed as well.
+func RecordExecutionPhase(exec *lsv1alpha1.Execution) {
+	phase := string(exec.Status.ExecutionPhase)
+	ExecutionPhaseTransitions.WithLabelValues(phase).Inc()
+	if exec.Status.ExecutionPhase.IsFinal() && exec.Status.JobIDGenerationTime != nil {
+		ExecutionJobDuration.WithLabelValues(phase).Observe(time.Since(exec.Status.JobIDGenerationTime.Time).Seconds())
+	}
+}
+
+// RecordDeployItemPhase records the transition of the deploy item into its current phase.
+// If the phase is final, the duration since the creation of the current JobID is observed as well.
+func RecordDeployItemPhase(di *lsv1alpha1.DeployItem) {
+	phase := string(di.Status.Phase)
+	DeployItemPhaseTransitions.WithLabelValues(phase).Inc()
+	if di.Status.Phase.IsFinal() && di.Status.JobIDGenerationTime != nil {
+		DeployItemJobDuration.WithLabelValues(phase).Observe(time.Since(di.Status.JobIDGenerationTime.Time).Seconds())
+	}
+}
+
+// RecordDeployItemTimeout counts a deploy item that failed because of a timeout with the given reason,
+// e.g. lsv1alpha1.PickupTimeoutReason or lsv1alpha1.ProgressingTimeoutReason.
+func RecordDeployItemTimeout(reason string) {
+	DeployItemTimeouts.WithLabelValues(reason).Inc()
+}
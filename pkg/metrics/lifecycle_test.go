@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/metrics"
+)
+
+func counterValue(c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	ExpectWithOffset(1, c.Write(m)).To(Succeed())
+	return m.GetCounter().GetValue()
+}
+
+func histogramCount(o prometheus.Observer) uint64 {
+	m := &dto.Metric{}
+	ExpectWithOffset(1, o.(prometheus.Metric).Write(m)).To(Succeed())
+	return m.GetHistogram().GetSampleCount()
+}
+
+var _ = Describe("Lifecycle Metrics", func() {
+
+	BeforeEach(func() {
+		metrics.Errors.Reset()
+		metrics.InstallationPhaseTransitions.Reset()
+		metrics.InstallationJobDuration.Reset()
+	})
+
+	It("should register all lifecycle metrics", func() {
+		reg := prometheus.NewRegistry()
+		Expect(func() { metrics.RegisterLifecycleMetrics(reg) }).ToNot(Panic())
+	})
+
+	It("should count an error once for every error code", func() {
+		err := lserrors.NewError("op", "reason", "message", lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorInternalProblem)
+		metrics.RecordError(metrics.InstallationController, fmt.Errorf("wrapped: %w", err))
+
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.InstallationController, string(lsv1alpha1.ErrorTimeout)))).To(Equal(float64(1)))
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.InstallationController, string(lsv1alpha1.ErrorInternalProblem)))).To(Equal(float64(1)))
+	})
+
+	It("should not count unfinished and informational errors", func() {
+		metrics.RecordError(metrics.ExecutionController,
+			lserrors.NewError("op", "reason", "some running items", lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly))
+		metrics.RecordError(metrics.InstallationController,
+			lserrors.NewError("op", "reason", "message", lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorForInfoOnly))
+		metrics.RecordLastError(metrics.DeployItemController,
+			&lsv1alpha1.Error{Reason: "reason", Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorUnfinished}})
+
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.ExecutionController, string(lsv1alpha1.ErrorUnfinished)))).To(BeZero())
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.InstallationController, string(lsv1alpha1.ErrorTimeout)))).To(BeZero())
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.DeployItemController, string(lsv1alpha1.ErrorUnfinished)))).To(BeZero())
+	})
+
+	It("should count errors without error code", func() {
+		metrics.RecordError(metrics.TargetSyncController, errors.New("plain error"))
+		metrics.RecordLastError(metrics.DeployItemController, &lsv1alpha1.Error{Reason: "reason"})
+		metrics.RecordError(metrics.TargetSyncController, nil)
+
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.TargetSyncController, "NONE"))).To(Equal(float64(1)))
+		Expect(counterValue(metrics.Errors.WithLabelValues(metrics.DeployItemController, "NONE"))).To(Equal(float64(1)))
+	})
+
+	It("should observe the job duration only for final phases", func() {
+		jobStart := metav1.NewTime(time.Now().Add(-time.Minute))
+		inst := &lsv1alpha1.Installation{}
+		inst.Status.JobIDGenerationTime = &jobStart
+
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Progressing
+		metrics.RecordInstallationPhase(inst)
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		metrics.RecordInstallationPhase(inst)
+
+		Expect(counterValue(metrics.InstallationPhaseTransitions.WithLabelValues(string(lsv1alpha1.InstallationPhases.Progressing)))).To(Equal(float64(1)))
+		Expect(counterValue(metrics.InstallationPhaseTransitions.WithLabelValues(string(lsv1alpha1.InstallationPhases.Succeeded)))).To(Equal(float64(1)))
+		Expect(histogramCount(metrics.InstallationJobDuration.WithLabelValues(string(lsv1alpha1.InstallationPhases.Progressing)))).To(BeZero())
+		Expect(histogramCount(metrics.InstallationJobDuration.WithLabelValues(string(lsv1alpha1.InstallationPhases.Succeeded)))).To(Equal(uint64(1)))
+	})
+})
@@ -19,5 +19,6 @@ import (
 // RegisterMetrics allows to register all landscaper exposed metrics
 func RegisterMetrics(reg prometheus.Registerer) {
 	blueprints.RegisterStoreMetrics(reg)
+	RegisterLifecycleMetrics(reg)
 	componentcliMetrics.RegisterCacheMetrics(reg)
 }
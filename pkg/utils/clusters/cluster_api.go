@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// clusterAPIKubeconfigSecretSuffix is the suffix of the name of the secret in which Cluster API stores
+	// the kubeconfig of a cluster.
+	clusterAPIKubeconfigSecretSuffix = "-kubeconfig"
+	// clusterAPIKubeconfigSecretKey is the key of the kubeconfig in the kubeconfig secret of a Cluster API cluster.
+	clusterAPIKubeconfigSecretKey = "value"
+)
+
+// ClusterAPIClusterListGVK is the group version kind of the list of Cluster API clusters.
+var ClusterAPIClusterListGVK = schema.GroupVersionKind{
+	Group:   "cluster.x-k8s.io",
+	Version: "v1beta1",
+	Kind:    "ClusterList",
+}
+
+// ListClusterAPIClusters returns the Cluster API clusters in the specified namespace.
+func ListClusterAPIClusters(ctx context.Context, cl client.Client, namespace string, opts ...client.ListOption) (*unstructured.UnstructuredList, error) {
+	clusterList := &unstructured.UnstructuredList{}
+	clusterList.SetGroupVersionKind(ClusterAPIClusterListGVK)
+
+	opts = append(opts, client.InNamespace(namespace))
+	if err := cl.List(ctx, clusterList, opts...); err != nil {
+		return nil, fmt.Errorf("cluster api client: unable to list clusters: %w", err)
+	}
+	return clusterList, nil
+}
+
+// GetClusterAPIKubeconfigSecretKey returns the key of the secret that contains the kubeconfig of a Cluster API cluster.
+func GetClusterAPIKubeconfigSecretKey(cluster client.Object) client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: cluster.GetNamespace(),
+		Name:      cluster.GetName() + clusterAPIKubeconfigSecretSuffix,
+	}
+}
+
+// GetClusterAPIKubeconfig returns the kubeconfig of a Cluster API cluster.
+// Cluster API renews the kubeconfig in the secret itself, therefore the returned kubeconfig is always the current one.
+func GetClusterAPIKubeconfig(ctx context.Context, cl client.Client, cluster client.Object) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := cl.Get(ctx, GetClusterAPIKubeconfigSecretKey(cluster), secret); err != nil {
+		return nil, fmt.Errorf("cluster api client: unable to get kubeconfig secret of cluster %s: %w",
+			client.ObjectKeyFromObject(cluster).String(), err)
+	}
+
+	kubeconfig, ok := secret.Data[clusterAPIKubeconfigSecretKey]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("cluster api client: kubeconfig secret of cluster %s contains no kubeconfig",
+			client.ObjectKeyFromObject(cluster).String())
+	}
+	return kubeconfig, nil
+}
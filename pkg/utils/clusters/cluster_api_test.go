@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("Cluster API Clusters", func() {
+
+	newCluster := func(name string, labels map[string]string) *unstructured.Unstructured {
+		cluster := &unstructured.Unstructured{}
+		cluster.SetAPIVersion("cluster.x-k8s.io/v1beta1")
+		cluster.SetKind("Cluster")
+		cluster.SetName(name)
+		cluster.SetNamespace("capi")
+		cluster.SetLabels(labels)
+		return cluster
+	}
+
+	It("should list clusters and read their kubeconfigs", func() {
+		ctx := context.Background()
+
+		secret := &corev1.Secret{}
+		secret.Name = "cluster-a-kubeconfig"
+		secret.Namespace = "capi"
+		secret.Data = map[string][]byte{"value": []byte("kubeconfig-a")}
+
+		cl := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(secret,
+			newCluster("cluster-a", map[string]string{"env": "prod"}),
+			newCluster("cluster-b", map[string]string{"env": "dev"})).Build()
+
+		clusterList, err := ListClusterAPIClusters(ctx, cl, "capi")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterList.Items).To(HaveLen(2))
+
+		clusterList, err = ListClusterAPIClusters(ctx, cl, "capi", client.MatchingLabels{"env": "prod"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterList.Items).To(HaveLen(1))
+		Expect(clusterList.Items[0].GetName()).To(Equal("cluster-a"))
+
+		kubeconfig, err := GetClusterAPIKubeconfig(ctx, cl, &clusterList.Items[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-a"))
+
+		_, err = GetClusterAPIKubeconfig(ctx, cl, newCluster("cluster-b", nil))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff compares the json representations of objects.
+// It is used by the plan of installations as well as by the dry-run and drift detection of deployers.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangedFields returns the sorted paths of all fields whose values differ
+// between the json representations of the given objects.
+// Nested fields are separated by a dot, list elements are addressed by their index, e.g. "config.items[1].name".
+func ChangedFields(oldObj, newObj interface{}) ([]string, error) {
+	oldVal, err := toGenericValue(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	newVal, err := toGenericValue(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	changedFields := []string{}
+	collectChangedFields("", oldVal, newVal, &changedFields)
+	sort.Strings(changedFields)
+	return changedFields, nil
+}
+
+// FieldPaths returns the sorted paths of all fields of the json representation of the given object,
+// including the paths of all intermediate maps and lists. The paths have the same format as the ones of ChangedFields.
+func FieldPaths(obj interface{}) ([]string, error) {
+	val, err := toGenericValue(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	collectFieldPaths("", val, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func toGenericValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var val interface{}
+	if err := json.Unmarshal(data, &val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+func collectChangedFields(path string, oldVal, newVal interface{}, changedFields *[]string) {
+	switch oldTyped := oldVal.(type) {
+	case map[string]interface{}:
+		newTyped, ok := newVal.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := map[string]struct{}{}
+		for key := range oldTyped {
+			keys[key] = struct{}{}
+		}
+		for key := range newTyped {
+			keys[key] = struct{}{}
+		}
+		for key := range keys {
+			collectChangedFields(joinPath(path, key), oldTyped[key], newTyped[key], changedFields)
+		}
+		return
+	case []interface{}:
+		newTyped, ok := newVal.([]interface{})
+		if !ok || len(oldTyped) != len(newTyped) {
+			break
+		}
+		for i := range oldTyped {
+			collectChangedFields(fmt.Sprintf("%s[%d]", path, i), oldTyped[i], newTyped[i], changedFields)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(oldVal, newVal) {
+		if len(path) == 0 {
+			path = "."
+		}
+		*changedFields = append(*changedFields, path)
+	}
+}
+
+func collectFieldPaths(path string, val interface{}, paths *[]string) {
+	if len(path) != 0 {
+		*paths = append(*paths, path)
+	}
+	switch typed := val.(type) {
+	case map[string]interface{}:
+		for key, fieldVal := range typed {
+			collectFieldPaths(joinPath(path, key), fieldVal, paths)
+		}
+	case []interface{}:
+		for i, itemVal := range typed {
+			collectFieldPaths(fmt.Sprintf("%s[%d]", path, i), itemVal, paths)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, ".[]") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
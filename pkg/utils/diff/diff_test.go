@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diff_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/utils/diff"
+)
+
+var _ = Describe("Diff", func() {
+
+	Context("ChangedFields", func() {
+		It("should return no fields for equal objects", func() {
+			obj := map[string]interface{}{"a": 1, "b": []interface{}{"x", "y"}}
+			Expect(diff.ChangedFields(obj, obj)).To(BeEmpty())
+		})
+
+		It("should return the paths of all changed, added and removed fields", func() {
+			oldObj := map[string]interface{}{
+				"a": 1,
+				"b": map[string]interface{}{
+					"c":   "old",
+					"d":   true,
+					"e.f": 1,
+				},
+				"list": []interface{}{
+					map[string]interface{}{"name": "x"},
+					map[string]interface{}{"name": "y"},
+				},
+			}
+			newObj := map[string]interface{}{
+				"a": 1,
+				"b": map[string]interface{}{
+					"c":   "new",
+					"e.f": 2,
+					"g":   "added",
+				},
+				"list": []interface{}{
+					map[string]interface{}{"name": "x"},
+					map[string]interface{}{"name": "z"},
+				},
+			}
+			Expect(diff.ChangedFields(oldObj, newObj)).To(Equal([]string{
+				"b.c",
+				"b.d",
+				"b.g",
+				`b["e.f"]`,
+				"list[1].name",
+			}))
+		})
+
+		It("should return the complete list if the length of a list changed", func() {
+			oldObj := map[string]interface{}{"list": []interface{}{"a"}}
+			newObj := map[string]interface{}{"list": []interface{}{"a", "b"}}
+			Expect(diff.ChangedFields(oldObj, newObj)).To(Equal([]string{"list"}))
+		})
+	})
+
+	Context("FieldPaths", func() {
+		It("should return the paths of all fields", func() {
+			obj := map[string]interface{}{
+				"a": map[string]interface{}{
+					"b.c": "val",
+				},
+				"list": []interface{}{"x", map[string]interface{}{"d": 1}},
+			}
+			Expect(diff.FieldPaths(obj)).To(Equal([]string{
+				"a",
+				`a["b.c"]`,
+				"list",
+				"list[0]",
+				"list[1]",
+				"list[1].d",
+			}))
+		})
+	})
+})
@@ -6,6 +6,7 @@ package landscaper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -19,13 +20,16 @@ import (
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/cueschema"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/jsonnet"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils/dependencies"
+	"github.com/gardener/landscaper/pkg/utils/targetregistry"
 )
 
 // BlueprintRenderer is able to render a blueprint with given import values or exports for export templates.
@@ -68,7 +72,7 @@ func NewBlueprintRenderer(cdList *model.ComponentVersionList, registryAccess mod
 }
 
 // RenderDeployItemsAndSubInstallations renders deploy items and subinstallations of a given blueprint using the given imports.
-// The import values are validated with the JSON schemas defined in the blueprint.
+// The import values are validated with the JSON schemas and CUE definitions defined in the blueprint.
 func (r *BlueprintRenderer) RenderDeployItemsAndSubInstallations(input *ResolvedInstallation, imports map[string]interface{}) (*RenderedDeployItemsSubInstallations, error) {
 	if input == nil {
 		return nil, fmt.Errorf("render input may not be nil")
@@ -128,7 +132,8 @@ func (r *BlueprintRenderer) RenderImportExecutions(input *ResolvedInstallation,
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	errorList, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			input.Installation,
@@ -174,7 +179,8 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -200,7 +206,8 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -333,7 +340,8 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	formatter := template.NewTemplateInputFormatter(true)
 	tmpl := template.New(
 		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler).WithInputFormatter(formatter))
+		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter),
+		jsonnet.New(templateStateHandler, nil).WithInputFormatter(formatter))
 	subInstallationTemplates, err := tmpl.TemplateSubinstallationExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -412,7 +420,8 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	return subInstallations, templateStateHandler, nil
 }
 
-// validateImports validates the imports with the JSON schemas defined in the blueprint
+// validateImports validates the imports with the JSON schemas and CUE definitions defined in the blueprint.
+// The defaults of the CUE definitions are applied to the given imports.
 func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports map[string]interface{}) error {
 
 	inputRepositoryContext, err := r.getRepositoryContext(input)
@@ -427,6 +436,11 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		RegistryAccess:    r.registryAccess,
 		RepositoryContext: inputRepositoryContext,
 	}
+	cueValidatorConfig := &cueschema.ReferenceContext{
+		BlueprintFs:       input.Blueprint.Fs,
+		ComponentVersion:  input.ComponentVersion,
+		RepositoryContext: inputRepositoryContext,
+	}
 
 	var allErr field.ErrorList
 	for _, importDef := range input.Blueprint.Info.Imports {
@@ -440,11 +454,26 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		}
 		switch importDef.Type {
 		case lsv1alpha1.ImportTypeData:
-			if err := jsonschema.ValidateGoStruct(importDef.Schema.RawMessage, value, validatorConfig); err != nil {
-				allErr = append(allErr, field.Invalid(
-					fldPath,
-					value,
-					fmt.Sprintf("invalid imported value: %s", err.Error())))
+			if importDef.Cue != nil {
+				completed, err := cueschema.Complete(importDef.Cue, value, cueValidatorConfig)
+				if err != nil {
+					allErr = append(allErr, field.Invalid(
+						fldPath,
+						value,
+						fmt.Sprintf("invalid imported value: %s", err.Error())))
+					continue
+				}
+				// apply the defaults of the cue definition
+				value = completed
+				imports[importDef.Name] = completed
+			}
+			if importDef.Schema != nil {
+				if err := jsonschema.ValidateGoStruct(importDef.Schema.RawMessage, value, validatorConfig); err != nil {
+					allErr = append(allErr, field.Invalid(
+						fldPath,
+						value,
+						fmt.Sprintf("invalid imported value: %s", err.Error())))
+				}
 			}
 		case lsv1alpha1.ImportTypeTarget:
 			allErr = append(allErr, validateTargetImport(value, importDef.TargetType, fldPath)...)
@@ -523,6 +552,20 @@ func validateTargetImport(value interface{}, expectedTargetType string, fldPath
 		return allErr
 	}
 
+	config, ok, err := unstructured.NestedFieldNoCopy(targetObj, "spec", "config")
+	if err != nil || !ok {
+		// the target configuration might be defined in a secret
+		return allErr
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		allErr = append(allErr, field.InternalError(
+			fldPath.Child("spec", "config"),
+			fmt.Errorf("unable to marshal target configuration: %w", err)))
+		return allErr
+	}
+	allErr = append(allErr, targetregistry.Default.ValidateConfig(lsv1alpha1.TargetType(targetType), configBytes, fldPath.Child("spec", "config"))...)
+
 	return allErr
 }
 
@@ -49,6 +49,39 @@ var _ = Describe("Landscaper", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should validate the configuration of imported targets", func() {
+			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil)
+			blueprint := GetBlueprint("./testdata/01-blueprint-with-ssh-target/blueprint")
+			_, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				Blueprint: blueprint,
+			}, GetImports("./testdata/01-blueprint-with-ssh-target/values.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				Blueprint: blueprint,
+			}, GetImports("./testdata/01-blueprint-with-ssh-target/invalid-values.yaml"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.config.user"))
+			Expect(err.Error()).ToNot(ContainSubstring("secret"))
+		})
+
+		It("should validate and default data imports with a cue definition", func() {
+			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil)
+			blueprint := GetBlueprint("./testdata/02-blueprint-with-cue/blueprint")
+			res, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				Blueprint: blueprint,
+			}, GetImports("./testdata/02-blueprint-with-cue/values.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.DeployItems).To(HaveLen(1))
+			Expect(string(res.DeployItems[0].Spec.Configuration.Raw)).To(ContainSubstring(`"replicas":1`))
+
+			_, err = renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+				Blueprint: blueprint,
+			}, GetImports("./testdata/02-blueprint-with-cue/invalid-values.yaml"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("replicas"))
+		})
+
 	})
 
 })
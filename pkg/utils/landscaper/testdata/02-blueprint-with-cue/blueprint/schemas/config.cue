@@ -0,0 +1,7 @@
+#Config: {
+	ha:       bool | *false
+	replicas: int & >=1 | *1
+	if ha {
+		replicas: >=3
+	}
+}
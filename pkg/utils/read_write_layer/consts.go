@@ -153,10 +153,30 @@ const (
 	W000147 WriteID = "w000147"
 	W000148 WriteID = "w000148"
 	W000149 WriteID = "w000149"
+	W000150 WriteID = "w000150"
+	W000151 WriteID = "w000151"
+	W000152 WriteID = "w000152"
+	W000153 WriteID = "w000153"
+	W000154 WriteID = "w000154"
+	W000155 WriteID = "w000155"
+	W000156 WriteID = "w000156"
+	W000157 WriteID = "w000157"
+	W000158 WriteID = "w000158"
+	W000159 WriteID = "w000159"
+	W000160 WriteID = "w000160"
+	W000161 WriteID = "w000161"
+	W000162 WriteID = "w000162"
+	W000163 WriteID = "w000163"
+	W000164 WriteID = "w000164"
+	W000165 WriteID = "w000165"
+	W000166 WriteID = "w000166"
+	W000167 WriteID = "w000167"
+	W000168 WriteID = "w000168"
 )
 
 const (
 	opContextCreateOrUpdate = "history: context create or update"
+	opContextDelete         = "history: context delete"
 	opDOCreateOrUpdate      = "history: dataobject create or update"
 	opInstCreateOrUpdate    = "history: installation create or update"
 	opInstSpec              = "history: installation update"
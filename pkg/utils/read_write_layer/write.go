@@ -36,6 +36,13 @@ func (w *Writer) CreateOrPatchCoreContext(ctx context.Context, writeID WriteID,
 	return result, errorWithWriteID(err, writeID)
 }
 
+func (w *Writer) DeleteContext(ctx context.Context, writeID WriteID, lsContext *lsv1alpha1.Context) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(lsContext)
+	err := delete(ctx, w.client, lsContext)
+	w.logContextUpdate(ctx, writeID, opContextDelete, lsContext, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 // methods for targets
 
 func (w *Writer) CreateOrUpdateCoreTarget(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target,
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const defaultRetryBackoffFactor = 2
+
+// GetRetryAction returns the action of the first retry rule that matches the given error.
+// If no rule matches, the default action "Retry" is returned.
+func GetRetryAction(rules []lsv1alpha1.RetryRule, lastError *lsv1alpha1.Error) lsv1alpha1.RetryAction {
+	for _, rule := range rules {
+		if retryRuleMatches(rule, lastError) {
+			return rule.Action
+		}
+	}
+	return lsv1alpha1.RetryActionRetry
+}
+
+func retryRuleMatches(rule lsv1alpha1.RetryRule, lastError *lsv1alpha1.Error) bool {
+	if len(rule.Codes) == 0 && len(rule.Reasons) == 0 {
+		return true
+	}
+	if lastError == nil {
+		return false
+	}
+
+	if len(rule.Codes) != 0 {
+		codeMatches := false
+		for _, code := range rule.Codes {
+			for _, errCode := range lastError.Codes {
+				if code == errCode {
+					codeMatches = true
+				}
+			}
+		}
+		if !codeMatches {
+			return false
+		}
+	}
+
+	if len(rule.Reasons) != 0 {
+		for _, reason := range rule.Reasons {
+			if reason == lastError.Reason {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// GetRetryInterval returns the interval before the given retry, starting with 1 for the first retry.
+// Without a backoff, the interval is constant. With a backoff, the interval is multiplied by the factor after every
+// retry and limited by the max interval. The jitter is derived from the seed and the retry number, so that the
+// interval of a retry does not change between two reconciliations, but differs for different objects.
+func GetRetryInterval(interval time.Duration, backoff *lsv1alpha1.RetryBackoff, retry int, seed string) time.Duration {
+	if backoff == nil {
+		return interval
+	}
+
+	factor := float64(defaultRetryBackoffFactor)
+	if backoff.Factor != nil {
+		factor = float64(*backoff.Factor)
+	}
+	exponent := retry - 1
+	if exponent < 0 {
+		exponent = 0
+	}
+
+	result := float64(interval) * math.Pow(factor, float64(exponent))
+	if backoff.MaxInterval != nil && result > float64(backoff.MaxInterval.Duration) {
+		result = float64(backoff.MaxInterval.Duration)
+	}
+	// avoid an overflow if no max interval is defined
+	if result > math.MaxInt64/2 {
+		result = math.MaxInt64 / 2
+	}
+
+	if backoff.JitterPercent != nil && *backoff.JitterPercent > 0 {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(seed + "/" + strconv.Itoa(retry)))
+		// random value in [-1, 1]
+		random := float64(h.Sum32())/float64(math.MaxUint32)*2 - 1
+		result += result * random * float64(*backoff.JitterPercent) / 100
+	}
+
+	return time.Duration(result)
+}
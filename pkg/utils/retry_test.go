@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsutils "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("Retry", func() {
+
+	Context("GetRetryInterval", func() {
+		It("should return a constant interval without a backoff", func() {
+			Expect(lsutils.GetRetryInterval(time.Minute, nil, 5, "seed")).To(Equal(time.Minute))
+		})
+
+		It("should increase the interval exponentially up to the max interval", func() {
+			backoff := &lsv1alpha1.RetryBackoff{
+				MaxInterval: &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+			}
+			Expect(lsutils.GetRetryInterval(time.Minute, backoff, 1, "seed")).To(Equal(time.Minute))
+			Expect(lsutils.GetRetryInterval(time.Minute, backoff, 2, "seed")).To(Equal(2 * time.Minute))
+			Expect(lsutils.GetRetryInterval(time.Minute, backoff, 4, "seed")).To(Equal(8 * time.Minute))
+			Expect(lsutils.GetRetryInterval(time.Minute, backoff, 5, "seed")).To(Equal(10 * time.Minute))
+
+			backoff.Factor = pointer.Int32(3)
+			Expect(lsutils.GetRetryInterval(time.Minute, backoff, 3, "seed")).To(Equal(9 * time.Minute))
+		})
+
+		It("should apply a stable jitter", func() {
+			backoff := &lsv1alpha1.RetryBackoff{
+				Factor:        pointer.Int32(1),
+				JitterPercent: pointer.Int32(10),
+			}
+			interval := lsutils.GetRetryInterval(time.Hour, backoff, 1, "seed")
+			Expect(interval).To(BeNumerically("~", time.Hour, 6*time.Minute))
+			Expect(lsutils.GetRetryInterval(time.Hour, backoff, 1, "seed")).To(Equal(interval))
+		})
+	})
+
+	Context("GetRetryAction", func() {
+		rules := []lsv1alpha1.RetryRule{
+			{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem}, Action: lsv1alpha1.RetryActionNever},
+			{Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}, Reasons: []string{"FetchTarget"}, Action: lsv1alpha1.RetryActionAlways},
+		}
+
+		It("should return the action of the first matching rule", func() {
+			Expect(lsutils.GetRetryAction(rules, &lsv1alpha1.Error{
+				Codes:  []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorConfigurationProblem},
+				Reason: "FetchTarget",
+			})).To(Equal(lsv1alpha1.RetryActionNever))
+			Expect(lsutils.GetRetryAction(rules, &lsv1alpha1.Error{
+				Codes:  []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+				Reason: "FetchTarget",
+			})).To(Equal(lsv1alpha1.RetryActionAlways))
+		})
+
+		It("should return the default action if no rule matches", func() {
+			Expect(lsutils.GetRetryAction(rules, &lsv1alpha1.Error{
+				Codes:  []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+				Reason: "Apply",
+			})).To(Equal(lsv1alpha1.RetryActionRetry))
+			Expect(lsutils.GetRetryAction(rules, nil)).To(Equal(lsv1alpha1.RetryActionRetry))
+		})
+	})
+})
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetregistry
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+const httpEndpointSchema = `{
+  "type": "object",
+  "required": ["url"],
+  "properties": {
+    "url": { "type": "string", "minLength": 1 },
+    "caBundle": { "type": "string" },
+    "insecureSkipVerify": { "type": "boolean" },
+    "username": { "type": "string" },
+    "password": { "type": "string" },
+    "bearerToken": { "type": "string" },
+    "headers": {
+      "type": "object",
+      "additionalProperties": { "type": "string" }
+    }
+  }
+}`
+
+// HTTPEndpointHandler handles targets of type landscaper.gardener.cloud/http-endpoint.
+// Its typed configuration is a *targettypes.HTTPEndpointTargetConfig and its client is a *http.Client
+// that authenticates all requests and adds the configured headers.
+type HTTPEndpointHandler struct{}
+
+var _ Handler = HTTPEndpointHandler{}
+var _ ClientFactory = HTTPEndpointHandler{}
+
+func (HTTPEndpointHandler) Type() lsv1alpha1.TargetType {
+	return targettypes.HTTPEndpointTargetType
+}
+
+func (HTTPEndpointHandler) Schema() []byte {
+	return []byte(httpEndpointSchema)
+}
+
+func (HTTPEndpointHandler) NewConfig() interface{} {
+	return &targettypes.HTTPEndpointTargetConfig{}
+}
+
+func (HTTPEndpointHandler) Validate(config interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	cfg := config.(*targettypes.HTTPEndpointTargetConfig)
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), cfg.URL, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), cfg.URL, "must be an absolute http or https url"))
+	}
+	if len(cfg.CABundle) != 0 && !x509.NewCertPool().AppendCertsFromPEM([]byte(cfg.CABundle)) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("caBundle"), hiddenValue, "no valid PEM encoded certificate found"))
+	}
+	if len(cfg.BearerToken) != 0 && len(cfg.Username) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("bearerToken"), "either basic authentication or a bearer token may be used, not both"))
+	}
+	if len(cfg.Password) != 0 && len(cfg.Username) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("username"), "a username is required for basic authentication"))
+	}
+	return allErrs
+}
+
+// NewClient returns a http client for the endpoint.
+func (HTTPEndpointHandler) NewClient(_ context.Context, config interface{}) (interface{}, error) {
+	cfg := config.(*targettypes.HTTPEndpointTargetConfig)
+	return HTTPClient(cfg)
+}
+
+// HTTPClient returns a http client that verifies the server certificate with the configured ca bundle,
+// authenticates all requests and adds the configured headers.
+func HTTPClient(cfg *targettypes.HTTPEndpointTargetConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+	if len(cfg.CABundle) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.CABundle)) {
+			return nil, fmt.Errorf("no valid PEM encoded certificate found in ca bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: &httpEndpointRoundTripper{
+			config: cfg,
+			next:   transport,
+		},
+	}, nil
+}
+
+// httpEndpointRoundTripper adds the authentication and headers of a http endpoint target to all requests.
+type httpEndpointRoundTripper struct {
+	config *targettypes.HTTPEndpointTargetConfig
+	next   http.RoundTripper
+}
+
+func (rt *httpEndpointRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for key, value := range rt.config.Headers {
+		req.Header.Set(key, value)
+	}
+	if len(rt.config.BearerToken) != 0 {
+		req.Header.Set("Authorization", "Bearer "+rt.config.BearerToken)
+	} else if len(rt.config.Username) != 0 {
+		req.SetBasicAuth(rt.config.Username, rt.config.Password)
+	}
+	return rt.next.RoundTrip(req)
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetregistry
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+// kubernetesClusterSchema is lax, as the kubeconfig can be given as string, as deprecated secret reference,
+// or the whole configuration can be the kubeconfig itself.
+const kubernetesClusterSchema = `{
+  "properties": {
+    "kubeconfig": {
+      "type": ["string", "object"],
+      "properties": {
+        "secretRef": {
+          "type": "object",
+          "required": ["name"],
+          "properties": {
+            "name": { "type": "string" },
+            "namespace": { "type": "string" },
+            "key": { "type": "string" }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// KubernetesClusterHandler handles targets of type landscaper.gardener.cloud/kubernetes-cluster.
+// Its typed configuration is a *targettypes.KubernetesClusterTargetConfig and its client is a *rest.Config.
+type KubernetesClusterHandler struct{}
+
+var _ Handler = KubernetesClusterHandler{}
+var _ SecretResolver = KubernetesClusterHandler{}
+var _ ClientFactory = KubernetesClusterHandler{}
+
+func (KubernetesClusterHandler) Type() lsv1alpha1.TargetType {
+	return targettypes.KubernetesClusterTargetType
+}
+
+func (KubernetesClusterHandler) Schema() []byte {
+	return []byte(kubernetesClusterSchema)
+}
+
+func (KubernetesClusterHandler) NewConfig() interface{} {
+	return &targettypes.KubernetesClusterTargetConfig{}
+}
+
+func (KubernetesClusterHandler) Validate(config interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	cfg := config.(*targettypes.KubernetesClusterTargetConfig)
+	kubeconfigPath := fldPath.Child("kubeconfig")
+	switch {
+	case cfg.Kubeconfig.SecretRef != nil:
+		if len(cfg.Kubeconfig.SecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(kubeconfigPath.Child("secretRef", "name"), "name of the secret is required"))
+		}
+	case cfg.Kubeconfig.StrVal == nil || len(*cfg.Kubeconfig.StrVal) == 0:
+		// the kubeconfig itself is not parsed, as it is only needed by the deployers when they access the cluster.
+		allErrs = append(allErrs, field.Required(kubeconfigPath, "a kubeconfig is required"))
+	}
+	return allErrs
+}
+
+// ResolveSecrets reads the kubeconfig of a deprecated secret reference.
+// The secret has to be in the namespace of the target.
+func (KubernetesClusterHandler) ResolveSecrets(ctx context.Context, lsClient client.Client, namespace string, config interface{}) error {
+	cfg := config.(*targettypes.KubernetesClusterTargetConfig)
+	ref := cfg.Kubeconfig.SecretRef
+	if ref == nil {
+		return nil
+	}
+	if len(ref.Namespace) != 0 && ref.Namespace != namespace {
+		return fmt.Errorf("namespace of secret ref %s differs from target namespace %s", ref.Namespace, namespace)
+	}
+	key := ref.Key
+	if len(key) == 0 {
+		key = targettypes.DefaultKubeconfigKey
+	}
+
+	secret := &corev1.Secret{}
+	if err := lsClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return err
+	}
+	kubeconfig, ok := secret.Data[key]
+	if !ok {
+		return fmt.Errorf("secret %s/%s found but key %q not found", namespace, ref.Name, key)
+	}
+	kubeconfigStr := string(kubeconfig)
+	cfg.Kubeconfig = targettypes.ValueRef{StrVal: &kubeconfigStr}
+	return nil
+}
+
+// NewClient returns the *rest.Config of the kubeconfig.
+func (KubernetesClusterHandler) NewClient(_ context.Context, config interface{}) (interface{}, error) {
+	cfg := config.(*targettypes.KubernetesClusterTargetConfig)
+	if cfg.Kubeconfig.StrVal == nil {
+		return nil, fmt.Errorf("kubeconfig not defined")
+	}
+	return clientcmd.RESTConfigFromKubeConfig([]byte(*cfg.Kubeconfig.StrVal))
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetregistry
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+const ociRegistrySchema = `{
+  "type": "object",
+  "required": ["registry"],
+  "properties": {
+    "registry": { "type": "string", "minLength": 1 },
+    "username": { "type": "string" },
+    "password": { "type": "string" },
+    "insecure": { "type": "boolean" }
+  }
+}`
+
+// OCIRegistryHandler handles targets of type landscaper.gardener.cloud/oci-registry.
+// Its typed configuration is a *targettypes.OCIRegistryTargetConfig.
+// No client is constructed, as the oci client depends on the consumer.
+type OCIRegistryHandler struct{}
+
+var _ Handler = OCIRegistryHandler{}
+
+func (OCIRegistryHandler) Type() lsv1alpha1.TargetType {
+	return targettypes.OCIRegistryTargetType
+}
+
+func (OCIRegistryHandler) Schema() []byte {
+	return []byte(ociRegistrySchema)
+}
+
+func (OCIRegistryHandler) NewConfig() interface{} {
+	return &targettypes.OCIRegistryTargetConfig{}
+}
+
+func (OCIRegistryHandler) Validate(config interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	cfg := config.(*targettypes.OCIRegistryTargetConfig)
+	if strings.Contains(cfg.Registry, "://") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("registry"), cfg.Registry, "must not contain a scheme, use insecure for plain http registries"))
+	}
+	if len(cfg.Password) != 0 && len(cfg.Username) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("username"), "a username is required if a password is defined"))
+	}
+	return allErrs
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// hiddenValue is used instead of the actual value in validation errors of target configurations.
+const hiddenValue = "<hidden>"
+
+// Handler implements the type specific logic for the targets of one target type.
+type Handler interface {
+	// Type returns the target type that is handled.
+	Type() lsv1alpha1.TargetType
+	// Schema returns the JSON schema of the target configuration.
+	Schema() []byte
+	// NewConfig returns a new empty typed target configuration, e.g. a *targettypes.SSHTargetConfig.
+	NewConfig() interface{}
+	// Validate validates the typed target configuration beyond its schema.
+	Validate(config interface{}, fldPath *field.Path) field.ErrorList
+}
+
+// SecretResolver is an optional interface of a Handler whose target configuration contains references to secrets.
+type SecretResolver interface {
+	// ResolveSecrets resolves the secret references of the typed configuration of a target in the given namespace.
+	ResolveSecrets(ctx context.Context, lsClient client.Client, namespace string, config interface{}) error
+}
+
+// ClientFactory is an optional interface of a Handler that is able to construct a client for its targets.
+type ClientFactory interface {
+	// NewClient constructs a client from the typed target configuration with resolved secrets.
+	NewClient(ctx context.Context, config interface{}) (interface{}, error)
+}
+
+// Registry contains the handlers of all known target types.
+type Registry struct {
+	mux      sync.RWMutex
+	handlers map[lsv1alpha1.TargetType]Handler
+}
+
+// Default is the registry that contains the handlers of the target types that are known to the landscaper.
+// Deployers can register handlers for their own target types.
+var Default = New(
+	KubernetesClusterHandler{},
+	SSHHandler{},
+	HTTPEndpointHandler{},
+	OCIRegistryHandler{},
+)
+
+// New creates a new registry with the given handlers.
+func New(handlers ...Handler) *Registry {
+	r := &Registry{
+		handlers: make(map[lsv1alpha1.TargetType]Handler),
+	}
+	r.Register(handlers...)
+	return r
+}
+
+// Register adds the given handlers to the registry.
+// Already registered handlers of the same target type are replaced.
+func (r *Registry) Register(handlers ...Handler) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, h := range handlers {
+		r.handlers[h.Type()] = h
+	}
+}
+
+// Get returns the handler of the given target type.
+func (r *Registry) Get(targetType lsv1alpha1.TargetType) (Handler, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	h, ok := r.handlers[targetType]
+	return h, ok
+}
+
+// Types returns the sorted list of all registered target types.
+func (r *Registry) Types() []lsv1alpha1.TargetType {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	types := make([]lsv1alpha1.TargetType, 0, len(r.handlers))
+	for t := range r.handlers {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// ValidateConfig validates the configuration of a target of the given type against the schema
+// and the validation of the registered handler.
+// The content can either be JSON or YAML.
+// Targets of unknown types are not validated, as their configuration is only known to the consuming deployer.
+func (r *Registry) ValidateConfig(targetType lsv1alpha1.TargetType, content []byte, fldPath *field.Path) field.ErrorList {
+	h, ok := r.Get(targetType)
+	if !ok {
+		return field.ErrorList{}
+	}
+	_, allErrs := decodeConfig(h, content, fldPath)
+	return allErrs
+}
+
+// Decode decodes the content of a resolved target into the typed configuration of its target type.
+// Secret references in the configuration are resolved with the given client if the handler supports it.
+func (r *Registry) Decode(ctx context.Context, lsClient client.Client, rt *lsv1alpha1.ResolvedTarget) (interface{}, error) {
+	if rt == nil || rt.Target == nil {
+		return nil, fmt.Errorf("no target defined")
+	}
+	targetType := rt.Target.Spec.Type
+	h, ok := r.Get(targetType)
+	if !ok {
+		return nil, fmt.Errorf("unknown target type %q", targetType)
+	}
+
+	config, errs := decodeConfig(h, []byte(rt.Content), field.NewPath("config"))
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("invalid configuration of target %s/%s: %w", rt.Target.Namespace, rt.Target.Name, errs.ToAggregate())
+	}
+
+	if sr, ok := h.(SecretResolver); ok {
+		if err := sr.ResolveSecrets(ctx, lsClient, rt.Target.Namespace, config); err != nil {
+			return nil, fmt.Errorf("unable to resolve secrets of target %s/%s: %w", rt.Target.Namespace, rt.Target.Name, err)
+		}
+	}
+	return config, nil
+}
+
+// NewClient decodes the given resolved target and constructs a client for it.
+// The type of the client depends on the target type, see the documentation of the handlers.
+func (r *Registry) NewClient(ctx context.Context, lsClient client.Client, rt *lsv1alpha1.ResolvedTarget) (interface{}, error) {
+	config, err := r.Decode(ctx, lsClient, rt)
+	if err != nil {
+		return nil, err
+	}
+	h, _ := r.Get(rt.Target.Spec.Type)
+	cf, ok := h.(ClientFactory)
+	if !ok {
+		return nil, fmt.Errorf("unable to construct a client for targets of type %q", rt.Target.Spec.Type)
+	}
+	return cf.NewClient(ctx, config)
+}
+
+// decodeConfig validates the given content against the schema of the handler and decodes it into the typed configuration.
+// The content is never part of the returned errors, as it usually contains credentials.
+func decodeConfig(h Handler, content []byte, fldPath *field.Path) (interface{}, field.ErrorList) {
+	allErrs := field.ErrorList{}
+
+	data, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, append(allErrs, field.Invalid(fldPath, hiddenValue, fmt.Sprintf("unable to parse target configuration: %s", err.Error())))
+	}
+
+	res, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(h.Schema()), gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return nil, append(allErrs, field.InternalError(fldPath, fmt.Errorf("unable to validate target configuration of type %q: %w", h.Type(), err)))
+	}
+	if !res.Valid() {
+		for _, resErr := range res.Errors() {
+			errPath := fldPath
+			if resErr.Field() != gojsonschema.STRING_CONTEXT_ROOT {
+				errPath = fldPath.Child(resErr.Field())
+			}
+			if resErr.Type() == "required" {
+				allErrs = append(allErrs, field.Required(errPath.Child(fmt.Sprint(resErr.Details()["property"])), ""))
+				continue
+			}
+			allErrs = append(allErrs, field.Invalid(errPath, hiddenValue, resErr.Description()))
+		}
+		return nil, allErrs
+	}
+
+	config := h.NewConfig()
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, append(allErrs, field.Invalid(fldPath, hiddenValue, fmt.Sprintf("unable to decode target configuration: %s", err.Error())))
+	}
+	if errs := h.Validate(config, fldPath); len(errs) != 0 {
+		return nil, errs
+	}
+	return config, nil
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetregistry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/utils/targetregistry"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://example.com
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: abc
+`
+
+func resolvedTarget(targetType lsv1alpha1.TargetType, content string) *lsv1alpha1.ResolvedTarget {
+	target := &lsv1alpha1.Target{}
+	target.Name = "my-target"
+	target.Namespace = "default"
+	target.Spec.Type = targetType
+	return &lsv1alpha1.ResolvedTarget{
+		Target:  target,
+		Content: content,
+	}
+}
+
+type customHandler struct{}
+
+func (customHandler) Type() lsv1alpha1.TargetType { return "example.com/custom" }
+func (customHandler) Schema() []byte {
+	return []byte(`{"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}}`)
+}
+func (customHandler) NewConfig() interface{} { return &map[string]string{} }
+func (customHandler) Validate(config interface{}, fldPath *field.Path) field.ErrorList {
+	return nil
+}
+
+var _ = Describe("Registry", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should contain the landscaper target types by default", func() {
+		Expect(targetregistry.Default.Types()).To(ConsistOf(
+			targettypes.KubernetesClusterTargetType,
+			targettypes.SSHTargetType,
+			targettypes.HTTPEndpointTargetType,
+			targettypes.OCIRegistryTargetType,
+		))
+	})
+
+	It("should not validate targets of unknown types", func() {
+		errs := targetregistry.Default.ValidateConfig("example.com/unknown", []byte("abc"), field.NewPath("config"))
+		Expect(errs).To(BeEmpty())
+	})
+
+	It("should register custom target types", func() {
+		r := targetregistry.New(customHandler{})
+		Expect(r.ValidateConfig("example.com/custom", []byte(`name: test`), field.NewPath("config"))).To(BeEmpty())
+		errs := r.ValidateConfig("example.com/custom", []byte(`{}`), field.NewPath("config"))
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Type).To(Equal(field.ErrorTypeRequired))
+		Expect(errs[0].Field).To(Equal("config.name"))
+
+		config, err := r.Decode(ctx, nil, resolvedTarget("example.com/custom", `{"name": "test"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*config.(*map[string]string)).To(HaveKeyWithValue("name", "test"))
+
+		_, err = r.NewClient(ctx, nil, resolvedTarget("example.com/custom", `{"name": "test"}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("KubernetesCluster", func() {
+
+		It("should accept all known kubeconfig variants", func() {
+			for _, content := range []string{
+				`{"kubeconfig": "apiVersion: v1"}`,
+				`{"kubeconfig": {"secretRef": {"name": "my-secret"}}}`,
+				testKubeconfig,
+			} {
+				errs := targetregistry.Default.ValidateConfig(targettypes.KubernetesClusterTargetType, []byte(content), field.NewPath("config"))
+				Expect(errs).To(BeEmpty(), content)
+			}
+		})
+
+		It("should reject a secret reference without name", func() {
+			errs := targetregistry.Default.ValidateConfig(targettypes.KubernetesClusterTargetType,
+				[]byte(`{"kubeconfig": {"secretRef": {"key": "abc"}}}`), field.NewPath("config"))
+			Expect(errs).ToNot(BeEmpty())
+		})
+
+		It("should resolve a deprecated secret reference and construct a rest config", func() {
+			secret := &corev1.Secret{}
+			secret.Name = "my-secret"
+			secret.Namespace = "default"
+			secret.Data = map[string][]byte{
+				targettypes.DefaultKubeconfigKey: []byte(testKubeconfig),
+			}
+			lsClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+
+			rt := resolvedTarget(targettypes.KubernetesClusterTargetType, `{"kubeconfig": {"secretRef": {"name": "my-secret"}}}`)
+			config, err := targetregistry.Default.Decode(ctx, lsClient, rt)
+			Expect(err).ToNot(HaveOccurred())
+			kubeconfig := config.(*targettypes.KubernetesClusterTargetConfig).Kubeconfig.StrVal
+			Expect(kubeconfig).ToNot(BeNil())
+			Expect(*kubeconfig).To(Equal(testKubeconfig))
+
+			restConfig, err := targetregistry.Default.NewClient(ctx, lsClient, rt)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restConfig.(*rest.Config).Host).To(Equal("https://example.com"))
+		})
+	})
+
+	Context("SSH", func() {
+
+		It("should require a user and a credential", func() {
+			errs := targetregistry.Default.ValidateConfig(targettypes.SSHTargetType,
+				[]byte(`{"host": "10.0.0.1", "user": "admin"}`), field.NewPath("config"))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("config.privateKey"))
+
+			errs = targetregistry.Default.ValidateConfig(targettypes.SSHTargetType,
+				[]byte(`{"host": "10.0.0.1", "password": "secret"}`), field.NewPath("config"))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("config.user"))
+		})
+
+		It("should reject invalid keys without exposing them", func() {
+			errs := targetregistry.Default.ValidateConfig(targettypes.SSHTargetType,
+				[]byte(`{"host": "10.0.0.1", "port": 70000, "user": "admin", "privateKey": "my-private-key"}`), field.NewPath("config"))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("config.port"))
+
+			errs = targetregistry.Default.ValidateConfig(targettypes.SSHTargetType,
+				[]byte(`{"host": "10.0.0.1", "user": "admin", "privateKey": "my-private-key"}`), field.NewPath("config"))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("config.privateKey"))
+			Expect(errs.ToAggregate().Error()).ToNot(ContainSubstring("my-private-key"))
+		})
+
+		It("should default the port", func() {
+			Expect(targetregistry.SSHAddress(&targettypes.SSHTargetConfig{Host: "10.0.0.1"})).To(Equal("10.0.0.1:22"))
+			Expect(targetregistry.SSHAddress(&targettypes.SSHTargetConfig{Host: "::1", Port: 2222})).To(Equal("[::1]:2222"))
+		})
+	})
+
+	Context("HTTPEndpoint", func() {
+
+		It("should reject invalid urls and ambiguous authentication", func() {
+			errs := targetregistry.Default.ValidateConfig(targettypes.HTTPEndpointTargetType,
+				[]byte(`{"url": "example.com", "username": "admin", "bearerToken": "token"}`), field.NewPath("config"))
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("config.url"))
+			Expect(errs[1].Field).To(Equal("config.bearerToken"))
+		})
+
+		It("should construct a client that authenticates all requests", func() {
+			var req *http.Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req = r
+			}))
+			defer server.Close()
+
+			rt := resolvedTarget(targettypes.HTTPEndpointTargetType,
+				`{"url": "`+server.URL+`", "bearerToken": "my-token", "headers": {"X-Tenant": "test"}}`)
+			httpClient, err := targetregistry.Default.NewClient(ctx, nil, rt)
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := httpClient.(*http.Client).Get(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Body.Close()).To(Succeed())
+			Expect(req.Header.Get("Authorization")).To(Equal("Bearer my-token"))
+			Expect(req.Header.Get("X-Tenant")).To(Equal("test"))
+		})
+	})
+
+	Context("OCIRegistry", func() {
+
+		It("should reject registries with scheme", func() {
+			errs := targetregistry.Default.ValidateConfig(targettypes.OCIRegistryTargetType,
+				[]byte(`{"registry": "https://eu.gcr.io/my-project"}`), field.NewPath("config"))
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("config.registry"))
+
+			_, err := targetregistry.Default.NewClient(ctx, nil,
+				resolvedTarget(targettypes.OCIRegistryTargetType, `{"registry": "eu.gcr.io/my-project"}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetregistry
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"golang.org/x/crypto/ssh"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+const sshSchema = `{
+  "type": "object",
+  "required": ["host", "user"],
+  "properties": {
+    "host": { "type": "string", "minLength": 1 },
+    "port": { "type": "integer", "minimum": 1, "maximum": 65535 },
+    "user": { "type": "string", "minLength": 1 },
+    "privateKey": { "type": "string" },
+    "password": { "type": "string" },
+    "hostPublicKey": { "type": "string" }
+  }
+}`
+
+// SSHHandler handles targets of type landscaper.gardener.cloud/ssh.
+// Its typed configuration is a *targettypes.SSHTargetConfig and its client is a connected *ssh.Client.
+type SSHHandler struct{}
+
+var _ Handler = SSHHandler{}
+var _ ClientFactory = SSHHandler{}
+
+func (SSHHandler) Type() lsv1alpha1.TargetType {
+	return targettypes.SSHTargetType
+}
+
+func (SSHHandler) Schema() []byte {
+	return []byte(sshSchema)
+}
+
+func (SSHHandler) NewConfig() interface{} {
+	return &targettypes.SSHTargetConfig{}
+}
+
+func (SSHHandler) Validate(config interface{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	cfg := config.(*targettypes.SSHTargetConfig)
+	if len(cfg.PrivateKey) == 0 && len(cfg.Password) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("privateKey"), "either a private key or a password is required"))
+	}
+	if len(cfg.PrivateKey) != 0 {
+		if _, err := ssh.ParsePrivateKey([]byte(cfg.PrivateKey)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("privateKey"), hiddenValue, fmt.Sprintf("invalid private key: %s", err.Error())))
+		}
+	}
+	if len(cfg.HostPublicKey) != 0 {
+		if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cfg.HostPublicKey)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hostPublicKey"), cfg.HostPublicKey, fmt.Sprintf("invalid public key: %s", err.Error())))
+		}
+	}
+	return allErrs
+}
+
+// NewClient connects to the host of the target.
+func (SSHHandler) NewClient(_ context.Context, config interface{}) (interface{}, error) {
+	cfg := config.(*targettypes.SSHTargetConfig)
+	clientConfig, err := SSHClientConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.Dial("tcp", SSHAddress(cfg), clientConfig)
+}
+
+// SSHAddress returns the address of the ssh server of the given target config.
+func SSHAddress(cfg *targettypes.SSHTargetConfig) string {
+	port := cfg.Port
+	if port == 0 {
+		port = targettypes.DefaultSSHPort
+	}
+	return net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+}
+
+// SSHClientConfig returns the ssh client configuration for the given target config.
+func SSHClientConfig(cfg *targettypes.SSHTargetConfig) (*ssh.ClientConfig, error) {
+	clientConfig := &ssh.ClientConfig{
+		User: cfg.User,
+		// the host key is only verified if a host key is defined.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+	if len(cfg.PrivateKey) != 0 {
+		signer, err := ssh.ParsePrivateKey([]byte(cfg.PrivateKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse private key: %w", err)
+		}
+		clientConfig.Auth = append(clientConfig.Auth, ssh.PublicKeys(signer))
+	}
+	if len(cfg.Password) != 0 {
+		clientConfig.Auth = append(clientConfig.Auth, ssh.Password(cfg.Password))
+	}
+	if len(cfg.HostPublicKey) != 0 {
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cfg.HostPublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse host public key: %w", err)
+		}
+		clientConfig.HostKeyCallback = ssh.FixedHostKey(hostKey)
+	}
+	return clientConfig, nil
+}
@@ -56,6 +56,8 @@ type Options struct {
 	WebhookedResources []WebhookedResourceDefinition
 	// certificates for the webhook
 	CABundle []byte
+	// DeepInstallationValidation enables the validation of installations against their blueprints if set
+	DeepInstallationValidation *DeepInstallationValidationOptions
 }
 
 // UpdateValidatingWebhookConfiguration will create or update a ValidatingWebhookConfiguration
@@ -173,7 +175,7 @@ func RegisterWebhooks(ctx context.Context, webhookServer *ctrlwebhook.Server, cl
 	// registering webhooks
 	for _, elem := range o.WebhookedResources {
 		rsLogger := logger.WithName(elem.ResourceName)
-		val, err := ValidatorFromResourceType(rsLogger, client, scheme, elem.ResourceName)
+		val, err := ValidatorFromResourceType(rsLogger, client, scheme, elem.ResourceName, o.DeepInstallationValidation)
 		if err != nil {
 			return fmt.Errorf("unable to register webhooks: %w", err)
 		}
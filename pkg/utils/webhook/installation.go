@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+// DeepInstallationValidationOptions configures the deep validation of installations.
+// The deep validation resolves the blueprint of root installations and validates the imports of the installation against it.
+type DeepInstallationValidationOptions struct {
+	// OCIConfig is the oci configuration that is used to resolve component descriptors and blueprints.
+	OCIConfig *config.OCIConfiguration
+}
+
+// deepValidationTimeout is the maximum duration of the deep validation of an installation.
+// It is shorter than the timeout of the webhook so that a slow registry results in an error response of the webhook.
+const deepValidationTimeout = 10 * time.Second
+
+// validateWithBlueprint resolves the blueprint of an installation and validates the imports of the installation against the blueprint.
+// Errors that prevent the validation, e.g. the unavailability of the api server or the registry, are returned as error.
+// Only imports that do not match the resolved blueprint are returned as validation errors.
+func (iv *InstallationValidator) validateWithBlueprint(ctx context.Context, inst *lsv1alpha1.Installation) (field.ErrorList, error) {
+	ctx, cancel := context.WithTimeout(ctx, deepValidationTimeout)
+	defer cancel()
+
+	lsCtx, err := installations.GetExternalContext(ctx, iv.Client, inst)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.ErrorList{field.Invalid(field.NewPath("spec", "context"), inst.Spec.Context, err.Error())}, nil
+		}
+		if err == installations.MissingRepositoryContextError {
+			return field.ErrorList{field.Required(field.NewPath("spec", "componentDescriptor", "ref", "repositoryContext"),
+				"no repository context is defined by the installation or its context")}, nil
+		}
+		return nil, err
+	}
+
+	secrets, err := iv.getPullSecrets(ctx, append(lsCtx.RegistryPullSecrets(), inst.Spec.RegistryPullSecrets...))
+	if err != nil {
+		return nil, err
+	}
+
+	var inlineCd *types.ComponentDescriptor = nil
+	if inst.Spec.ComponentDescriptor != nil {
+		inlineCd = inst.Spec.ComponentDescriptor.Inline
+	}
+
+	var ociConfig *config.OCIConfiguration
+	if iv.deepValidation != nil {
+		ociConfig = iv.deepValidation.OCIConfig
+	}
+	registryAccess, err := registries.NewFactory().NewRegistryAccess(ctx, secrets, nil, nil, ociConfig, inlineCd)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create registry access: %w", err)
+	}
+
+	blueprint, err := blueprints.ResolveBlueprint(ctx, registryAccess, lsCtx.ComponentDescriptorRef(), inst.Spec.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve blueprint %s: %w", blueprintRefString(inst.Spec.Blueprint), err)
+	}
+
+	allErrs := ValidateInstallationImportsWithBlueprint(inst, blueprint.Info)
+	targetErrs, err := ValidateImportedTargetTypes(ctx, iv.Client, inst, blueprint.Info)
+	if err != nil {
+		return nil, err
+	}
+	return append(allErrs, targetErrs...), nil
+}
+
+// getPullSecrets fetches the given registry pull secrets.
+// Secrets that do not exist are ignored as they are also not required to access public registries.
+func (iv *InstallationValidator) getPullSecrets(ctx context.Context, refs []lsv1alpha1.ObjectReference) ([]corev1.Secret, error) {
+	secrets := make([]corev1.Secret, 0, len(refs))
+	for _, ref := range refs {
+		secret := corev1.Secret{}
+		if err := iv.Client.Get(ctx, ref.NamespacedName(), &secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// ValidateInstallationImportsWithBlueprint validates that the imports and import data mappings of an installation
+// satisfy the imports that are defined by its blueprint.
+func ValidateInstallationImportsWithBlueprint(inst *lsv1alpha1.Installation, blueprint *lsv1alpha1.Blueprint) field.ErrorList {
+	allErrs := field.ErrorList{}
+	importsPath := field.NewPath("spec", "imports")
+
+	dataImports := map[string]bool{}
+	for _, imp := range inst.Spec.Imports.Data {
+		dataImports[imp.Name] = true
+	}
+	for name := range inst.Spec.ImportDataMappings {
+		dataImports[name] = true
+	}
+	targetImports := map[string]int{}
+	for i, imp := range inst.Spec.Imports.Targets {
+		targetImports[imp.Name] = i
+	}
+
+	allErrs = append(allErrs, validateImportDefinitionsSatisfied(blueprint.Imports, inst, dataImports, targetImports, importsPath)...)
+
+	definedDataImports := map[string]bool{}
+	collectDataImportNames(blueprint.Imports, definedDataImports)
+	for name := range inst.Spec.ImportDataMappings {
+		if !definedDataImports[name] {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "importDataMappings").Key(name), name,
+				"the blueprint does not define a data import with this name"))
+		}
+	}
+	for i, imp := range inst.Spec.Imports.Targets {
+		if def := getImportDefinition(blueprint.Imports, imp.Name); def != nil && def.Type == lsv1alpha1.ImportTypeData {
+			allErrs = append(allErrs, field.Invalid(importsPath.Child("targets").Index(i).Child("name"), imp.Name,
+				"the blueprint defines a data import with this name"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateImportDefinitionsSatisfied(defs lsv1alpha1.ImportDefinitionList,
+	inst *lsv1alpha1.Installation,
+	dataImports map[string]bool,
+	targetImports map[string]int,
+	importsPath *field.Path) field.ErrorList {
+
+	allErrs := field.ErrorList{}
+	for _, def := range defs {
+		required := def.Required == nil || *def.Required
+		switch def.Type {
+		case lsv1alpha1.ImportTypeData:
+			if !dataImports[def.Name] {
+				if required {
+					allErrs = append(allErrs, field.Required(importsPath.Child("data"),
+						fmt.Sprintf("blueprint defines import %q of type %s, which is not satisfied", def.Name, def.Type)))
+				}
+				continue
+			}
+			// conditional imports are only relevant if the parent import is satisfied
+			allErrs = append(allErrs, validateImportDefinitionsSatisfied(def.ConditionalImports, inst, dataImports, targetImports, importsPath)...)
+		case lsv1alpha1.ImportTypeTarget, lsv1alpha1.ImportTypeTargetList:
+			idx, ok := targetImports[def.Name]
+			if !ok {
+				if required {
+					allErrs = append(allErrs, field.Required(importsPath.Child("targets"),
+						fmt.Sprintf("blueprint defines import %q of type %s, which is not satisfied", def.Name, def.Type)))
+				}
+				continue
+			}
+			imp := inst.Spec.Imports.Targets[idx]
+			isSingleTarget := len(imp.Target) != 0
+			if def.Type == lsv1alpha1.ImportTypeTarget && !isSingleTarget {
+				allErrs = append(allErrs, field.Invalid(importsPath.Child("targets").Index(idx), imp.Name,
+					fmt.Sprintf("blueprint defines import %q of type %s, but a target list is imported", def.Name, def.Type)))
+			}
+			if def.Type == lsv1alpha1.ImportTypeTargetList && isSingleTarget {
+				allErrs = append(allErrs, field.Invalid(importsPath.Child("targets").Index(idx), imp.Name,
+					fmt.Sprintf("blueprint defines import %q of type %s, but a single target is imported", def.Name, def.Type)))
+			}
+		}
+	}
+	return allErrs
+}
+
+// ValidateImportedTargetTypes validates that the targets that are imported by an installation have the type that is expected by the blueprint.
+// Targets that do not exist yet are not validated.
+func ValidateImportedTargetTypes(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, blueprint *lsv1alpha1.Blueprint) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+	contextName := installations.GetInstallationContextName(inst)
+	targetsPath := field.NewPath("spec", "imports", "targets")
+
+	for i, imp := range inst.Spec.Imports.Targets {
+		def := getImportDefinition(blueprint.Imports, imp.Name)
+		if def == nil || len(def.TargetType) == 0 {
+			continue
+		}
+		switch {
+		case def.Type == lsv1alpha1.ImportTypeTarget && len(imp.Target) != 0:
+			target, err := installations.GetTargetImport(ctx, kubeClient, contextName, inst, imp)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			if targetType := string(target.GetTarget().Spec.Type); targetType != def.TargetType {
+				allErrs = append(allErrs, field.Invalid(targetsPath.Index(i).Child("target"), imp.Target,
+					fmt.Sprintf("imported target type is %s but expected %s", targetType, def.TargetType)))
+			}
+		case def.Type == lsv1alpha1.ImportTypeTargetList && len(imp.Targets) != 0:
+			for j := range imp.Targets {
+				single := lsv1alpha1.TargetImport{Name: imp.Name, Target: imp.Targets[j]}
+				target, err := installations.GetTargetImport(ctx, kubeClient, contextName, inst, single)
+				if err != nil {
+					if apierrors.IsNotFound(err) {
+						continue
+					}
+					return nil, err
+				}
+				if targetType := string(target.GetTarget().Spec.Type); targetType != def.TargetType {
+					allErrs = append(allErrs, field.Invalid(targetsPath.Index(i).Child("targets").Index(j), imp.Targets[j],
+						fmt.Sprintf("imported target type is %s but expected %s", targetType, def.TargetType)))
+				}
+			}
+		}
+	}
+	return allErrs, nil
+}
+
+// getImportDefinition returns the import definition with the given name including conditional imports.
+func getImportDefinition(defs lsv1alpha1.ImportDefinitionList, name string) *lsv1alpha1.ImportDefinition {
+	for i := range defs {
+		if defs[i].Name == name {
+			return &defs[i]
+		}
+		if def := getImportDefinition(defs[i].ConditionalImports, name); def != nil {
+			return def
+		}
+	}
+	return nil
+}
+
+func collectDataImportNames(defs lsv1alpha1.ImportDefinitionList, names map[string]bool) {
+	for _, def := range defs {
+		if def.Type == lsv1alpha1.ImportTypeData {
+			names[def.Name] = true
+		}
+		collectDataImportNames(def.ConditionalImports, names)
+	}
+}
+
+func blueprintRefString(def lsv1alpha1.BlueprintDefinition) string {
+	if def.Reference != nil {
+		return def.Reference.ResourceName
+	}
+	return "inline"
+}
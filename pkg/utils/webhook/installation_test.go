@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/webhook"
+)
+
+var _ = Describe("Installation", func() {
+
+	var blueprint *lsv1alpha1.Blueprint
+
+	BeforeEach(func() {
+		blueprint = &lsv1alpha1.Blueprint{
+			Imports: lsv1alpha1.ImportDefinitionList{
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "replicas"},
+					Type:                 lsv1alpha1.ImportTypeData,
+					ConditionalImports: lsv1alpha1.ImportDefinitionList{
+						{
+							FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "autoscaling"},
+							Type:                 lsv1alpha1.ImportTypeData,
+						},
+					},
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "namespace"},
+					Type:                 lsv1alpha1.ImportTypeData,
+					Required:             pointer.Bool(false),
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "cluster", TargetType: "landscaper.gardener.cloud/kubernetes-cluster"},
+					Type:                 lsv1alpha1.ImportTypeTarget,
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "clusters", TargetType: "landscaper.gardener.cloud/kubernetes-cluster"},
+					Type:                 lsv1alpha1.ImportTypeTargetList,
+					Required:             pointer.Bool(false),
+				},
+			},
+		}
+	})
+
+	newInstallation := func() *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "my-inst"
+		inst.Namespace = "default"
+		inst.Spec.Imports.Data = []lsv1alpha1.DataImport{{Name: "replicas", DataRef: "my-replicas"}}
+		inst.Spec.ImportDataMappings = map[string]lsv1alpha1.AnyJSON{
+			"autoscaling": lsv1alpha1.NewAnyJSON([]byte("true")),
+		}
+		inst.Spec.Imports.Targets = []lsv1alpha1.TargetImport{{Name: "cluster", Target: "my-cluster"}}
+		return inst
+	}
+
+	Context("ValidateInstallationImportsWithBlueprint", func() {
+
+		It("should accept an installation that satisfies all imports", func() {
+			Expect(webhook.ValidateInstallationImportsWithBlueprint(newInstallation(), blueprint)).To(BeEmpty())
+		})
+
+		It("should reject an installation with a missing import", func() {
+			inst := newInstallation()
+			inst.Spec.Imports.Data[0].Name = "replica"
+
+			allErrs := webhook.ValidateInstallationImportsWithBlueprint(inst, blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("spec.imports.data"),
+				"Detail": ContainSubstring(`"replicas"`),
+			}))))
+		})
+
+		It("should require conditional imports only if the parent import is satisfied", func() {
+			inst := newInstallation()
+			inst.Spec.ImportDataMappings = nil
+
+			allErrs := webhook.ValidateInstallationImportsWithBlueprint(inst, blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("spec.imports.data"),
+				"Detail": ContainSubstring(`"autoscaling"`),
+			}))))
+
+			blueprint.Imports[0].Required = pointer.Bool(false)
+			inst.Spec.Imports.Data = nil
+			Expect(webhook.ValidateInstallationImportsWithBlueprint(inst, blueprint)).To(BeEmpty())
+		})
+
+		It("should reject a data mapping for an unknown import", func() {
+			inst := newInstallation()
+			inst.Spec.ImportDataMappings["namespce"] = lsv1alpha1.NewAnyJSON([]byte(`"default"`))
+
+			allErrs := webhook.ValidateInstallationImportsWithBlueprint(inst, blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.importDataMappings[namespce]"),
+			}))))
+		})
+
+		It("should reject a target list that is imported as single target", func() {
+			inst := newInstallation()
+			inst.Spec.Imports.Targets = append(inst.Spec.Imports.Targets, lsv1alpha1.TargetImport{Name: "clusters", Target: "my-cluster"})
+
+			allErrs := webhook.ValidateInstallationImportsWithBlueprint(inst, blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.imports.targets[1]"),
+			}))))
+		})
+
+		It("should reject a data import that is imported as target", func() {
+			inst := newInstallation()
+			inst.Spec.Imports.Targets = append(inst.Spec.Imports.Targets, lsv1alpha1.TargetImport{Name: "namespace", Target: "my-cluster"})
+
+			allErrs := webhook.ValidateInstallationImportsWithBlueprint(inst, blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.imports.targets[1].name"),
+			}))))
+		})
+	})
+
+	Context("ValidateImportedTargetTypes", func() {
+
+		newTarget := func(name, targetType string) *lsv1alpha1.Target {
+			target := &lsv1alpha1.Target{}
+			target.Name = name
+			target.Namespace = "default"
+			target.Spec.Type = lsv1alpha1.TargetType(targetType)
+			return target
+		}
+
+		It("should accept targets of the expected type and ignore missing targets", func() {
+			inst := newInstallation()
+			inst.Spec.Imports.Targets = append(inst.Spec.Imports.Targets, lsv1alpha1.TargetImport{Name: "clusters", Targets: []string{"my-cluster", "missing"}})
+			kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+				WithObjects(newTarget("my-cluster", "landscaper.gardener.cloud/kubernetes-cluster")).Build()
+
+			allErrs, err := webhook.ValidateImportedTargetTypes(context.Background(), kubeClient, inst, blueprint)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject targets of a different type", func() {
+			inst := newInstallation()
+			inst.Spec.Imports.Targets = append(inst.Spec.Imports.Targets, lsv1alpha1.TargetImport{Name: "clusters", Targets: []string{"my-cluster", "my-other-cluster"}})
+			kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(
+				newTarget("my-cluster", "landscaper.gardener.cloud/mock"),
+				newTarget("my-other-cluster", "landscaper.gardener.cloud/kubernetes-cluster"),
+			).Build()
+
+			allErrs, err := webhook.ValidateImportedTargetTypes(context.Background(), kubeClient, inst, blueprint)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.imports.targets[0].target"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.imports.targets[1].targets[0]"),
+				})),
+			))
+		})
+	})
+
+	Context("InstallationValidator", func() {
+
+		var kubeClient client.Client
+
+		BeforeEach(func() {
+			kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		})
+
+		newRequest := func(operation admissionv1.Operation, inst, oldInst *lsv1alpha1.Installation) admission.Request {
+			req := admission.Request{}
+			req.Operation = operation
+			req.Namespace = inst.Namespace
+			req.Kind = metav1.GroupVersionKind{Group: "landscaper.gardener.cloud", Version: "v1alpha1", Kind: "Installation"}
+			raw, err := json.Marshal(inst)
+			Expect(err).ToNot(HaveOccurred())
+			req.Object = runtime.RawExtension{Raw: raw}
+			if oldInst != nil {
+				raw, err := json.Marshal(oldInst)
+				Expect(err).ToNot(HaveOccurred())
+				req.OldObject = runtime.RawExtension{Raw: raw}
+			}
+			return req
+		}
+
+		newInlineInstallation := func(dataMappings map[string]lsv1alpha1.AnyJSON) *lsv1alpha1.Installation {
+			inst := &lsv1alpha1.Installation{}
+			inst.TypeMeta = metav1.TypeMeta{APIVersion: lsv1alpha1.SchemeGroupVersion.String(), Kind: "Installation"}
+			inst.Name = "my-inst"
+			inst.Namespace = "default"
+			fs, err := json.Marshal(map[string]string{
+				lsv1alpha1.BlueprintFileName: `apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+imports:
+- name: replicas
+  type: data
+  schema:
+    type: integer
+`,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			inst.Spec.Blueprint.Inline = &lsv1alpha1.InlineBlueprint{Filesystem: lsv1alpha1.NewAnyJSON(fs)}
+			inst.Spec.ImportDataMappings = dataMappings
+			return inst
+		}
+
+		It("should deny an installation that does not satisfy the imports of its inline blueprint", func() {
+			validator, err := webhook.ValidatorFromResourceType(logging.Discard(), kubeClient, api.LandscaperScheme, "installations",
+				&webhook.DeepInstallationValidationOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			inst := newInlineInstallation(map[string]lsv1alpha1.AnyJSON{"replica": lsv1alpha1.NewAnyJSON([]byte("3"))})
+			res := validator.Handle(context.Background(), newRequest(admissionv1.Create, inst, nil))
+			Expect(res.Allowed).To(BeFalse())
+			Expect(string(res.Result.Reason)).To(ContainSubstring("spec.importDataMappings[replica]"))
+
+			inst = newInlineInstallation(map[string]lsv1alpha1.AnyJSON{"replicas": lsv1alpha1.NewAnyJSON([]byte("3"))})
+			res = validator.Handle(context.Background(), newRequest(admissionv1.Create, inst, nil))
+			Expect(res.Allowed).To(BeTrue())
+		})
+
+		It("should return an error if the blueprint cannot be resolved", func() {
+			validator, err := webhook.ValidatorFromResourceType(logging.Discard(), kubeClient, api.LandscaperScheme, "installations",
+				&webhook.DeepInstallationValidationOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			inst := newInlineInstallation(nil)
+			fs, err := json.Marshal(map[string]string{"other.yaml": "foo: bar"})
+			Expect(err).ToNot(HaveOccurred())
+			inst.Spec.Blueprint.Inline = &lsv1alpha1.InlineBlueprint{Filesystem: lsv1alpha1.NewAnyJSON(fs)}
+			res := validator.Handle(context.Background(), newRequest(admissionv1.Create, inst, nil))
+			Expect(res.Allowed).To(BeFalse())
+			Expect(res.Result.Code).To(BeEquivalentTo(http.StatusInternalServerError))
+		})
+
+		It("should not validate updates that do not change the spec", func() {
+			validator, err := webhook.ValidatorFromResourceType(logging.Discard(), kubeClient, api.LandscaperScheme, "installations",
+				&webhook.DeepInstallationValidationOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			inst := newInlineInstallation(nil)
+			oldInst := inst.DeepCopy()
+			inst.Annotations = map[string]string{"foo": "bar"}
+			res := validator.Handle(context.Background(), newRequest(admissionv1.Update, inst, oldInst))
+			Expect(res.Allowed).To(BeTrue())
+		})
+
+		It("should not resolve the blueprint if the deep validation is disabled", func() {
+			validator, err := webhook.ValidatorFromResourceType(logging.Discard(), kubeClient, api.LandscaperScheme, "installations", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			inst := newInlineInstallation(nil)
+			res := validator.Handle(context.Background(), newRequest(admissionv1.Create, inst, nil))
+			Expect(res.Allowed).To(BeTrue())
+		})
+	})
+})
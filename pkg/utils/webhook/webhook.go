@@ -6,13 +6,17 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -20,23 +24,36 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/targetregistry"
 )
 
 // ValidatorFromResourceType is a helper method that gets a resource type and returns the fitting validator
-func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, resource string) (GenericValidator, error) {
+// The deep installation validation is only enabled if the corresponding options are given.
+func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, scheme *runtime.Scheme, resource string,
+	deepInstallationValidation *DeepInstallationValidationOptions) (GenericValidator, error) {
 	abstrVal := newAbstractedValidator(log, kubeClient, scheme)
 	var val GenericValidator
 	switch resource {
 	case "installations":
-		val = &InstallationValidator{abstrVal}
+		val = &InstallationValidator{abstractValidator: abstrVal, deepValidation: deepInstallationValidation}
 	case "deployitems":
 		val = &DeployItemValidator{abstrVal}
 	case "executions":
 		val = &ExecutionValidator{abstrVal}
 	case "targets":
 		val = &TargetValidator{abstrVal}
+	case "dataobjects":
+		val = &DataObjectValidator{abstrVal}
+	case "contexts":
+		val = &ContextValidator{abstrVal}
+	case "targetsyncs":
+		val = &TargetSyncValidator{abstrVal}
+	case "componentversionoverwrites":
+		val = &ComponentVersionOverwritesValidator{abstrVal}
 	default:
 		return nil, fmt.Errorf("unable to find validator for resource type %q", resource)
 	}
@@ -66,7 +83,11 @@ type GenericValidator interface {
 // INSTALLATION
 
 // InstallationValidator represents a validator for an Installation
-type InstallationValidator struct{ abstractValidator }
+type InstallationValidator struct {
+	abstractValidator
+	// deepValidation enables the validation of the installation's imports against its blueprint if set.
+	deepValidation *DeepInstallationValidationOptions
+}
 
 // Handle handles a request to the webhook
 func (iv *InstallationValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
@@ -107,9 +128,42 @@ func (iv *InstallationValidator) handlePrivate(ctx context.Context, req admissio
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	if iv.deepValidation != nil {
+		v1Inst := &lsv1alpha1.Installation{}
+		if err := json.Unmarshal(req.Object.Raw, v1Inst); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if iv.requiresDeepValidation(req, v1Inst) {
+			errs, err := iv.validateWithBlueprint(ctx, v1Inst)
+			if err != nil {
+				logger.Error(err, "unable to validate installation with its blueprint")
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			if len(errs) > 0 {
+				return admission.Denied(errs.ToAggregate().Error())
+			}
+		}
+	}
+
 	return admission.Allowed("Installation is valid")
 }
 
+// requiresDeepValidation checks whether the blueprint of an installation has to be resolved to validate it.
+// Only root installations are validated as subinstallations are created by the landscaper.
+// Updates that do not change the spec, e.g. of annotations or finalizers, are not validated again.
+func (iv *InstallationValidator) requiresDeepValidation(req admission.Request, inst *lsv1alpha1.Installation) bool {
+	if inst.DeletionTimestamp != nil || !installations.IsRootInstallation(inst) {
+		return false
+	}
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) != 0 {
+		oldInst := &lsv1alpha1.Installation{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldInst); err == nil && equality.Semantic.DeepEqual(oldInst.Spec, inst.Spec) {
+			return false
+		}
+	}
+	return true
+}
+
 // DEPLOYITEM
 
 // DeployItemValidator represents a validator for a DeployItem
@@ -189,9 +243,36 @@ func (ev *ExecutionValidator) handlePrivate(ctx context.Context, req admission.R
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	oldExec := &lscore.Execution{}
+	if req.Operation == admissionv1.Update {
+		if _, _, err := ev.decoder.Decode(req.OldObject.Raw, nil, oldExec); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+	if errs := ValidateApprovalAnnotations(exec, oldExec, req.UserInfo.Username); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
 	return admission.Allowed("Execution is valid")
 }
 
+// ValidateApprovalAnnotations validates that approval annotations that are added or changed name the user
+// who sets them as approver. This way, the approvers that are recorded by the landscaper are authenticated.
+func ValidateApprovalAnnotations(exec, oldExec *lscore.Execution, username string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	annotationsPath := field.NewPath("metadata", "annotations")
+	for key, approver := range exec.GetAnnotations() {
+		if !strings.HasPrefix(key, lscore.ApprovalAnnotationPrefix) || oldExec.GetAnnotations()[key] == approver {
+			continue
+		}
+		if approver != username {
+			allErrs = append(allErrs, field.Invalid(annotationsPath.Key(key), approver,
+				fmt.Sprintf("the approver must be the user who approves the deploy item: %s", username)))
+		}
+	}
+	return allErrs
+}
+
 // TARGET
 
 // TargetValidator represents a validator for a Target
@@ -224,5 +305,167 @@ func (tv *TargetValidator) handlePrivate(ctx context.Context, req admission.Requ
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	// targets with a secret reference are validated when they are resolved by the deployers
+	if t.Spec.Configuration != nil {
+		errs := targetregistry.Default.ValidateConfig(lsv1alpha1.TargetType(t.Spec.Type), t.Spec.Configuration.RawMessage, field.NewPath("spec", "config"))
+		if len(errs) > 0 {
+			return admission.Denied(errs.ToAggregate().Error())
+		}
+	}
+
 	return admission.Allowed("Target is valid")
 }
+
+// DATAOBJECT
+
+// DataObjectValidator represents a validator for a DataObject
+type DataObjectValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (dv *DataObjectValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	logger := dv.log.WithValues(lc.KeyResourceGroup, req.Kind.Group, lc.KeyResourceKind, req.Kind.Kind, lc.KeyResourceVersion, req.Kind.Version)
+	ctx = logging.NewContext(ctx, logger)
+
+	timeBefore := time.Now()
+	result := dv.handlePrivate(ctx, req)
+
+	logIfDurationExceeded(ctx, timeBefore)
+
+	return result
+}
+
+func (dv *DataObjectValidator) handlePrivate(ctx context.Context, req admission.Request) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "DataObjectValidator.handlePrivate"})
+
+	logger.Debug("Received request")
+
+	do := &lscore.DataObject{}
+	if _, _, err := dv.decoder.Decode(req.Object.Raw, nil, do); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateDataObject(do); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("DataObject is valid")
+}
+
+// CONTEXT
+
+// ContextValidator represents a validator for a Context
+type ContextValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (cv *ContextValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	logger := cv.log.WithValues(lc.KeyResourceGroup, req.Kind.Group, lc.KeyResourceKind, req.Kind.Kind, lc.KeyResourceVersion, req.Kind.Version)
+	ctx = logging.NewContext(ctx, logger)
+
+	timeBefore := time.Now()
+	result := cv.handlePrivate(ctx, req)
+
+	logIfDurationExceeded(ctx, timeBefore)
+
+	return result
+}
+
+func (cv *ContextValidator) handlePrivate(ctx context.Context, req admission.Request) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ContextValidator.handlePrivate"})
+
+	logger.Debug("Received request")
+
+	lsCtx := &lscore.Context{}
+	if _, _, err := cv.decoder.Decode(req.Object.Raw, nil, lsCtx); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateContext(lsCtx); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	// the component version overwrites are resolved in the namespace of the context
+	if len(lsCtx.ComponentVersionOverwritesReference) != 0 {
+		cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		key := client.ObjectKey{Name: lsCtx.ComponentVersionOverwritesReference, Namespace: req.Namespace}
+		if err := cv.Client.Get(ctx, key, cvo); err != nil {
+			if apierrors.IsNotFound(err) {
+				fldPath := field.NewPath("componentVersionOverwrites")
+				return admission.Denied(field.NotFound(fldPath, lsCtx.ComponentVersionOverwritesReference).Error())
+			}
+			logger.Error(err, "unable to get referenced component version overwrites", lc.KeyResource, key.String())
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+	}
+
+	return admission.Allowed("Context is valid")
+}
+
+// TARGETSYNC
+
+// TargetSyncValidator represents a validator for a TargetSync
+type TargetSyncValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (tsv *TargetSyncValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	logger := tsv.log.WithValues(lc.KeyResourceGroup, req.Kind.Group, lc.KeyResourceKind, req.Kind.Kind, lc.KeyResourceVersion, req.Kind.Version)
+	ctx = logging.NewContext(ctx, logger)
+
+	timeBefore := time.Now()
+	result := tsv.handlePrivate(ctx, req)
+
+	logIfDurationExceeded(ctx, timeBefore)
+
+	return result
+}
+
+func (tsv *TargetSyncValidator) handlePrivate(ctx context.Context, req admission.Request) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetSyncValidator.handlePrivate"})
+
+	logger.Debug("Received request")
+
+	ts := &lscore.TargetSync{}
+	if _, _, err := tsv.decoder.Decode(req.Object.Raw, nil, ts); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateTargetSync(ts); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("TargetSync is valid")
+}
+
+// COMPONENTVERSIONOVERWRITES
+
+// ComponentVersionOverwritesValidator represents a validator for ComponentVersionOverwrites
+type ComponentVersionOverwritesValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (cvov *ComponentVersionOverwritesValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	logger := cvov.log.WithValues(lc.KeyResourceGroup, req.Kind.Group, lc.KeyResourceKind, req.Kind.Kind, lc.KeyResourceVersion, req.Kind.Version)
+	ctx = logging.NewContext(ctx, logger)
+
+	timeBefore := time.Now()
+	result := cvov.handlePrivate(ctx, req)
+
+	logIfDurationExceeded(ctx, timeBefore)
+
+	return result
+}
+
+func (cvov *ComponentVersionOverwritesValidator) handlePrivate(ctx context.Context, req admission.Request) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ComponentVersionOverwritesValidator.handlePrivate"})
+
+	logger.Debug("Received request")
+
+	cvo := &lscore.ComponentVersionOverwrites{}
+	if _, _, err := cvov.decoder.Decode(req.Object.Raw, nil, cvo); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateComponentVersionOverwrites(cvo); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("ComponentVersionOverwrites are valid")
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/webhook"
+)
+
+var _ = Describe("Execution", func() {
+
+	var validator admission.Handler
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build(),
+			api.LandscaperScheme, "executions", nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	newExecution := func(approver string) *lsv1alpha1.Execution {
+		exec := &lsv1alpha1.Execution{}
+		exec.TypeMeta = metav1.TypeMeta{APIVersion: lsv1alpha1.SchemeGroupVersion.String(), Kind: "Execution"}
+		exec.Name = "my-exec"
+		exec.Namespace = "default"
+		if len(approver) != 0 {
+			exec.Annotations = map[string]string{lsv1alpha1.ApprovalAnnotationPrefix + "my-item": approver}
+		}
+		return exec
+	}
+
+	newRequest := func(operation admissionv1.Operation, username string, exec, oldExec *lsv1alpha1.Execution) admission.Request {
+		req := admission.Request{}
+		req.Operation = operation
+		req.UserInfo.Username = username
+		raw, err := json.Marshal(exec)
+		Expect(err).ToNot(HaveOccurred())
+		req.Object = runtime.RawExtension{Raw: raw}
+		if oldExec != nil {
+			raw, err := json.Marshal(oldExec)
+			Expect(err).ToNot(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return req
+	}
+
+	It("should accept an approval of the requesting user", func() {
+		res := validator.Handle(context.Background(), newRequest(admissionv1.Update, "alice", newExecution("alice"), newExecution("")))
+		Expect(res.Allowed).To(BeTrue())
+	})
+
+	It("should reject an approval on behalf of another user", func() {
+		res := validator.Handle(context.Background(), newRequest(admissionv1.Update, "alice", newExecution("bob"), newExecution("")))
+		Expect(res.Allowed).To(BeFalse())
+		Expect(string(res.Result.Reason)).To(ContainSubstring("the approver must be the user who approves the deploy item: alice"))
+
+		res = validator.Handle(context.Background(), newRequest(admissionv1.Create, "alice", newExecution("bob"), nil))
+		Expect(res.Allowed).To(BeFalse())
+	})
+
+	It("should not validate approvals that are not changed", func() {
+		res := validator.Handle(context.Background(), newRequest(admissionv1.Update, "landscaper", newExecution("bob"), newExecution("bob")))
+		Expect(res.Allowed).To(BeTrue())
+
+		res = validator.Handle(context.Background(), newRequest(admissionv1.Update, "landscaper", newExecution(""), newExecution("bob")))
+		Expect(res.Allowed).To(BeTrue())
+	})
+})
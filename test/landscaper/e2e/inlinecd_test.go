@@ -51,7 +51,7 @@ var _ = Describe("Inline Component Descriptor", func() {
 			},
 		})
 
-		execActuator, err = execctlr.NewController(logging.Discard(), testenv.Client, api.LandscaperScheme, record.NewFakeRecorder(1024))
+		execActuator, err = execctlr.NewController(logging.Discard(), testenv.Client, api.LandscaperScheme, record.NewFakeRecorder(1024), nil)
 		Expect(err).ToNot(HaveOccurred())
 
 		mockActuator, err = mockctlr.NewController(logging.Discard(), testenv.Client, api.LandscaperScheme, record.NewFakeRecorder(1024), mockv1alpha1.Configuration{})